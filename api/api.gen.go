@@ -10,6 +10,8 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
+	"mime/multipart"
 	"net/http"
 	"net/url"
 	"path"
@@ -27,6 +29,40 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for AttachmentContentType.
+const (
+	Applicationpdf AttachmentContentType = "application/pdf"
+	Imagejpeg      AttachmentContentType = "image/jpeg"
+	Imagepng       AttachmentContentType = "image/png"
+)
+
+// Defines values for DiscrepancyItemKind.
+const (
+	Extra      DiscrepancyItemKind = "extra"
+	Matched    DiscrepancyItemKind = "matched"
+	Mismatched DiscrepancyItemKind = "mismatched"
+	Missing    DiscrepancyItemKind = "missing"
+)
+
+// Defines values for ExportFormat.
+const (
+	Csv  ExportFormat = "csv"
+	Xlsx ExportFormat = "xlsx"
+)
+
+// Defines values for ManifestStatus.
+const (
+	ManifestStatusExpected ManifestStatus = "expected"
+	ManifestStatusReceived ManifestStatus = "received"
+)
+
+// Defines values for OrderStatus.
+const (
+	Awaiting OrderStatus = "awaiting"
+	Issued   OrderStatus = "issued"
+	Ready    OrderStatus = "ready"
+)
+
 // Defines values for PVZCity.
 const (
 	Казань         PVZCity = "Казань"
@@ -34,17 +70,94 @@ const (
 	СанктПетербург PVZCity = "Санкт-Петербург"
 )
 
-// Defines values for ProductType.
+// Defines values for ProductStatus.
+const (
+	ProductStatusInTransit        ProductStatus = "in_transit"
+	ProductStatusIssued           ProductStatus = "issued"
+	ProductStatusReceived         ProductStatus = "received"
+	ProductStatusReturned         ProductStatus = "returned"
+	ProductStatusReturnedToSender ProductStatus = "returned_to_sender"
+	ProductStatusStored           ProductStatus = "stored"
+)
+
+// Defines values for PvzField.
+const (
+	PvzFieldPvz             PvzField = "pvz"
+	PvzFieldReceptions      PvzField = "receptions"
+	PvzFieldReturnShipments PvzField = "returnShipments"
+)
+
+// Defines values for PvzInclude.
+const (
+	PvzIncludeCounts     PvzInclude = "counts"
+	PvzIncludeNone       PvzInclude = "none"
+	PvzIncludeProducts   PvzInclude = "products"
+	PvzIncludeReceptions PvzInclude = "receptions"
+)
+
+// Defines values for PvzLimitsOnExceed.
 const (
-	ProductTypeОбувь       ProductType = "обувь"
-	ProductTypeОдежда      ProductType = "одежда"
-	ProductTypeЭлектроника ProductType = "электроника"
+	Reject PvzLimitsOnExceed = "reject"
+	Warn   PvzLimitsOnExceed = "warn"
 )
 
 // Defines values for ReceptionStatus.
 const (
-	Close      ReceptionStatus = "close"
-	InProgress ReceptionStatus = "in_progress"
+	ReceptionStatusCancelled  ReceptionStatus = "cancelled"
+	ReceptionStatusClosed     ReceptionStatus = "closed"
+	ReceptionStatusDraft      ReceptionStatus = "draft"
+	ReceptionStatusInProgress ReceptionStatus = "in_progress"
+	ReceptionStatusVerified   ReceptionStatus = "verified"
+)
+
+// Defines values for ReceptionType.
+const (
+	ReceptionTypeSupply   ReceptionType = "supply"
+	ReceptionTypeTransfer ReceptionType = "transfer"
+)
+
+// Defines values for ReturnCondition.
+const (
+	Defective ReturnCondition = "defective"
+	Intact    ReturnCondition = "intact"
+	Opened    ReturnCondition = "opened"
+)
+
+// Defines values for ReturnReasonCode.
+const (
+	ChangedMind    ReturnReasonCode = "changed_mind"
+	Damaged        ReturnReasonCode = "damaged"
+	NotAsDescribed ReturnReasonCode = "not_as_described"
+	Other          ReturnReasonCode = "other"
+	Refused        ReturnReasonCode = "refused"
+	WrongItem      ReturnReasonCode = "wrong_item"
+)
+
+// Defines values for ReturnShipmentStatus.
+const (
+	ReturnShipmentStatusClosed     ReturnShipmentStatus = "closed"
+	ReturnShipmentStatusInProgress ReturnShipmentStatus = "in_progress"
+)
+
+// Defines values for StatsDimension.
+const (
+	StatsDimensionCity        StatsDimension = "city"
+	StatsDimensionProductType StatsDimension = "productType"
+	StatsDimensionPvz         StatsDimension = "pvz"
+)
+
+// Defines values for StatsPeriod.
+const (
+	Day   StatsPeriod = "day"
+	Month StatsPeriod = "month"
+	Week  StatsPeriod = "week"
+)
+
+// Defines values for TransferStatus.
+const (
+	Created    TransferStatus = "created"
+	Dispatched TransferStatus = "dispatched"
+	Received   TransferStatus = "received"
 )
 
 // Defines values for UserRole.
@@ -59,11 +172,10 @@ const (
 	PostDummyLoginJSONBodyRoleModerator PostDummyLoginJSONBodyRole = "moderator"
 )
 
-// Defines values for PostProductsJSONBodyType.
+// Defines values for PostReceptionsJSONBodyStatus.
 const (
-	PostProductsJSONBodyTypeОбувь       PostProductsJSONBodyType = "обувь"
-	PostProductsJSONBodyTypeОдежда      PostProductsJSONBodyType = "одежда"
-	PostProductsJSONBodyTypeЭлектроника PostProductsJSONBodyType = "электроника"
+	Draft      PostReceptionsJSONBodyStatus = "draft"
+	InProgress PostReceptionsJSONBodyStatus = "in_progress"
 )
 
 // Defines values for PostRegisterJSONBodyRole.
@@ -72,11 +184,146 @@ const (
 	Moderator PostRegisterJSONBodyRole = "moderator"
 )
 
+// Attachment Вложение приемки или товара (фото повреждений, скан накладной), содержимое хранится в хранилище файлов
+type Attachment struct {
+	ContentType AttachmentContentType `json:"contentType"`
+	CreatedBy   *openapi_types.UUID   `json:"createdBy,omitempty"`
+	DateTime    *time.Time            `json:"dateTime,omitempty"`
+	FileName    string                `json:"fileName"`
+	Id          *openapi_types.UUID   `json:"id,omitempty"`
+	ProductId   *openapi_types.UUID   `json:"productId,omitempty"`
+	ReceptionId *openapi_types.UUID   `json:"receptionId,omitempty"`
+
+	// Size Размер файла в байтах
+	Size int `json:"size"`
+}
+
+// AttachmentContentType defines model for Attachment.ContentType.
+type AttachmentContentType string
+
+// AttachmentUpload defines model for AttachmentUpload.
+type AttachmentUpload struct {
+	// File JPEG, PNG или PDF размером до 10 МБ
+	File openapi_types.File `json:"file"`
+}
+
+// CapacityUsage Занятые вес (граммы) и объем (кубические сантиметры) товарами на руках ПВЗ
+type CapacityUsage struct {
+	// Products Количество товаров на руках
+	Products int `json:"products"`
+	Volume   int `json:"volume"`
+	Weight   int `json:"weight"`
+}
+
+// CellCapacity defines model for CellCapacity.
+type CellCapacity struct {
+	CellId openapi_types.UUID `json:"cellId"`
+	Code   string             `json:"code"`
+
+	// Used Занятые вес (граммы) и объем (кубические сантиметры) товарами на руках ПВЗ
+	Used CapacityUsage `json:"used"`
+}
+
+// DiscrepancyItem defines model for DiscrepancyItem.
+type DiscrepancyItem struct {
+	Actual   int                 `json:"actual"`
+	Expected int                 `json:"expected"`
+	Kind     DiscrepancyItemKind `json:"kind"`
+
+	// Sku Штрихкод строки манифеста, для строк по типу товара отсутствует
+	Sku  *string `json:"sku,omitempty"`
+	Type string  `json:"type"`
+}
+
+// DiscrepancyItemKind defines model for DiscrepancyItem.Kind.
+type DiscrepancyItemKind string
+
+// DiscrepancyReport defines model for DiscrepancyReport.
+type DiscrepancyReport struct {
+	DateTime         *time.Time          `json:"dateTime,omitempty"`
+	HasDiscrepancies bool                `json:"hasDiscrepancies"`
+	Id               *openapi_types.UUID `json:"id,omitempty"`
+	Items            []DiscrepancyItem   `json:"items"`
+	Overridden       bool                `json:"overridden"`
+	OverriddenBy     *openapi_types.UUID `json:"overriddenBy,omitempty"`
+	OverrideReason   *string             `json:"overrideReason,omitempty"`
+	ReceptionId      openapi_types.UUID  `json:"receptionId"`
+}
+
+// DuplicateProductError Товар с таким штрихкодом уже находится на складе
+type DuplicateProductError struct {
+	Barcode     string             `json:"barcode"`
+	Message     string             `json:"message"`
+	ProductId   openapi_types.UUID `json:"productId"`
+	ReceptionId openapi_types.UUID `json:"receptionId"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	Message string `json:"message"`
 }
 
+// ExportFormat Формат выгрузки, без параметра выбирается по заголовку Accept (по умолчанию csv)
+type ExportFormat string
+
+// Manifest defines model for Manifest.
+type Manifest struct {
+	DateTime *time.Time          `json:"dateTime,omitempty"`
+	Id       *openapi_types.UUID `json:"id,omitempty"`
+	Items    []ManifestItem      `json:"items"`
+	PvzId    openapi_types.UUID  `json:"pvzId"`
+
+	// ReceptionId Приемка, при закрытии которой манифест был сверен
+	ReceptionId *openapi_types.UUID `json:"receptionId,omitempty"`
+	Status      ManifestStatus      `json:"status"`
+
+	// Strict Запрещать закрытие приемки с расхождениями без подтверждения модератора
+	Strict   bool   `json:"strict"`
+	Supplier string `json:"supplier"`
+}
+
+// ManifestStatus defines model for Manifest.Status.
+type ManifestStatus string
+
+// ManifestItem defines model for ManifestItem.
+type ManifestItem struct {
+	Count int `json:"count"`
+
+	// Sku Штрихкод ожидаемого товара. Строка с sku сверяется с товаром приемки с этим barcode, count должен быть 1
+	Sku  *string `json:"sku,omitempty"`
+	Type string  `json:"type"`
+}
+
+// Order defines model for Order.
+type Order struct {
+	DateTime *time.Time          `json:"dateTime,omitempty"`
+	Id       *openapi_types.UUID `json:"id,omitempty"`
+	IssuedAt *time.Time          `json:"issuedAt,omitempty"`
+	Items    []OrderItem         `json:"items"`
+	Number   string              `json:"number"`
+
+	// PickupAttempts Количество неверных попыток ввода кода выдачи
+	PickupAttempts int                `json:"pickupAttempts"`
+	PvzId          openapi_types.UUID `json:"pvzId"`
+	ReadyAt        *time.Time         `json:"readyAt,omitempty"`
+	RecipientEmail *string            `json:"recipientEmail,omitempty"`
+	RecipientPhone *string            `json:"recipientPhone,omitempty"`
+
+	// Status Статус заказа, код выдачи выпускается при переходе в ready
+	Status OrderStatus `json:"status"`
+}
+
+// OrderItem defines model for OrderItem.
+type OrderItem struct {
+	Barcode string `json:"barcode"`
+
+	// ProductId Товар ПВЗ, сопоставленный с позицией заказа при сканировании
+	ProductId *openapi_types.UUID `json:"productId,omitempty"`
+}
+
+// OrderStatus Статус заказа, код выдачи выпускается при переходе в ready
+type OrderStatus string
+
 // PVZ defines model for PVZ.
 type PVZ struct {
 	City             PVZCity             `json:"city"`
@@ -87,31 +334,371 @@ type PVZ struct {
 // PVZCity defines model for PVZ.City.
 type PVZCity string
 
+// PickupCode defines model for PickupCode.
+type PickupCode struct {
+	PickupCode string `json:"pickupCode"`
+}
+
 // Product defines model for Product.
 type Product struct {
-	DateTime    *time.Time          `json:"dateTime,omitempty"`
+	// Attributes Атрибуты товара, проверяемые по схеме его типа
+	Attributes *ProductAttributes `json:"attributes,omitempty"`
+
+	// Barcode Штрихкод товара (EAN-13 или Code128 с контрольным символом)
+	Barcode *string `json:"barcode,omitempty"`
+
+	// CellId Ячейка хранения товара в ПВЗ
+	CellId *openapi_types.UUID `json:"cellId,omitempty"`
+
+	// CreatedBy Пользователь, добавивший товар
+	CreatedBy *openapi_types.UUID `json:"createdBy,omitempty"`
+	DateTime  *time.Time          `json:"dateTime,omitempty"`
+
+	// DeleteReason Причина удаления товара, у товаров, удаленных последним добавленным, не заполняется
+	DeleteReason *string `json:"deleteReason,omitempty"`
+
+	// DeletedAt Время удаления товара из приемки, заполняется только в списке удаленных товаров
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+
+	// DeletedBy Пользователь, удаливший товар
+	DeletedBy *openapi_types.UUID `json:"deletedBy,omitempty"`
+
+	// Dimensions Габариты товара в сантиметрах, каждая сторона не более 10 м
+	Dimensions  *ProductDimensions  `json:"dimensions,omitempty"`
 	Id          *openapi_types.UUID `json:"id,omitempty"`
 	ReceptionId openapi_types.UUID  `json:"receptionId"`
-	Type        ProductType         `json:"type"`
+
+	// Status Статус товара, товары на руках ПВЗ имеют статусы received и stored, возвращенные получателем товары ждут отправки в статусе returned
+	Status *ProductStatus `json:"status,omitempty"`
+
+	// Type Название типа из справочника типов товаров
+	Type string `json:"type"`
+
+	// VoidedAt Время аннулирования товара при отмене приемки
+	VoidedAt *time.Time `json:"voidedAt,omitempty"`
+
+	// Warnings Превышенные при добавлении товара лимиты ПВЗ, если ПВЗ принимает товары с предупреждением
+	Warnings *[]string `json:"warnings,omitempty"`
+
+	// Weight Вес товара в граммах
+	Weight *int `json:"weight,omitempty"`
+}
+
+// ProductAttributes Атрибуты товара, проверяемые по схеме его типа
+type ProductAttributes map[string]interface{}
+
+// ProductBatchItem defines model for ProductBatchItem.
+type ProductBatchItem struct {
+	// Attributes Атрибуты товара, проверяемые по схеме его типа
+	Attributes *ProductAttributes `json:"attributes,omitempty"`
+	Barcode    *string            `json:"barcode,omitempty"`
+
+	// Dimensions Габариты товара в сантиметрах, каждая сторона не более 10 м
+	Dimensions *ProductDimensions `json:"dimensions,omitempty"`
+	Type       string             `json:"type"`
+
+	// Weight Вес товара в граммах, не более 1 т
+	Weight *int `json:"weight,omitempty"`
+}
+
+// ProductCounts Количество неаннулированных товаров приемки по типам
+type ProductCounts map[string]int
+
+// ProductDimensions Габариты товара в сантиметрах, каждая сторона не более 10 м
+type ProductDimensions struct {
+	Height int `json:"height"`
+	Length int `json:"length"`
+	Width  int `json:"width"`
+}
+
+// ProductLocation Местонахождение товара
+type ProductLocation struct {
+	// Cell Ячейка хранения ПВЗ (стеллаж, полка, ячейка)
+	Cell      *StorageCell       `json:"cell,omitempty"`
+	ProductId openapi_types.UUID `json:"productId"`
+
+	// PvzId Текущий ПВЗ товара, после получения перемещения - ПВЗ назначения
+	PvzId openapi_types.UUID `json:"pvzId"`
+
+	// ReceptionId Приемка поставки товара, при перемещении не меняется
+	ReceptionId openapi_types.UUID `json:"receptionId"`
+
+	// Status Статус товара, товары на руках ПВЗ имеют статусы received и stored, возвращенные получателем товары ждут отправки в статусе returned
+	Status ProductStatus `json:"status"`
+}
+
+// ProductPatch Исправление товара, атрибуты проверяются по схеме итогового типа
+type ProductPatch struct {
+	// Attributes Атрибуты товара, проверяемые по схеме его типа
+	Attributes *ProductAttributes `json:"attributes,omitempty"`
+	Type       *string            `json:"type,omitempty"`
+}
+
+// ProductStatus Статус товара, товары на руках ПВЗ имеют статусы received и stored, возвращенные получателем товары ждут отправки в статусе returned
+type ProductStatus string
+
+// ProductType defines model for ProductType.
+type ProductType struct {
+	// AttributesSchema JSON Schema атрибутов товаров этого типа
+	AttributesSchema map[string]interface{} `json:"attributesSchema"`
+	DateTime         *time.Time             `json:"dateTime,omitempty"`
+	Name             string                 `json:"name"`
+}
+
+// ProductivityReport Отчет о скорости приемок по сотрудникам и ПВЗ для приемок, закрытых в диапазоне [startDate, endDate)
+type ProductivityReport struct {
+	EndDate        time.Time               `json:"endDate"`
+	GeneratedAt    time.Time               `json:"generatedAt"`
+	IdleGapMinutes int                     `json:"idleGapMinutes"`
+	Rows           []ProductivityReportRow `json:"rows"`
+	SlaMinutes     int                     `json:"slaMinutes"`
+	StartDate      time.Time               `json:"startDate"`
+}
+
+// ProductivityReportRow Показатели закрытых приемок ПВЗ, открытых одним сотрудником
+type ProductivityReportRow struct {
+	AvgDurationSeconds int `json:"avgDurationSeconds"`
+
+	// EmployeeId Сотрудник, открывший приемки, не заполняется для приемок без автора
+	EmployeeId *openapi_types.UUID `json:"employeeId,omitempty"`
+
+	// IdleGaps Количество простоев - промежутков между открытием, сканированиями и закрытием длиннее idleGapMinutes
+	IdleGaps int `json:"idleGaps"`
+
+	// IdleSeconds Суммарная длительность простоев
+	IdleSeconds int `json:"idleSeconds"`
+
+	// ItemsPerMinute Товаров в минуту за суммарную длительность приемок
+	ItemsPerMinute     float32 `json:"itemsPerMinute"`
+	MaxDurationSeconds int     `json:"maxDurationSeconds"`
+
+	// MaxIdleGapSeconds Самый длинный промежуток между событиями приемки
+	MaxIdleGapSeconds int `json:"maxIdleGapSeconds"`
+
+	// Products Количество отсканированных товаров без удаленных
+	Products int                `json:"products"`
+	PvzId    openapi_types.UUID `json:"pvzId"`
+
+	// Receptions Количество закрытых приемок
+	Receptions int `json:"receptions"`
+
+	// SlaBreaches Количество приемок длительностью больше slaMinutes
+	SlaBreaches int `json:"slaBreaches"`
+
+	// TotalDurationSeconds Суммарная длительность приемок от открытия до закрытия
+	TotalDurationSeconds int `json:"totalDurationSeconds"`
 }
 
-// ProductType defines model for Product.Type.
-type ProductType string
+// PvzCapacity defines model for PvzCapacity.
+type PvzCapacity struct {
+	Cells []CellCapacity `json:"cells"`
+
+	// Limits Ограничения ПВЗ по весу (граммы) и объему (кубические сантиметры), отсутствующий лимит не проверяется
+	Limits PvzLimits          `json:"limits"`
+	PvzId  openapi_types.UUID `json:"pvzId"`
+
+	// Used Занятые вес (граммы) и объем (кубические сантиметры) товарами на руках ПВЗ
+	Used CapacityUsage `json:"used"`
+}
+
+// PvzField Раздел элемента списка ПВЗ
+type PvzField string
+
+// PvzInclude Глубина данных приемок в списке ПВЗ:
+// none - без приемок, receptions и counts - приемки без товаров с количеством товаров по типам,
+// products - приемки с товарами
+type PvzInclude string
+
+// PvzLimits Ограничения ПВЗ по весу (граммы) и объему (кубические сантиметры), отсутствующий лимит не проверяется
+type PvzLimits struct {
+	MaxItemVolume  *int `json:"maxItemVolume,omitempty"`
+	MaxItemWeight  *int `json:"maxItemWeight,omitempty"`
+	MaxTotalVolume *int `json:"maxTotalVolume,omitempty"`
+	MaxTotalWeight *int `json:"maxTotalWeight,omitempty"`
+
+	// OnExceed reject - товар сверх лимита не принимается, warn - принимается с предупреждением
+	OnExceed PvzLimitsOnExceed `json:"onExceed"`
+}
+
+// PvzLimitsOnExceed reject - товар сверх лимита не принимается, warn - принимается с предупреждением
+type PvzLimitsOnExceed string
+
+// PvzStock defines model for PvzStock.
+type PvzStock struct {
+	Limit int `json:"limit"`
+	Page  int `json:"page"`
+
+	// ProductCounts Количество неаннулированных товаров приемки по типам
+	ProductCounts ProductCounts      `json:"productCounts"`
+	Products      []Product          `json:"products"`
+	PvzId         openapi_types.UUID `json:"pvzId"`
+
+	// TotalProducts Общее количество товаров на руках ПВЗ
+	TotalProducts int `json:"totalProducts"`
+}
+
+// ReasonRequest defines model for ReasonRequest.
+type ReasonRequest struct {
+	Reason string `json:"reason"`
+}
 
 // Reception defines model for Reception.
 type Reception struct {
-	DateTime time.Time           `json:"dateTime"`
-	Id       *openapi_types.UUID `json:"id,omitempty"`
-	PvzId    openapi_types.UUID  `json:"pvzId"`
-	Status   ReceptionStatus     `json:"status"`
+	// ClosedBy Пользователь, закрывший приемку
+	ClosedBy *openapi_types.UUID `json:"closedBy,omitempty"`
+
+	// CreatedBy Пользователь, создавший приемку
+	CreatedBy *openapi_types.UUID `json:"createdBy,omitempty"`
+	DateTime  time.Time           `json:"dateTime"`
+	Id        *openapi_types.UUID `json:"id,omitempty"`
+	PvzId     openapi_types.UUID  `json:"pvzId"`
+
+	// StaleAt Время, когда приемка была помечена как зависшая фоновым обработчиком
+	StaleAt *time.Time      `json:"staleAt,omitempty"`
+	Status  ReceptionStatus `json:"status"`
+
+	// Type Приемка поставки или приемка товаров, перемещенных из другого ПВЗ
+	Type *ReceptionType `json:"type,omitempty"`
+}
+
+// ReceptionDetail defines model for ReceptionDetail.
+type ReceptionDetail struct {
+	Limit int `json:"limit"`
+	Page  int `json:"page"`
+
+	// ProductCounts Количество неаннулированных товаров приемки по типам
+	ProductCounts ProductCounts `json:"productCounts"`
+	Products      []Product     `json:"products"`
+	Reception     Reception     `json:"reception"`
+
+	// TotalProducts Общее количество товаров приемки, включая аннулированные
+	TotalProducts int `json:"totalProducts"`
 }
 
-// ReceptionStatus defines model for Reception.Status.
+// ReceptionStatsRow Строка статистики приемок, измерения вне группировки не заполняются
+type ReceptionStatsRow struct {
+	City *string `json:"city,omitempty"`
+
+	// PeriodStart Начало дня, недели (с понедельника) или месяца
+	PeriodStart *time.Time `json:"periodStart,omitempty"`
+	ProductType *string    `json:"productType,omitempty"`
+
+	// Products Количество принятых товаров без удаленных и аннулированных
+	Products int                 `json:"products"`
+	PvzId    *openapi_types.UUID `json:"pvzId,omitempty"`
+
+	// Receptions Количество закрытых приемок
+	Receptions int `json:"receptions"`
+}
+
+// ReceptionStatus defines model for ReceptionStatus.
 type ReceptionStatus string
 
+// ReceptionStatusChange defines model for ReceptionStatusChange.
+type ReceptionStatusChange struct {
+	ActorId     openapi_types.UUID  `json:"actorId"`
+	ActorRole   string              `json:"actorRole"`
+	DateTime    time.Time           `json:"dateTime"`
+	FromStatus  *ReceptionStatus    `json:"fromStatus,omitempty"`
+	Id          *openapi_types.UUID `json:"id,omitempty"`
+	Reason      *string             `json:"reason,omitempty"`
+	ReceptionId openapi_types.UUID  `json:"receptionId"`
+	ToStatus    ReceptionStatus     `json:"toStatus"`
+}
+
+// ReceptionSummary defines model for ReceptionSummary.
+type ReceptionSummary struct {
+	// ProductCounts Количество неаннулированных товаров приемки по типам
+	ProductCounts ProductCounts `json:"productCounts"`
+	Reception     Reception     `json:"reception"`
+}
+
+// ReceptionType Приемка поставки или приемка товаров, перемещенных из другого ПВЗ
+type ReceptionType string
+
+// Return defines model for Return.
+type Return struct {
+	Comment *string `json:"comment,omitempty"`
+
+	// Condition Состояние возвращенного товара
+	Condition ReturnCondition     `json:"condition"`
+	CreatedBy *openapi_types.UUID `json:"createdBy,omitempty"`
+	DateTime  *time.Time          `json:"dateTime,omitempty"`
+	Id        *openapi_types.UUID `json:"id,omitempty"`
+	ProductId openapi_types.UUID  `json:"productId"`
+
+	// ReasonCode Причина возврата или отказа от товара
+	ReasonCode ReturnReasonCode   `json:"reasonCode"`
+	ShipmentId openapi_types.UUID `json:"shipmentId"`
+}
+
+// ReturnCondition Состояние возвращенного товара
+type ReturnCondition string
+
+// ReturnReasonCode Причина возврата или отказа от товара
+type ReturnReasonCode string
+
+// ReturnShipment Исходящая отправка возвратов, в ПВЗ может быть открыта только одна
+type ReturnShipment struct {
+	ClosedAt  *time.Time           `json:"closedAt,omitempty"`
+	ClosedBy  *openapi_types.UUID  `json:"closedBy,omitempty"`
+	CreatedBy *openapi_types.UUID  `json:"createdBy,omitempty"`
+	DateTime  *time.Time           `json:"dateTime,omitempty"`
+	Id        *openapi_types.UUID  `json:"id,omitempty"`
+	PvzId     openapi_types.UUID   `json:"pvzId"`
+	Returns   *[]Return            `json:"returns,omitempty"`
+	Status    ReturnShipmentStatus `json:"status"`
+}
+
+// ReturnShipmentStatus defines model for ReturnShipment.Status.
+type ReturnShipmentStatus string
+
+// StatsDimension Измерение группировки статистики
+type StatsDimension string
+
+// StatsPeriod Период группировки статистики по дате создания приемки
+type StatsPeriod string
+
+// StorageCell Ячейка хранения ПВЗ (стеллаж, полка, ячейка)
+type StorageCell struct {
+	// Capacity Вместимость ячейки в товарах
+	Capacity int    `json:"capacity"`
+	Cell     string `json:"cell"`
+
+	// Code Код ячейки вида СТЕЛЛАЖ-ПОЛКА-ЯЧЕЙКА
+	Code     *string             `json:"code,omitempty"`
+	DateTime *time.Time          `json:"dateTime,omitempty"`
+	Id       *openapi_types.UUID `json:"id,omitempty"`
+
+	// Occupied Количество товаров на руках ПВЗ в ячейке
+	Occupied *int               `json:"occupied,omitempty"`
+	PvzId    openapi_types.UUID `json:"pvzId"`
+	Rack     string             `json:"rack"`
+	Shelf    string             `json:"shelf"`
+}
+
 // Token defines model for Token.
 type Token = string
 
+// Transfer Перемещение товаров между ПВЗ
+type Transfer struct {
+	CreatedBy        *openapi_types.UUID  `json:"createdBy,omitempty"`
+	DateTime         *time.Time           `json:"dateTime,omitempty"`
+	DestinationPvzId openapi_types.UUID   `json:"destinationPvzId"`
+	DispatchedAt     *time.Time           `json:"dispatchedAt,omitempty"`
+	Id               *openapi_types.UUID  `json:"id,omitempty"`
+	ProductIds       []openapi_types.UUID `json:"productIds"`
+	ReceivedAt       *time.Time           `json:"receivedAt,omitempty"`
+
+	// ReceptionId Приемка в ПВЗ назначения, через которую получены товары
+	ReceptionId *openapi_types.UUID `json:"receptionId,omitempty"`
+	SourcePvzId openapi_types.UUID  `json:"sourcePvzId"`
+	Status      TransferStatus      `json:"status"`
+}
+
+// TransferStatus defines model for Transfer.Status.
+type TransferStatus string
+
 // User defines model for User.
 type User struct {
 	Email openapi_types.Email `json:"email"`
@@ -130,20 +717,86 @@ type PostDummyLoginJSONBody struct {
 // PostDummyLoginJSONBodyRole defines parameters for PostDummyLogin.
 type PostDummyLoginJSONBodyRole string
 
+// GetExportPvzParams defines parameters for GetExportPvz.
+type GetExportPvzParams struct {
+	// StartDate Начальная дата диапазона
+	StartDate *time.Time `form:"startDate,omitempty" json:"startDate,omitempty"`
+
+	// EndDate Конечная дата диапазона
+	EndDate *time.Time    `form:"endDate,omitempty" json:"endDate,omitempty"`
+	Format  *ExportFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetExportReceptionsParams defines parameters for GetExportReceptions.
+type GetExportReceptionsParams struct {
+	PvzId  *openapi_types.UUID `form:"pvzId,omitempty" json:"pvzId,omitempty"`
+	Status *ReceptionStatus    `form:"status,omitempty" json:"status,omitempty"`
+
+	// CreatedBy Идентификатор сотрудника, создавшего приемку
+	CreatedBy *openapi_types.UUID `form:"createdBy,omitempty" json:"createdBy,omitempty"`
+
+	// StartDate Начальная дата диапазона
+	StartDate *time.Time `form:"startDate,omitempty" json:"startDate,omitempty"`
+
+	// EndDate Конечная дата диапазона
+	EndDate *time.Time    `form:"endDate,omitempty" json:"endDate,omitempty"`
+	Format  *ExportFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
 // PostLoginJSONBody defines parameters for PostLogin.
 type PostLoginJSONBody struct {
 	Email    openapi_types.Email `json:"email"`
 	Password string              `json:"password"`
 }
 
+// PostManifestsJSONBody defines parameters for PostManifests.
+type PostManifestsJSONBody struct {
+	Items    []ManifestItem     `json:"items"`
+	PvzId    openapi_types.UUID `json:"pvzId"`
+	Strict   *bool              `json:"strict,omitempty"`
+	Supplier string             `json:"supplier"`
+}
+
+// PostOrdersJSONBody defines parameters for PostOrders.
+type PostOrdersJSONBody struct {
+	Barcodes       []string             `json:"barcodes"`
+	Number         string               `json:"number"`
+	PvzId          openapi_types.UUID   `json:"pvzId"`
+	RecipientEmail *openapi_types.Email `json:"recipientEmail,omitempty"`
+	RecipientPhone *string              `json:"recipientPhone,omitempty"`
+}
+
+// PutProductTypesTypeNameJSONBody defines parameters for PutProductTypesTypeName.
+type PutProductTypesTypeNameJSONBody struct {
+	// AttributesSchema JSON Schema атрибутов товаров этого типа
+	AttributesSchema map[string]interface{} `json:"attributesSchema"`
+}
+
 // PostProductsJSONBody defines parameters for PostProducts.
 type PostProductsJSONBody struct {
-	PvzId openapi_types.UUID       `json:"pvzId"`
-	Type  PostProductsJSONBodyType `json:"type"`
+	// Attributes Атрибуты товара, проверяемые по схеме его типа
+	Attributes *ProductAttributes `json:"attributes,omitempty"`
+	Barcode    *string            `json:"barcode,omitempty"`
+
+	// Dimensions Габариты товара в сантиметрах, каждая сторона не более 10 м
+	Dimensions *ProductDimensions `json:"dimensions,omitempty"`
+	PvzId      openapi_types.UUID `json:"pvzId"`
+	Type       string             `json:"type"`
+
+	// Weight Вес товара в граммах, не более 1 т
+	Weight *int `json:"weight,omitempty"`
+}
+
+// PostProductsBatchJSONBody defines parameters for PostProductsBatch.
+type PostProductsBatchJSONBody struct {
+	Items []ProductBatchItem `json:"items"`
+	PvzId openapi_types.UUID `json:"pvzId"`
 }
 
-// PostProductsJSONBodyType defines parameters for PostProducts.
-type PostProductsJSONBodyType string
+// PostProductsProductIdMoveJSONBody defines parameters for PostProductsProductIdMove.
+type PostProductsProductIdMoveJSONBody struct {
+	CellId openapi_types.UUID `json:"cellId"`
+}
 
 // GetPvzParams defines parameters for GetPvz.
 type GetPvzParams struct {
@@ -158,11 +811,72 @@ type GetPvzParams struct {
 
 	// Limit Количество элементов на странице
 	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Include Какие данные приемок включать в ответ, по умолчанию products
+	Include *PvzInclude `form:"include,omitempty" json:"include,omitempty"`
+
+	// Fields Разделы элемента ответа через запятую, по умолчанию все
+	Fields *[]PvzField `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// PostPvzPvzIdCellsJSONBody defines parameters for PostPvzPvzIdCells.
+type PostPvzPvzIdCellsJSONBody struct {
+	Capacity int    `json:"capacity"`
+	Cell     string `json:"cell"`
+	Rack     string `json:"rack"`
+	Shelf    string `json:"shelf"`
+}
+
+// GetPvzPvzIdStockParams defines parameters for GetPvzPvzIdStock.
+type GetPvzPvzIdStockParams struct {
+	Page  *int `form:"page,omitempty" json:"page,omitempty"`
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetReceptionsParams defines parameters for GetReceptions.
+type GetReceptionsParams struct {
+	PvzId  *openapi_types.UUID `form:"pvzId,omitempty" json:"pvzId,omitempty"`
+	Status *ReceptionStatus    `form:"status,omitempty" json:"status,omitempty"`
+
+	// CreatedBy Идентификатор сотрудника, создавшего приемку
+	CreatedBy *openapi_types.UUID `form:"createdBy,omitempty" json:"createdBy,omitempty"`
+
+	// StartDate Начальная дата диапазона
+	StartDate *time.Time `form:"startDate,omitempty" json:"startDate,omitempty"`
+
+	// EndDate Конечная дата диапазона
+	EndDate *time.Time `form:"endDate,omitempty" json:"endDate,omitempty"`
+
+	// Page Номер страницы
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Количество элементов на странице
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
 // PostReceptionsJSONBody defines parameters for PostReceptions.
 type PostReceptionsJSONBody struct {
 	PvzId openapi_types.UUID `json:"pvzId"`
+
+	// Status Начальный статус приемки
+	Status *PostReceptionsJSONBodyStatus `json:"status,omitempty"`
+}
+
+// PostReceptionsJSONBodyStatus defines parameters for PostReceptions.
+type PostReceptionsJSONBodyStatus string
+
+// GetReceptionsReceptionIdParams defines parameters for GetReceptionsReceptionId.
+type GetReceptionsReceptionIdParams struct {
+	// Page Номер страницы товаров
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Количество товаров на странице
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PostReceptionsReceptionIdVerifyJSONBody defines parameters for PostReceptionsReceptionIdVerify.
+type PostReceptionsReceptionIdVerifyJSONBody struct {
+	Reason *string `json:"reason,omitempty"`
 }
 
 // PostRegisterJSONBody defines parameters for PostRegister.
@@ -179,77 +893,488 @@ type PostRegisterJSONBody struct {
 // PostRegisterJSONBodyRole defines parameters for PostRegister.
 type PostRegisterJSONBodyRole string
 
-// PostDummyLoginJSONRequestBody defines body for PostDummyLogin for application/json ContentType.
-type PostDummyLoginJSONRequestBody PostDummyLoginJSONBody
+// GetReportsProductivityParams defines parameters for GetReportsProductivity.
+type GetReportsProductivityParams struct {
+	// StartDate Начало диапазона закрытия приемок, включительно
+	StartDate *time.Time `form:"startDate,omitempty" json:"startDate,omitempty"`
 
-// PostLoginJSONRequestBody defines body for PostLogin for application/json ContentType.
-type PostLoginJSONRequestBody PostLoginJSONBody
+	// EndDate Конец диапазона закрытия приемок, не включительно
+	EndDate *time.Time          `form:"endDate,omitempty" json:"endDate,omitempty"`
+	PvzId   *openapi_types.UUID `form:"pvzId,omitempty" json:"pvzId,omitempty"`
 
-// PostProductsJSONRequestBody defines body for PostProducts for application/json ContentType.
-type PostProductsJSONRequestBody PostProductsJSONBody
+	// SlaMinutes Допустимая длительность приемки
+	SlaMinutes *int `form:"slaMinutes,omitempty" json:"slaMinutes,omitempty"`
 
-// PostPvzJSONRequestBody defines body for PostPvz for application/json ContentType.
-type PostPvzJSONRequestBody = PVZ
+	// IdleGapMinutes Промежуток между событиями приемки, начиная с которого он считается простоем
+	IdleGapMinutes *int `form:"idleGapMinutes,omitempty" json:"idleGapMinutes,omitempty"`
+}
 
-// PostReceptionsJSONRequestBody defines body for PostReceptions for application/json ContentType.
-type PostReceptionsJSONRequestBody PostReceptionsJSONBody
+// PostReturnShipmentsJSONBody defines parameters for PostReturnShipments.
+type PostReturnShipmentsJSONBody struct {
+	PvzId openapi_types.UUID `json:"pvzId"`
+}
 
-// PostRegisterJSONRequestBody defines body for PostRegister for application/json ContentType.
-type PostRegisterJSONRequestBody PostRegisterJSONBody
+// PostReturnsJSONBody defines parameters for PostReturns.
+type PostReturnsJSONBody struct {
+	Comment *string `json:"comment,omitempty"`
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
+	// Condition Состояние возвращенного товара
+	Condition ReturnCondition    `json:"condition"`
+	ProductId openapi_types.UUID `json:"productId"`
+	PvzId     openapi_types.UUID `json:"pvzId"`
+
+	// ReasonCode Причина возврата или отказа от товара
+	ReasonCode ReturnReasonCode `json:"reasonCode"`
+}
+
+// GetStatsReceptionsParams defines parameters for GetStatsReceptions.
+type GetStatsReceptionsParams struct {
+	// GroupBy Измерения группировки через запятую
+	GroupBy *[]StatsDimension `form:"groupBy,omitempty" json:"groupBy,omitempty"`
+	Period  *StatsPeriod      `form:"period,omitempty" json:"period,omitempty"`
+
+	// StartDate Начальная дата диапазона, учитываются сутки UTC целиком
+	StartDate *time.Time `form:"startDate,omitempty" json:"startDate,omitempty"`
+
+	// EndDate Конечная дата диапазона, учитываются сутки UTC целиком
+	EndDate *time.Time `form:"endDate,omitempty" json:"endDate,omitempty"`
+}
+
+// PostTransfersJSONBody defines parameters for PostTransfers.
+type PostTransfersJSONBody struct {
+	DestinationPvzId openapi_types.UUID   `json:"destinationPvzId"`
+	ProductIds       []openapi_types.UUID `json:"productIds"`
+	SourcePvzId      openapi_types.UUID   `json:"sourcePvzId"`
+}
+
+// GetTransfersInTransitParams defines parameters for GetTransfersInTransit.
+type GetTransfersInTransitParams struct {
+	// PvzId ПВЗ отправления или назначения
+	PvzId *openapi_types.UUID `form:"pvzId,omitempty" json:"pvzId,omitempty"`
+}
+
+// PostDummyLoginJSONRequestBody defines body for PostDummyLogin for application/json ContentType.
+type PostDummyLoginJSONRequestBody PostDummyLoginJSONBody
+
+// PostLoginJSONRequestBody defines body for PostLogin for application/json ContentType.
+type PostLoginJSONRequestBody PostLoginJSONBody
+
+// PostManifestsJSONRequestBody defines body for PostManifests for application/json ContentType.
+type PostManifestsJSONRequestBody PostManifestsJSONBody
+
+// PostOrdersJSONRequestBody defines body for PostOrders for application/json ContentType.
+type PostOrdersJSONRequestBody PostOrdersJSONBody
+
+// PostOrdersOrderIdIssueJSONRequestBody defines body for PostOrdersOrderIdIssue for application/json ContentType.
+type PostOrdersOrderIdIssueJSONRequestBody = PickupCode
+
+// PostProductTypesJSONRequestBody defines body for PostProductTypes for application/json ContentType.
+type PostProductTypesJSONRequestBody = ProductType
+
+// PutProductTypesTypeNameJSONRequestBody defines body for PutProductTypesTypeName for application/json ContentType.
+type PutProductTypesTypeNameJSONRequestBody PutProductTypesTypeNameJSONBody
+
+// PostProductsJSONRequestBody defines body for PostProducts for application/json ContentType.
+type PostProductsJSONRequestBody PostProductsJSONBody
+
+// PostProductsBatchJSONRequestBody defines body for PostProductsBatch for application/json ContentType.
+type PostProductsBatchJSONRequestBody PostProductsBatchJSONBody
+
+// DeleteProductsProductIdJSONRequestBody defines body for DeleteProductsProductId for application/json ContentType.
+type DeleteProductsProductIdJSONRequestBody = ReasonRequest
+
+// PatchProductsProductIdJSONRequestBody defines body for PatchProductsProductId for application/json ContentType.
+type PatchProductsProductIdJSONRequestBody = ProductPatch
+
+// PostProductsProductIdAttachmentsMultipartRequestBody defines body for PostProductsProductIdAttachments for multipart/form-data ContentType.
+type PostProductsProductIdAttachmentsMultipartRequestBody = AttachmentUpload
+
+// PostProductsProductIdIssueJSONRequestBody defines body for PostProductsProductIdIssue for application/json ContentType.
+type PostProductsProductIdIssueJSONRequestBody = PickupCode
+
+// PostProductsProductIdMoveJSONRequestBody defines body for PostProductsProductIdMove for application/json ContentType.
+type PostProductsProductIdMoveJSONRequestBody PostProductsProductIdMoveJSONBody
+
+// PostProductsProductIdReturnToSenderJSONRequestBody defines body for PostProductsProductIdReturnToSender for application/json ContentType.
+type PostProductsProductIdReturnToSenderJSONRequestBody = ReasonRequest
+
+// PostPvzJSONRequestBody defines body for PostPvz for application/json ContentType.
+type PostPvzJSONRequestBody = PVZ
+
+// PostPvzPvzIdCellsJSONRequestBody defines body for PostPvzPvzIdCells for application/json ContentType.
+type PostPvzPvzIdCellsJSONRequestBody PostPvzPvzIdCellsJSONBody
+
+// PutPvzPvzIdLimitsJSONRequestBody defines body for PutPvzPvzIdLimits for application/json ContentType.
+type PutPvzPvzIdLimitsJSONRequestBody = PvzLimits
+
+// PostReceptionsJSONRequestBody defines body for PostReceptions for application/json ContentType.
+type PostReceptionsJSONRequestBody PostReceptionsJSONBody
+
+// PostReceptionsReceptionIdAttachmentsMultipartRequestBody defines body for PostReceptionsReceptionIdAttachments for multipart/form-data ContentType.
+type PostReceptionsReceptionIdAttachmentsMultipartRequestBody = AttachmentUpload
+
+// PostReceptionsReceptionIdCancelJSONRequestBody defines body for PostReceptionsReceptionIdCancel for application/json ContentType.
+type PostReceptionsReceptionIdCancelJSONRequestBody = ReasonRequest
+
+// PostReceptionsReceptionIdCloseWithOverrideJSONRequestBody defines body for PostReceptionsReceptionIdCloseWithOverride for application/json ContentType.
+type PostReceptionsReceptionIdCloseWithOverrideJSONRequestBody = ReasonRequest
+
+// PostReceptionsReceptionIdReopenJSONRequestBody defines body for PostReceptionsReceptionIdReopen for application/json ContentType.
+type PostReceptionsReceptionIdReopenJSONRequestBody = ReasonRequest
+
+// PostReceptionsReceptionIdVerifyJSONRequestBody defines body for PostReceptionsReceptionIdVerify for application/json ContentType.
+type PostReceptionsReceptionIdVerifyJSONRequestBody PostReceptionsReceptionIdVerifyJSONBody
+
+// PostRegisterJSONRequestBody defines body for PostRegister for application/json ContentType.
+type PostRegisterJSONRequestBody PostRegisterJSONBody
+
+// PostReturnShipmentsJSONRequestBody defines body for PostReturnShipments for application/json ContentType.
+type PostReturnShipmentsJSONRequestBody PostReturnShipmentsJSONBody
+
+// PostReturnsJSONRequestBody defines body for PostReturns for application/json ContentType.
+type PostReturnsJSONRequestBody PostReturnsJSONBody
+
+// PostTransfersJSONRequestBody defines body for PostTransfers for application/json ContentType.
+type PostTransfersJSONRequestBody PostTransfersJSONBody
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Скачивание вложения (для всех ролей)
+	// (GET /attachments/{attachmentId})
+	GetAttachmentsAttachmentId(w http.ResponseWriter, r *http.Request, attachmentId openapi_types.UUID)
 	// Получение тестового токена
 	// (POST /dummyLogin)
 	PostDummyLogin(w http.ResponseWriter, r *http.Request)
+	// Выгрузка ПВЗ с приемками и товарами в CSV или XLSX (для всех ролей)
+	// (GET /export/pvz)
+	GetExportPvz(w http.ResponseWriter, r *http.Request, params GetExportPvzParams)
+	// Выгрузка списка приемок в CSV или XLSX (для всех ролей)
+	// (GET /export/receptions)
+	GetExportReceptions(w http.ResponseWriter, r *http.Request, params GetExportReceptionsParams)
 	// Авторизация пользователя
 	// (POST /login)
 	PostLogin(w http.ResponseWriter, r *http.Request)
+	// Регистрация ожидаемой поставки в ПВЗ (только для модераторов)
+	// (POST /manifests)
+	PostManifests(w http.ResponseWriter, r *http.Request)
+	// Получение манифеста поставки (для всех ролей)
+	// (GET /manifests/{manifestId})
+	GetManifestsManifestId(w http.ResponseWriter, r *http.Request, manifestId openapi_types.UUID)
+	// Регистрация заказа покупателя в ПВЗ (только для модераторов)
+	// (POST /orders)
+	PostOrders(w http.ResponseWriter, r *http.Request)
+	// Получение заказа покупателя (для всех ролей)
+	// (GET /orders/{orderId})
+	GetOrdersOrderId(w http.ResponseWriter, r *http.Request, orderId openapi_types.UUID)
+	// Выдача заказа по одноразовому коду (только для сотрудников ПВЗ)
+	// (POST /orders/{orderId}/issue)
+	PostOrdersOrderIdIssue(w http.ResponseWriter, r *http.Request, orderId openapi_types.UUID)
+	// Выпуск нового кода выдачи заказа со сбросом попыток (только для модераторов)
+	// (POST /orders/{orderId}/pickup_code)
+	PostOrdersOrderIdPickupCode(w http.ResponseWriter, r *http.Request, orderId openapi_types.UUID)
+	// Справочник типов товаров (для всех ролей)
+	// (GET /product_types)
+	GetProductTypes(w http.ResponseWriter, r *http.Request)
+	// Добавление типа товаров в справочник (только для модераторов)
+	// (POST /product_types)
+	PostProductTypes(w http.ResponseWriter, r *http.Request)
+	// Изменение схемы атрибутов типа товаров (только для модераторов)
+	// (PUT /product_types/{typeName})
+	PutProductTypesTypeName(w http.ResponseWriter, r *http.Request, typeName string)
 	// Добавление товара в текущую приемку (только для сотрудников ПВЗ)
 	// (POST /products)
 	PostProducts(w http.ResponseWriter, r *http.Request)
+	// Пакетное добавление товаров в текущую приемку (только для сотрудников ПВЗ)
+	// (POST /products/batch)
+	PostProductsBatch(w http.ResponseWriter, r *http.Request)
+	// Удаление товара из приемки в работе с указанием причины (только для сотрудников ПВЗ)
+	// (DELETE /products/{productId})
+	DeleteProductsProductId(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID)
+	// Исправление типа или атрибутов товара в приемке в работе (только для сотрудников ПВЗ)
+	// (PATCH /products/{productId})
+	PatchProductsProductId(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID)
+	// Вложения товара (для всех ролей)
+	// (GET /products/{productId}/attachments)
+	GetProductsProductIdAttachments(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID)
+	// Загрузка вложения товара (для всех ролей)
+	// (POST /products/{productId}/attachments)
+	PostProductsProductIdAttachments(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID)
+	// Выдача товара получателю по коду выдачи (только для сотрудников ПВЗ)
+	// (POST /products/{productId}/issue)
+	PostProductsProductIdIssue(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID)
+	// Местонахождение товара (для всех ролей)
+	// (GET /products/{productId}/location)
+	GetProductsProductIdLocation(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID)
+	// Перемещение товара в другую ячейку хранения ПВЗ (только для сотрудников ПВЗ)
+	// (POST /products/{productId}/move)
+	PostProductsProductIdMove(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID)
+	// Выпуск кода выдачи товара для передачи получателю со сбросом попыток (только для модераторов)
+	// (POST /products/{productId}/pickup_code)
+	PostProductsProductIdPickupCode(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID)
+	// Возврат товара отправителю (для сотрудников ПВЗ и модераторов)
+	// (POST /products/{productId}/return_to_sender)
+	PostProductsProductIdReturnToSender(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID)
 	// Получение списка ПВЗ с фильтрацией по дате приемки и пагинацией (для всех ролей)
 	// (GET /pvz)
 	GetPvz(w http.ResponseWriter, r *http.Request, params GetPvzParams)
 	// Создание ПВЗ (только для модераторов)
 	// (POST /pvz)
 	PostPvz(w http.ResponseWriter, r *http.Request)
+	// Занятые вес и объем ПВЗ и его ячеек хранения (для всех ролей)
+	// (GET /pvz/{pvzId}/capacity)
+	GetPvzPvzIdCapacity(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID)
+	// Ячейки хранения ПВЗ с заполненностью (для всех ролей)
+	// (GET /pvz/{pvzId}/cells)
+	GetPvzPvzIdCells(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID)
+	// Создание ячейки хранения в ПВЗ (только для модераторов)
+	// (POST /pvz/{pvzId}/cells)
+	PostPvzPvzIdCells(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID)
 	// Закрытие последней открытой приемки товаров в рамках ПВЗ (только для сотрудников ПВЗ)
 	// (POST /pvz/{pvzId}/close_last_reception)
 	PostPvzPvzIdCloseLastReception(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID)
+	// Закрытие открытой отправки возвратов ПВЗ, товары отправки возвращаются отправителю (только для сотрудников ПВЗ)
+	// (POST /pvz/{pvzId}/close_last_return_shipment)
+	PostPvzPvzIdCloseLastReturnShipment(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID)
 	// Удаление последнего добавленного товара из текущей приемки (LIFO, только для сотрудников ПВЗ)
 	// (POST /pvz/{pvzId}/delete_last_product)
 	PostPvzPvzIdDeleteLastProduct(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID)
+	// Установка ограничений ПВЗ по весу и объему товаров (только для модераторов)
+	// (PUT /pvz/{pvzId}/limits)
+	PutPvzPvzIdLimits(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID)
+	// Остатки ПВЗ - товары, которые еще не выданы и не возвращены (для всех ролей)
+	// (GET /pvz/{pvzId}/stock)
+	GetPvzPvzIdStock(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID, params GetPvzPvzIdStockParams)
+	// Получение списка приемок с фильтрацией и пагинацией (для всех ролей)
+	// (GET /receptions)
+	GetReceptions(w http.ResponseWriter, r *http.Request, params GetReceptionsParams)
 	// Создание новой приемки товаров (только для сотрудников ПВЗ)
 	// (POST /receptions)
 	PostReceptions(w http.ResponseWriter, r *http.Request)
+	// Получение приемки с постраничным списком товаров (для всех ролей)
+	// (GET /receptions/{receptionId})
+	GetReceptionsReceptionId(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID, params GetReceptionsReceptionIdParams)
+	// Вложения приемки (для всех ролей)
+	// (GET /receptions/{receptionId}/attachments)
+	GetReceptionsReceptionIdAttachments(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID)
+	// Загрузка вложения приемки (для всех ролей)
+	// (POST /receptions/{receptionId}/attachments)
+	PostReceptionsReceptionIdAttachments(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID)
+	// Отмена приемки с аннулированием её товаров (только для модераторов)
+	// (POST /receptions/{receptionId}/cancel)
+	PostReceptionsReceptionIdCancel(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID)
+	// Закрытие приемки с расхождениями по манифесту (только для модераторов)
+	// (POST /receptions/{receptionId}/close_with_override)
+	PostReceptionsReceptionIdCloseWithOverride(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID)
+	// Удаленные из приемки товары с автором и причиной удаления (только для модераторов)
+	// (GET /receptions/{receptionId}/deleted_products)
+	GetReceptionsReceptionIdDeletedProducts(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID)
+	// Отчет о расхождениях приемки с манифестами (для всех ролей)
+	// (GET /receptions/{receptionId}/discrepancy)
+	GetReceptionsReceptionIdDiscrepancy(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID)
+	// История смены статусов приемки (для всех ролей)
+	// (GET /receptions/{receptionId}/history)
+	GetReceptionsReceptionIdHistory(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID)
+	// Повторное открытие закрытой приемки (только для модераторов)
+	// (POST /receptions/{receptionId}/reopen)
+	PostReceptionsReceptionIdReopen(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID)
+	// Перевод черновика приемки в работу (только для сотрудников ПВЗ)
+	// (POST /receptions/{receptionId}/start)
+	PostReceptionsReceptionIdStart(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID)
+	// Подтверждение закрытой приемки (только для модераторов)
+	// (POST /receptions/{receptionId}/verify)
+	PostReceptionsReceptionIdVerify(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID)
 	// Регистрация пользователя
 	// (POST /register)
 	PostRegister(w http.ResponseWriter, r *http.Request)
+	// Отчет о скорости приемок и нарушениях SLA по сотрудникам и ПВЗ (только для модераторов)
+	// (GET /reports/productivity)
+	GetReportsProductivity(w http.ResponseWriter, r *http.Request, params GetReportsProductivityParams)
+	// Сохраненный ежедневный отчет о скорости приемок (только для модераторов)
+	// (GET /reports/productivity/daily/{date})
+	GetReportsProductivityDailyDate(w http.ResponseWriter, r *http.Request, date openapi_types.Date)
+	// Открытие отправки возвратов в ПВЗ (только для сотрудников ПВЗ)
+	// (POST /return_shipments)
+	PostReturnShipments(w http.ResponseWriter, r *http.Request)
+	// Получение отправки возвратов вместе с возвратами (для всех ролей)
+	// (GET /return_shipments/{shipmentId})
+	GetReturnShipmentsShipmentId(w http.ResponseWriter, r *http.Request, shipmentId openapi_types.UUID)
+	// Оформление возврата или отказа от товара на стойке ПВЗ (только для сотрудников ПВЗ)
+	// (POST /returns)
+	PostReturns(w http.ResponseWriter, r *http.Request)
+	// Статистика приемок по ПВЗ, городам, типам товаров и периодам (только для модераторов)
+	// (GET /stats/receptions)
+	GetStatsReceptions(w http.ResponseWriter, r *http.Request, params GetStatsReceptionsParams)
+	// Создание перемещения товаров на хранении в другой ПВЗ (только для сотрудников ПВЗ)
+	// (POST /transfers)
+	PostTransfers(w http.ResponseWriter, r *http.Request)
+	// Перемещения в пути (только для модераторов)
+	// (GET /transfers/in_transit)
+	GetTransfersInTransit(w http.ResponseWriter, r *http.Request, params GetTransfersInTransitParams)
+	// Получение перемещения (для всех ролей)
+	// (GET /transfers/{transferId})
+	GetTransfersTransferId(w http.ResponseWriter, r *http.Request, transferId openapi_types.UUID)
+	// Отправка перемещения из ПВЗ отправления, товары переходят в статус in_transit (только для сотрудников ПВЗ)
+	// (POST /transfers/{transferId}/dispatch)
+	PostTransfersTransferIdDispatch(w http.ResponseWriter, r *http.Request, transferId openapi_types.UUID)
+	// Получение перемещения в ПВЗ назначения через приемку типа transfer (только для сотрудников ПВЗ)
+	// (POST /transfers/{transferId}/receive)
+	PostTransfersTransferIdReceive(w http.ResponseWriter, r *http.Request, transferId openapi_types.UUID)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
 type Unimplemented struct{}
 
+// Скачивание вложения (для всех ролей)
+// (GET /attachments/{attachmentId})
+func (_ Unimplemented) GetAttachmentsAttachmentId(w http.ResponseWriter, r *http.Request, attachmentId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Получение тестового токена
 // (POST /dummyLogin)
 func (_ Unimplemented) PostDummyLogin(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Выгрузка ПВЗ с приемками и товарами в CSV или XLSX (для всех ролей)
+// (GET /export/pvz)
+func (_ Unimplemented) GetExportPvz(w http.ResponseWriter, r *http.Request, params GetExportPvzParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Выгрузка списка приемок в CSV или XLSX (для всех ролей)
+// (GET /export/receptions)
+func (_ Unimplemented) GetExportReceptions(w http.ResponseWriter, r *http.Request, params GetExportReceptionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Авторизация пользователя
 // (POST /login)
 func (_ Unimplemented) PostLogin(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Регистрация ожидаемой поставки в ПВЗ (только для модераторов)
+// (POST /manifests)
+func (_ Unimplemented) PostManifests(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получение манифеста поставки (для всех ролей)
+// (GET /manifests/{manifestId})
+func (_ Unimplemented) GetManifestsManifestId(w http.ResponseWriter, r *http.Request, manifestId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Регистрация заказа покупателя в ПВЗ (только для модераторов)
+// (POST /orders)
+func (_ Unimplemented) PostOrders(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получение заказа покупателя (для всех ролей)
+// (GET /orders/{orderId})
+func (_ Unimplemented) GetOrdersOrderId(w http.ResponseWriter, r *http.Request, orderId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Выдача заказа по одноразовому коду (только для сотрудников ПВЗ)
+// (POST /orders/{orderId}/issue)
+func (_ Unimplemented) PostOrdersOrderIdIssue(w http.ResponseWriter, r *http.Request, orderId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Выпуск нового кода выдачи заказа со сбросом попыток (только для модераторов)
+// (POST /orders/{orderId}/pickup_code)
+func (_ Unimplemented) PostOrdersOrderIdPickupCode(w http.ResponseWriter, r *http.Request, orderId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Справочник типов товаров (для всех ролей)
+// (GET /product_types)
+func (_ Unimplemented) GetProductTypes(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Добавление типа товаров в справочник (только для модераторов)
+// (POST /product_types)
+func (_ Unimplemented) PostProductTypes(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Изменение схемы атрибутов типа товаров (только для модераторов)
+// (PUT /product_types/{typeName})
+func (_ Unimplemented) PutProductTypesTypeName(w http.ResponseWriter, r *http.Request, typeName string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Добавление товара в текущую приемку (только для сотрудников ПВЗ)
 // (POST /products)
 func (_ Unimplemented) PostProducts(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Пакетное добавление товаров в текущую приемку (только для сотрудников ПВЗ)
+// (POST /products/batch)
+func (_ Unimplemented) PostProductsBatch(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удаление товара из приемки в работе с указанием причины (только для сотрудников ПВЗ)
+// (DELETE /products/{productId})
+func (_ Unimplemented) DeleteProductsProductId(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Исправление типа или атрибутов товара в приемке в работе (только для сотрудников ПВЗ)
+// (PATCH /products/{productId})
+func (_ Unimplemented) PatchProductsProductId(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Вложения товара (для всех ролей)
+// (GET /products/{productId}/attachments)
+func (_ Unimplemented) GetProductsProductIdAttachments(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Загрузка вложения товара (для всех ролей)
+// (POST /products/{productId}/attachments)
+func (_ Unimplemented) PostProductsProductIdAttachments(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Выдача товара получателю по коду выдачи (только для сотрудников ПВЗ)
+// (POST /products/{productId}/issue)
+func (_ Unimplemented) PostProductsProductIdIssue(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Местонахождение товара (для всех ролей)
+// (GET /products/{productId}/location)
+func (_ Unimplemented) GetProductsProductIdLocation(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Перемещение товара в другую ячейку хранения ПВЗ (только для сотрудников ПВЗ)
+// (POST /products/{productId}/move)
+func (_ Unimplemented) PostProductsProductIdMove(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Выпуск кода выдачи товара для передачи получателю со сбросом попыток (только для модераторов)
+// (POST /products/{productId}/pickup_code)
+func (_ Unimplemented) PostProductsProductIdPickupCode(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Возврат товара отправителю (для сотрудников ПВЗ и модераторов)
+// (POST /products/{productId}/return_to_sender)
+func (_ Unimplemented) PostProductsProductIdReturnToSender(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Получение списка ПВЗ с фильтрацией по дате приемки и пагинацией (для всех ролей)
 // (GET /pvz)
 func (_ Unimplemented) GetPvz(w http.ResponseWriter, r *http.Request, params GetPvzParams) {
@@ -262,30 +1387,204 @@ func (_ Unimplemented) PostPvz(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Занятые вес и объем ПВЗ и его ячеек хранения (для всех ролей)
+// (GET /pvz/{pvzId}/capacity)
+func (_ Unimplemented) GetPvzPvzIdCapacity(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Ячейки хранения ПВЗ с заполненностью (для всех ролей)
+// (GET /pvz/{pvzId}/cells)
+func (_ Unimplemented) GetPvzPvzIdCells(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создание ячейки хранения в ПВЗ (только для модераторов)
+// (POST /pvz/{pvzId}/cells)
+func (_ Unimplemented) PostPvzPvzIdCells(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Закрытие последней открытой приемки товаров в рамках ПВЗ (только для сотрудников ПВЗ)
 // (POST /pvz/{pvzId}/close_last_reception)
 func (_ Unimplemented) PostPvzPvzIdCloseLastReception(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Закрытие открытой отправки возвратов ПВЗ, товары отправки возвращаются отправителю (только для сотрудников ПВЗ)
+// (POST /pvz/{pvzId}/close_last_return_shipment)
+func (_ Unimplemented) PostPvzPvzIdCloseLastReturnShipment(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Удаление последнего добавленного товара из текущей приемки (LIFO, только для сотрудников ПВЗ)
 // (POST /pvz/{pvzId}/delete_last_product)
 func (_ Unimplemented) PostPvzPvzIdDeleteLastProduct(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Установка ограничений ПВЗ по весу и объему товаров (только для модераторов)
+// (PUT /pvz/{pvzId}/limits)
+func (_ Unimplemented) PutPvzPvzIdLimits(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Остатки ПВЗ - товары, которые еще не выданы и не возвращены (для всех ролей)
+// (GET /pvz/{pvzId}/stock)
+func (_ Unimplemented) GetPvzPvzIdStock(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID, params GetPvzPvzIdStockParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получение списка приемок с фильтрацией и пагинацией (для всех ролей)
+// (GET /receptions)
+func (_ Unimplemented) GetReceptions(w http.ResponseWriter, r *http.Request, params GetReceptionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Создание новой приемки товаров (только для сотрудников ПВЗ)
 // (POST /receptions)
 func (_ Unimplemented) PostReceptions(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Получение приемки с постраничным списком товаров (для всех ролей)
+// (GET /receptions/{receptionId})
+func (_ Unimplemented) GetReceptionsReceptionId(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID, params GetReceptionsReceptionIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Вложения приемки (для всех ролей)
+// (GET /receptions/{receptionId}/attachments)
+func (_ Unimplemented) GetReceptionsReceptionIdAttachments(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Загрузка вложения приемки (для всех ролей)
+// (POST /receptions/{receptionId}/attachments)
+func (_ Unimplemented) PostReceptionsReceptionIdAttachments(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Отмена приемки с аннулированием её товаров (только для модераторов)
+// (POST /receptions/{receptionId}/cancel)
+func (_ Unimplemented) PostReceptionsReceptionIdCancel(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Закрытие приемки с расхождениями по манифесту (только для модераторов)
+// (POST /receptions/{receptionId}/close_with_override)
+func (_ Unimplemented) PostReceptionsReceptionIdCloseWithOverride(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удаленные из приемки товары с автором и причиной удаления (только для модераторов)
+// (GET /receptions/{receptionId}/deleted_products)
+func (_ Unimplemented) GetReceptionsReceptionIdDeletedProducts(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Отчет о расхождениях приемки с манифестами (для всех ролей)
+// (GET /receptions/{receptionId}/discrepancy)
+func (_ Unimplemented) GetReceptionsReceptionIdDiscrepancy(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// История смены статусов приемки (для всех ролей)
+// (GET /receptions/{receptionId}/history)
+func (_ Unimplemented) GetReceptionsReceptionIdHistory(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Повторное открытие закрытой приемки (только для модераторов)
+// (POST /receptions/{receptionId}/reopen)
+func (_ Unimplemented) PostReceptionsReceptionIdReopen(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Перевод черновика приемки в работу (только для сотрудников ПВЗ)
+// (POST /receptions/{receptionId}/start)
+func (_ Unimplemented) PostReceptionsReceptionIdStart(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Подтверждение закрытой приемки (только для модераторов)
+// (POST /receptions/{receptionId}/verify)
+func (_ Unimplemented) PostReceptionsReceptionIdVerify(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Регистрация пользователя
 // (POST /register)
 func (_ Unimplemented) PostRegister(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Отчет о скорости приемок и нарушениях SLA по сотрудникам и ПВЗ (только для модераторов)
+// (GET /reports/productivity)
+func (_ Unimplemented) GetReportsProductivity(w http.ResponseWriter, r *http.Request, params GetReportsProductivityParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Сохраненный ежедневный отчет о скорости приемок (только для модераторов)
+// (GET /reports/productivity/daily/{date})
+func (_ Unimplemented) GetReportsProductivityDailyDate(w http.ResponseWriter, r *http.Request, date openapi_types.Date) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Открытие отправки возвратов в ПВЗ (только для сотрудников ПВЗ)
+// (POST /return_shipments)
+func (_ Unimplemented) PostReturnShipments(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получение отправки возвратов вместе с возвратами (для всех ролей)
+// (GET /return_shipments/{shipmentId})
+func (_ Unimplemented) GetReturnShipmentsShipmentId(w http.ResponseWriter, r *http.Request, shipmentId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Оформление возврата или отказа от товара на стойке ПВЗ (только для сотрудников ПВЗ)
+// (POST /returns)
+func (_ Unimplemented) PostReturns(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Статистика приемок по ПВЗ, городам, типам товаров и периодам (только для модераторов)
+// (GET /stats/receptions)
+func (_ Unimplemented) GetStatsReceptions(w http.ResponseWriter, r *http.Request, params GetStatsReceptionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создание перемещения товаров на хранении в другой ПВЗ (только для сотрудников ПВЗ)
+// (POST /transfers)
+func (_ Unimplemented) PostTransfers(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Перемещения в пути (только для модераторов)
+// (GET /transfers/in_transit)
+func (_ Unimplemented) GetTransfersInTransit(w http.ResponseWriter, r *http.Request, params GetTransfersInTransitParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получение перемещения (для всех ролей)
+// (GET /transfers/{transferId})
+func (_ Unimplemented) GetTransfersTransferId(w http.ResponseWriter, r *http.Request, transferId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Отправка перемещения из ПВЗ отправления, товары переходят в статус in_transit (только для сотрудников ПВЗ)
+// (POST /transfers/{transferId}/dispatch)
+func (_ Unimplemented) PostTransfersTransferIdDispatch(w http.ResponseWriter, r *http.Request, transferId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получение перемещения в ПВЗ назначения через приемку типа transfer (только для сотрудников ПВЗ)
+// (POST /transfers/{transferId}/receive)
+func (_ Unimplemented) PostTransfersTransferIdReceive(w http.ResponseWriter, r *http.Request, transferId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -295,25 +1594,28 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
-// PostDummyLogin operation middleware
-func (siw *ServerInterfaceWrapper) PostDummyLogin(w http.ResponseWriter, r *http.Request) {
+// GetAttachmentsAttachmentId operation middleware
+func (siw *ServerInterfaceWrapper) GetAttachmentsAttachmentId(w http.ResponseWriter, r *http.Request) {
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostDummyLogin(w, r)
-	}))
+	var err error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	// ------------- Path parameter "attachmentId" -------------
+	var attachmentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "attachmentId", chi.URLParam(r, "attachmentId"), &attachmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "attachmentId", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	ctx := r.Context()
 
-// PostLogin operation middleware
-func (siw *ServerInterfaceWrapper) PostLogin(w http.ResponseWriter, r *http.Request) {
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostLogin(w, r)
+		siw.Handler.GetAttachmentsAttachmentId(w, r, attachmentId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -323,17 +1625,11 @@ func (siw *ServerInterfaceWrapper) PostLogin(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// PostProducts operation middleware
-func (siw *ServerInterfaceWrapper) PostProducts(w http.ResponseWriter, r *http.Request) {
-
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
-
-	r = r.WithContext(ctx)
+// PostDummyLogin operation middleware
+func (siw *ServerInterfaceWrapper) PostDummyLogin(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostProducts(w, r)
+		siw.Handler.PostDummyLogin(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -343,8 +1639,8 @@ func (siw *ServerInterfaceWrapper) PostProducts(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// GetPvz operation middleware
-func (siw *ServerInterfaceWrapper) GetPvz(w http.ResponseWriter, r *http.Request) {
+// GetExportPvz operation middleware
+func (siw *ServerInterfaceWrapper) GetExportPvz(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -355,7 +1651,7 @@ func (siw *ServerInterfaceWrapper) GetPvz(w http.ResponseWriter, r *http.Request
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetPvzParams
+	var params GetExportPvzParams
 
 	// ------------- Optional query parameter "startDate" -------------
 
@@ -373,24 +1669,16 @@ func (siw *ServerInterfaceWrapper) GetPvz(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	// ------------- Optional query parameter "page" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Optional query parameter "format" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPvz(w, r, params)
+		siw.Handler.GetExportPvz(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -400,8 +1688,10 @@ func (siw *ServerInterfaceWrapper) GetPvz(w http.ResponseWriter, r *http.Request
 	handler.ServeHTTP(w, r)
 }
 
-// PostPvz operation middleware
-func (siw *ServerInterfaceWrapper) PostPvz(w http.ResponseWriter, r *http.Request) {
+// GetExportReceptions operation middleware
+func (siw *ServerInterfaceWrapper) GetExportReceptions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
 
 	ctx := r.Context()
 
@@ -409,8 +1699,59 @@ func (siw *ServerInterfaceWrapper) PostPvz(w http.ResponseWriter, r *http.Reques
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetExportReceptionsParams
+
+	// ------------- Optional query parameter "pvzId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pvzId", r.URL.Query(), &params.PvzId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "createdBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdBy", r.URL.Query(), &params.CreatedBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "createdBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "startDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "startDate", r.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "startDate", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "endDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "endDate", r.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "endDate", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPvz(w, r)
+		siw.Handler.GetExportReceptions(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -420,20 +1761,23 @@ func (siw *ServerInterfaceWrapper) PostPvz(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r)
 }
 
-// PostPvzPvzIdCloseLastReception operation middleware
-func (siw *ServerInterfaceWrapper) PostPvzPvzIdCloseLastReception(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// PostLogin operation middleware
+func (siw *ServerInterfaceWrapper) PostLogin(w http.ResponseWriter, r *http.Request) {
 
-	// ------------- Path parameter "pvzId" -------------
-	var pvzId openapi_types.UUID
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostLogin(w, r)
+	}))
 
-	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", chi.URLParam(r, "pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
+	handler.ServeHTTP(w, r)
+}
+
+// PostManifests operation middleware
+func (siw *ServerInterfaceWrapper) PostManifests(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -441,7 +1785,7 @@ func (siw *ServerInterfaceWrapper) PostPvzPvzIdCloseLastReception(w http.Respons
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPvzPvzIdCloseLastReception(w, r, pvzId)
+		siw.Handler.PostManifests(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -451,17 +1795,17 @@ func (siw *ServerInterfaceWrapper) PostPvzPvzIdCloseLastReception(w http.Respons
 	handler.ServeHTTP(w, r)
 }
 
-// PostPvzPvzIdDeleteLastProduct operation middleware
-func (siw *ServerInterfaceWrapper) PostPvzPvzIdDeleteLastProduct(w http.ResponseWriter, r *http.Request) {
+// GetManifestsManifestId operation middleware
+func (siw *ServerInterfaceWrapper) GetManifestsManifestId(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "pvzId" -------------
-	var pvzId openapi_types.UUID
+	// ------------- Path parameter "manifestId" -------------
+	var manifestId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", chi.URLParam(r, "pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "manifestId", chi.URLParam(r, "manifestId"), &manifestId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "manifestId", Err: err})
 		return
 	}
 
@@ -472,7 +1816,7 @@ func (siw *ServerInterfaceWrapper) PostPvzPvzIdDeleteLastProduct(w http.Response
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPvzPvzIdDeleteLastProduct(w, r, pvzId)
+		siw.Handler.GetManifestsManifestId(w, r, manifestId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -482,8 +1826,8 @@ func (siw *ServerInterfaceWrapper) PostPvzPvzIdDeleteLastProduct(w http.Response
 	handler.ServeHTTP(w, r)
 }
 
-// PostReceptions operation middleware
-func (siw *ServerInterfaceWrapper) PostReceptions(w http.ResponseWriter, r *http.Request) {
+// PostOrders operation middleware
+func (siw *ServerInterfaceWrapper) PostOrders(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -492,7 +1836,7 @@ func (siw *ServerInterfaceWrapper) PostReceptions(w http.ResponseWriter, r *http
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostReceptions(w, r)
+		siw.Handler.PostOrders(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -502,11 +1846,28 @@ func (siw *ServerInterfaceWrapper) PostReceptions(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// PostRegister operation middleware
-func (siw *ServerInterfaceWrapper) PostRegister(w http.ResponseWriter, r *http.Request) {
+// GetOrdersOrderId operation middleware
+func (siw *ServerInterfaceWrapper) GetOrdersOrderId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "orderId" -------------
+	var orderId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orderId", chi.URLParam(r, "orderId"), &orderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orderId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostRegister(w, r)
+		siw.Handler.GetOrdersOrderId(w, r, orderId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -516,571 +1877,6326 @@ func (siw *ServerInterfaceWrapper) PostRegister(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// PostOrdersOrderIdIssue operation middleware
+func (siw *ServerInterfaceWrapper) PostOrdersOrderIdIssue(w http.ResponseWriter, r *http.Request) {
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	var err error
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Path parameter "orderId" -------------
+	var orderId openapi_types.UUID
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "orderId", chi.URLParam(r, "orderId"), &orderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orderId", Err: err})
+		return
+	}
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	ctx := r.Context()
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type RequiredParamError struct {
-	ParamName string
-}
+	r = r.WithContext(ctx)
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostOrdersOrderIdIssue(w, r, orderId)
+	}))
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+	handler.ServeHTTP(w, r)
 }
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+// PostOrdersOrderIdPickupCode operation middleware
+func (siw *ServerInterfaceWrapper) PostOrdersOrderIdPickupCode(w http.ResponseWriter, r *http.Request) {
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+	var err error
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+	// ------------- Path parameter "orderId" -------------
+	var orderId openapi_types.UUID
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "orderId", chi.URLParam(r, "orderId"), &orderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orderId", Err: err})
+		return
+	}
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+	ctx := r.Context()
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
-}
+	r = r.WithContext(ctx)
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostOrdersOrderIdPickupCode(w, r, orderId)
+	}))
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
+	handler.ServeHTTP(w, r)
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+// GetProductTypes operation middleware
+func (siw *ServerInterfaceWrapper) GetProductTypes(w http.ResponseWriter, r *http.Request) {
 
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+	ctx := r.Context()
 
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/dummyLogin", wrapper.PostDummyLogin)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/login", wrapper.PostLogin)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/products", wrapper.PostProducts)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/pvz", wrapper.GetPvz)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/pvz", wrapper.PostPvz)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/pvz/{pvzId}/close_last_reception", wrapper.PostPvzPvzIdCloseLastReception)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/pvz/{pvzId}/delete_last_product", wrapper.PostPvzPvzIdDeleteLastProduct)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/receptions", wrapper.PostReceptions)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/register", wrapper.PostRegister)
-	})
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return r
-}
+	r = r.WithContext(ctx)
 
-type PostDummyLoginRequestObject struct {
-	Body *PostDummyLoginJSONRequestBody
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProductTypes(w, r)
+	}))
 
-type PostDummyLoginResponseObject interface {
-	VisitPostDummyLoginResponse(w http.ResponseWriter) error
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type PostDummyLogin200JSONResponse Token
+// PostProductTypes operation middleware
+func (siw *ServerInterfaceWrapper) PostProductTypes(w http.ResponseWriter, r *http.Request) {
 
-func (response PostDummyLogin200JSONResponse) VisitPostDummyLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type PostDummyLogin400JSONResponse Error
+	r = r.WithContext(ctx)
 
-func (response PostDummyLogin400JSONResponse) VisitPostDummyLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostProductTypes(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type PostDummyLogin500JSONResponse Error
+// PutProductTypesTypeName operation middleware
+func (siw *ServerInterfaceWrapper) PutProductTypesTypeName(w http.ResponseWriter, r *http.Request) {
 
-func (response PostDummyLogin500JSONResponse) VisitPostDummyLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "typeName" -------------
+	var typeName string
 
-type PostLoginRequestObject struct {
-	Body *PostLoginJSONRequestBody
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "typeName", chi.URLParam(r, "typeName"), &typeName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "typeName", Err: err})
+		return
+	}
 
-type PostLoginResponseObject interface {
-	VisitPostLoginResponse(w http.ResponseWriter) error
-}
+	ctx := r.Context()
 
-type PostLogin200JSONResponse Token
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response PostLogin200JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutProductTypesTypeName(w, r, typeName)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type PostLogin401JSONResponse Error
+// PostProducts operation middleware
+func (siw *ServerInterfaceWrapper) PostProducts(w http.ResponseWriter, r *http.Request) {
 
-func (response PostLogin401JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type PostLogin500JSONResponse Error
+	r = r.WithContext(ctx)
 
-func (response PostLogin500JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostProducts(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PostProductsRequestObject struct {
-	Body *PostProductsJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type PostProductsResponseObject interface {
-	VisitPostProductsResponse(w http.ResponseWriter) error
-}
+// PostProductsBatch operation middleware
+func (siw *ServerInterfaceWrapper) PostProductsBatch(w http.ResponseWriter, r *http.Request) {
 
-type PostProducts201JSONResponse Product
+	ctx := r.Context()
 
-func (response PostProducts201JSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type PostProducts400JSONResponse Error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostProductsBatch(w, r)
+	}))
 
-func (response PostProducts400JSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type PostProducts403JSONResponse Error
+// DeleteProductsProductId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProductsProductId(w http.ResponseWriter, r *http.Request) {
 
-func (response PostProducts403JSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "productId" -------------
+	var productId openapi_types.UUID
 
-type PostProducts500JSONResponse Error
+	err = runtime.BindStyledParameterWithOptions("simple", "productId", chi.URLParam(r, "productId"), &productId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "productId", Err: err})
+		return
+	}
 
-func (response PostProducts500JSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GetPvzRequestObject struct {
-	Params GetPvzParams
-}
+	r = r.WithContext(ctx)
 
-type GetPvzResponseObject interface {
-	VisitGetPvzResponse(w http.ResponseWriter) error
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteProductsProductId(w, r, productId)
+	}))
 
-type GetPvz200JSONResponse []struct {
-	Pvz        *PVZ `json:"pvz,omitempty"`
-	Receptions *[]struct {
-		Products  *[]Product `json:"products,omitempty"`
-		Reception *Reception `json:"reception,omitempty"`
-	} `json:"receptions,omitempty"`
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-func (response GetPvz200JSONResponse) VisitGetPvzResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PatchProductsProductId operation middleware
+func (siw *ServerInterfaceWrapper) PatchProductsProductId(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type GetPvz500JSONResponse Error
+	// ------------- Path parameter "productId" -------------
+	var productId openapi_types.UUID
 
-func (response GetPvz500JSONResponse) VisitGetPvzResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindStyledParameterWithOptions("simple", "productId", chi.URLParam(r, "productId"), &productId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "productId", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type PostPvzRequestObject struct {
-	Body *PostPvzJSONRequestBody
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type PostPvzResponseObject interface {
-	VisitPostPvzResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type PostPvz201JSONResponse PVZ
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchProductsProductId(w, r, productId)
+	}))
 
-func (response PostPvz201JSONResponse) VisitPostPvzResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type PostPvz400JSONResponse Error
+// GetProductsProductIdAttachments operation middleware
+func (siw *ServerInterfaceWrapper) GetProductsProductIdAttachments(w http.ResponseWriter, r *http.Request) {
 
-func (response PostPvz400JSONResponse) VisitPostPvzResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "productId" -------------
+	var productId openapi_types.UUID
 
-type PostPvz403JSONResponse Error
+	err = runtime.BindStyledParameterWithOptions("simple", "productId", chi.URLParam(r, "productId"), &productId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "productId", Err: err})
+		return
+	}
 
-func (response PostPvz403JSONResponse) VisitPostPvzResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type PostPvz500JSONResponse Error
+	r = r.WithContext(ctx)
 
-func (response PostPvz500JSONResponse) VisitPostPvzResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProductsProductIdAttachments(w, r, productId)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PostPvzPvzIdCloseLastReceptionRequestObject struct {
-	PvzId openapi_types.UUID `json:"pvzId"`
+	handler.ServeHTTP(w, r)
 }
 
-type PostPvzPvzIdCloseLastReceptionResponseObject interface {
-	VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error
-}
+// PostProductsProductIdAttachments operation middleware
+func (siw *ServerInterfaceWrapper) PostProductsProductIdAttachments(w http.ResponseWriter, r *http.Request) {
 
-type PostPvzPvzIdCloseLastReception200JSONResponse Reception
+	var err error
 
-func (response PostPvzPvzIdCloseLastReception200JSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "productId" -------------
+	var productId openapi_types.UUID
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "productId", chi.URLParam(r, "productId"), &productId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "productId", Err: err})
+		return
+	}
 
-type PostPvzPvzIdCloseLastReception400JSONResponse Error
+	ctx := r.Context()
 
-func (response PostPvzPvzIdCloseLastReception400JSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type PostPvzPvzIdCloseLastReception403JSONResponse Error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostProductsProductIdAttachments(w, r, productId)
+	}))
 
-func (response PostPvzPvzIdCloseLastReception403JSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type PostPvzPvzIdCloseLastReception500JSONResponse Error
+// PostProductsProductIdIssue operation middleware
+func (siw *ServerInterfaceWrapper) PostProductsProductIdIssue(w http.ResponseWriter, r *http.Request) {
 
-func (response PostPvzPvzIdCloseLastReception500JSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "productId" -------------
+	var productId openapi_types.UUID
 
-type PostPvzPvzIdDeleteLastProductRequestObject struct {
-	PvzId openapi_types.UUID `json:"pvzId"`
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "productId", chi.URLParam(r, "productId"), &productId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "productId", Err: err})
+		return
+	}
 
-type PostPvzPvzIdDeleteLastProductResponseObject interface {
-	VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error
-}
+	ctx := r.Context()
 
-type PostPvzPvzIdDeleteLastProduct200Response struct {
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response PostPvzPvzIdDeleteLastProduct200Response) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostProductsProductIdIssue(w, r, productId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProductsProductIdLocation operation middleware
+func (siw *ServerInterfaceWrapper) GetProductsProductIdLocation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "productId" -------------
+	var productId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "productId", chi.URLParam(r, "productId"), &productId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "productId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProductsProductIdLocation(w, r, productId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostProductsProductIdMove operation middleware
+func (siw *ServerInterfaceWrapper) PostProductsProductIdMove(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "productId" -------------
+	var productId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "productId", chi.URLParam(r, "productId"), &productId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "productId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostProductsProductIdMove(w, r, productId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostProductsProductIdPickupCode operation middleware
+func (siw *ServerInterfaceWrapper) PostProductsProductIdPickupCode(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "productId" -------------
+	var productId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "productId", chi.URLParam(r, "productId"), &productId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "productId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostProductsProductIdPickupCode(w, r, productId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostProductsProductIdReturnToSender operation middleware
+func (siw *ServerInterfaceWrapper) PostProductsProductIdReturnToSender(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "productId" -------------
+	var productId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "productId", chi.URLParam(r, "productId"), &productId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "productId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostProductsProductIdReturnToSender(w, r, productId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPvz operation middleware
+func (siw *ServerInterfaceWrapper) GetPvz(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPvzParams
+
+	// ------------- Optional query parameter "startDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "startDate", r.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "startDate", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "endDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "endDate", r.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "endDate", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include", r.URL.Query(), &params.Include)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "fields", r.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fields", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPvz(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPvz operation middleware
+func (siw *ServerInterfaceWrapper) PostPvz(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPvz(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPvzPvzIdCapacity operation middleware
+func (siw *ServerInterfaceWrapper) GetPvzPvzIdCapacity(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", chi.URLParam(r, "pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPvzPvzIdCapacity(w, r, pvzId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPvzPvzIdCells operation middleware
+func (siw *ServerInterfaceWrapper) GetPvzPvzIdCells(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", chi.URLParam(r, "pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPvzPvzIdCells(w, r, pvzId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPvzPvzIdCells operation middleware
+func (siw *ServerInterfaceWrapper) PostPvzPvzIdCells(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", chi.URLParam(r, "pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPvzPvzIdCells(w, r, pvzId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPvzPvzIdCloseLastReception operation middleware
+func (siw *ServerInterfaceWrapper) PostPvzPvzIdCloseLastReception(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", chi.URLParam(r, "pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPvzPvzIdCloseLastReception(w, r, pvzId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPvzPvzIdCloseLastReturnShipment operation middleware
+func (siw *ServerInterfaceWrapper) PostPvzPvzIdCloseLastReturnShipment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", chi.URLParam(r, "pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPvzPvzIdCloseLastReturnShipment(w, r, pvzId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPvzPvzIdDeleteLastProduct operation middleware
+func (siw *ServerInterfaceWrapper) PostPvzPvzIdDeleteLastProduct(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", chi.URLParam(r, "pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPvzPvzIdDeleteLastProduct(w, r, pvzId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutPvzPvzIdLimits operation middleware
+func (siw *ServerInterfaceWrapper) PutPvzPvzIdLimits(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", chi.URLParam(r, "pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutPvzPvzIdLimits(w, r, pvzId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPvzPvzIdStock operation middleware
+func (siw *ServerInterfaceWrapper) GetPvzPvzIdStock(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", chi.URLParam(r, "pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPvzPvzIdStockParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPvzPvzIdStock(w, r, pvzId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReceptions operation middleware
+func (siw *ServerInterfaceWrapper) GetReceptions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReceptionsParams
+
+	// ------------- Optional query parameter "pvzId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pvzId", r.URL.Query(), &params.PvzId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "createdBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdBy", r.URL.Query(), &params.CreatedBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "createdBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "startDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "startDate", r.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "startDate", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "endDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "endDate", r.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "endDate", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReceptions(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostReceptions operation middleware
+func (siw *ServerInterfaceWrapper) PostReceptions(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostReceptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReceptionsReceptionId operation middleware
+func (siw *ServerInterfaceWrapper) GetReceptionsReceptionId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "receptionId", chi.URLParam(r, "receptionId"), &receptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "receptionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReceptionsReceptionIdParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReceptionsReceptionId(w, r, receptionId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReceptionsReceptionIdAttachments operation middleware
+func (siw *ServerInterfaceWrapper) GetReceptionsReceptionIdAttachments(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "receptionId", chi.URLParam(r, "receptionId"), &receptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "receptionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReceptionsReceptionIdAttachments(w, r, receptionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostReceptionsReceptionIdAttachments operation middleware
+func (siw *ServerInterfaceWrapper) PostReceptionsReceptionIdAttachments(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "receptionId", chi.URLParam(r, "receptionId"), &receptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "receptionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostReceptionsReceptionIdAttachments(w, r, receptionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostReceptionsReceptionIdCancel operation middleware
+func (siw *ServerInterfaceWrapper) PostReceptionsReceptionIdCancel(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "receptionId", chi.URLParam(r, "receptionId"), &receptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "receptionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostReceptionsReceptionIdCancel(w, r, receptionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostReceptionsReceptionIdCloseWithOverride operation middleware
+func (siw *ServerInterfaceWrapper) PostReceptionsReceptionIdCloseWithOverride(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "receptionId", chi.URLParam(r, "receptionId"), &receptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "receptionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostReceptionsReceptionIdCloseWithOverride(w, r, receptionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReceptionsReceptionIdDeletedProducts operation middleware
+func (siw *ServerInterfaceWrapper) GetReceptionsReceptionIdDeletedProducts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "receptionId", chi.URLParam(r, "receptionId"), &receptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "receptionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReceptionsReceptionIdDeletedProducts(w, r, receptionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReceptionsReceptionIdDiscrepancy operation middleware
+func (siw *ServerInterfaceWrapper) GetReceptionsReceptionIdDiscrepancy(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "receptionId", chi.URLParam(r, "receptionId"), &receptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "receptionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReceptionsReceptionIdDiscrepancy(w, r, receptionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReceptionsReceptionIdHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetReceptionsReceptionIdHistory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "receptionId", chi.URLParam(r, "receptionId"), &receptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "receptionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReceptionsReceptionIdHistory(w, r, receptionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostReceptionsReceptionIdReopen operation middleware
+func (siw *ServerInterfaceWrapper) PostReceptionsReceptionIdReopen(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "receptionId", chi.URLParam(r, "receptionId"), &receptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "receptionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostReceptionsReceptionIdReopen(w, r, receptionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostReceptionsReceptionIdStart operation middleware
+func (siw *ServerInterfaceWrapper) PostReceptionsReceptionIdStart(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "receptionId", chi.URLParam(r, "receptionId"), &receptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "receptionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostReceptionsReceptionIdStart(w, r, receptionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostReceptionsReceptionIdVerify operation middleware
+func (siw *ServerInterfaceWrapper) PostReceptionsReceptionIdVerify(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "receptionId", chi.URLParam(r, "receptionId"), &receptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "receptionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostReceptionsReceptionIdVerify(w, r, receptionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostRegister operation middleware
+func (siw *ServerInterfaceWrapper) PostRegister(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostRegister(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReportsProductivity operation middleware
+func (siw *ServerInterfaceWrapper) GetReportsProductivity(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReportsProductivityParams
+
+	// ------------- Optional query parameter "startDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "startDate", r.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "startDate", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "endDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "endDate", r.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "endDate", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pvzId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pvzId", r.URL.Query(), &params.PvzId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "slaMinutes" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "slaMinutes", r.URL.Query(), &params.SlaMinutes)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slaMinutes", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "idleGapMinutes" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "idleGapMinutes", r.URL.Query(), &params.IdleGapMinutes)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "idleGapMinutes", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReportsProductivity(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReportsProductivityDailyDate operation middleware
+func (siw *ServerInterfaceWrapper) GetReportsProductivityDailyDate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "date" -------------
+	var date openapi_types.Date
+
+	err = runtime.BindStyledParameterWithOptions("simple", "date", chi.URLParam(r, "date"), &date, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReportsProductivityDailyDate(w, r, date)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostReturnShipments operation middleware
+func (siw *ServerInterfaceWrapper) PostReturnShipments(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostReturnShipments(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReturnShipmentsShipmentId operation middleware
+func (siw *ServerInterfaceWrapper) GetReturnShipmentsShipmentId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "shipmentId" -------------
+	var shipmentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "shipmentId", chi.URLParam(r, "shipmentId"), &shipmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "shipmentId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReturnShipmentsShipmentId(w, r, shipmentId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostReturns operation middleware
+func (siw *ServerInterfaceWrapper) PostReturns(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostReturns(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatsReceptions operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsReceptions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsReceptionsParams
+
+	// ------------- Optional query parameter "groupBy" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "groupBy", r.URL.Query(), &params.GroupBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "period" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "period", r.URL.Query(), &params.Period)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "period", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "startDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "startDate", r.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "startDate", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "endDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "endDate", r.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "endDate", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatsReceptions(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTransfers operation middleware
+func (siw *ServerInterfaceWrapper) PostTransfers(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTransfers(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTransfersInTransit operation middleware
+func (siw *ServerInterfaceWrapper) GetTransfersInTransit(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTransfersInTransitParams
+
+	// ------------- Optional query parameter "pvzId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pvzId", r.URL.Query(), &params.PvzId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTransfersInTransit(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTransfersTransferId operation middleware
+func (siw *ServerInterfaceWrapper) GetTransfersTransferId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "transferId" -------------
+	var transferId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "transferId", chi.URLParam(r, "transferId"), &transferId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "transferId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTransfersTransferId(w, r, transferId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTransfersTransferIdDispatch operation middleware
+func (siw *ServerInterfaceWrapper) PostTransfersTransferIdDispatch(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "transferId" -------------
+	var transferId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "transferId", chi.URLParam(r, "transferId"), &transferId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "transferId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTransfersTransferIdDispatch(w, r, transferId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTransfersTransferIdReceive operation middleware
+func (siw *ServerInterfaceWrapper) PostTransfersTransferIdReceive(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "transferId" -------------
+	var transferId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "transferId", chi.URLParam(r, "transferId"), &transferId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "transferId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTransfersTransferIdReceive(w, r, transferId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/attachments/{attachmentId}", wrapper.GetAttachmentsAttachmentId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/dummyLogin", wrapper.PostDummyLogin)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/export/pvz", wrapper.GetExportPvz)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/export/receptions", wrapper.GetExportReceptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/login", wrapper.PostLogin)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/manifests", wrapper.PostManifests)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/manifests/{manifestId}", wrapper.GetManifestsManifestId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/orders", wrapper.PostOrders)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/orders/{orderId}", wrapper.GetOrdersOrderId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/orders/{orderId}/issue", wrapper.PostOrdersOrderIdIssue)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/orders/{orderId}/pickup_code", wrapper.PostOrdersOrderIdPickupCode)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/product_types", wrapper.GetProductTypes)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/product_types", wrapper.PostProductTypes)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/product_types/{typeName}", wrapper.PutProductTypesTypeName)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/products", wrapper.PostProducts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/products/batch", wrapper.PostProductsBatch)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/products/{productId}", wrapper.DeleteProductsProductId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/products/{productId}", wrapper.PatchProductsProductId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/products/{productId}/attachments", wrapper.GetProductsProductIdAttachments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/products/{productId}/attachments", wrapper.PostProductsProductIdAttachments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/products/{productId}/issue", wrapper.PostProductsProductIdIssue)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/products/{productId}/location", wrapper.GetProductsProductIdLocation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/products/{productId}/move", wrapper.PostProductsProductIdMove)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/products/{productId}/pickup_code", wrapper.PostProductsProductIdPickupCode)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/products/{productId}/return_to_sender", wrapper.PostProductsProductIdReturnToSender)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pvz", wrapper.GetPvz)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pvz", wrapper.PostPvz)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pvz/{pvzId}/capacity", wrapper.GetPvzPvzIdCapacity)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pvz/{pvzId}/cells", wrapper.GetPvzPvzIdCells)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pvz/{pvzId}/cells", wrapper.PostPvzPvzIdCells)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pvz/{pvzId}/close_last_reception", wrapper.PostPvzPvzIdCloseLastReception)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pvz/{pvzId}/close_last_return_shipment", wrapper.PostPvzPvzIdCloseLastReturnShipment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pvz/{pvzId}/delete_last_product", wrapper.PostPvzPvzIdDeleteLastProduct)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/pvz/{pvzId}/limits", wrapper.PutPvzPvzIdLimits)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pvz/{pvzId}/stock", wrapper.GetPvzPvzIdStock)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/receptions", wrapper.GetReceptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/receptions", wrapper.PostReceptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/receptions/{receptionId}", wrapper.GetReceptionsReceptionId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/receptions/{receptionId}/attachments", wrapper.GetReceptionsReceptionIdAttachments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/receptions/{receptionId}/attachments", wrapper.PostReceptionsReceptionIdAttachments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/receptions/{receptionId}/cancel", wrapper.PostReceptionsReceptionIdCancel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/receptions/{receptionId}/close_with_override", wrapper.PostReceptionsReceptionIdCloseWithOverride)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/receptions/{receptionId}/deleted_products", wrapper.GetReceptionsReceptionIdDeletedProducts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/receptions/{receptionId}/discrepancy", wrapper.GetReceptionsReceptionIdDiscrepancy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/receptions/{receptionId}/history", wrapper.GetReceptionsReceptionIdHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/receptions/{receptionId}/reopen", wrapper.PostReceptionsReceptionIdReopen)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/receptions/{receptionId}/start", wrapper.PostReceptionsReceptionIdStart)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/receptions/{receptionId}/verify", wrapper.PostReceptionsReceptionIdVerify)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/register", wrapper.PostRegister)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/reports/productivity", wrapper.GetReportsProductivity)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/reports/productivity/daily/{date}", wrapper.GetReportsProductivityDailyDate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/return_shipments", wrapper.PostReturnShipments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/return_shipments/{shipmentId}", wrapper.GetReturnShipmentsShipmentId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/returns", wrapper.PostReturns)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats/receptions", wrapper.GetStatsReceptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/transfers", wrapper.PostTransfers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/transfers/in_transit", wrapper.GetTransfersInTransit)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/transfers/{transferId}", wrapper.GetTransfersTransferId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/transfers/{transferId}/dispatch", wrapper.PostTransfersTransferIdDispatch)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/transfers/{transferId}/receive", wrapper.PostTransfersTransferIdReceive)
+	})
+
+	return r
+}
+
+type GetAttachmentsAttachmentIdRequestObject struct {
+	AttachmentId openapi_types.UUID `json:"attachmentId"`
+}
+
+type GetAttachmentsAttachmentIdResponseObject interface {
+	VisitGetAttachmentsAttachmentIdResponse(w http.ResponseWriter) error
+}
+
+type GetAttachmentsAttachmentId200ResponseHeaders struct {
+	ContentDisposition string
+}
+
+type GetAttachmentsAttachmentId200AsteriskResponse struct {
+	Body          io.Reader
+	Headers       GetAttachmentsAttachmentId200ResponseHeaders
+	ContentType   string
+	ContentLength int64
+}
+
+func (response GetAttachmentsAttachmentId200AsteriskResponse) VisitGetAttachmentsAttachmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", response.ContentType)
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetAttachmentsAttachmentId400JSONResponse Error
+
+func (response GetAttachmentsAttachmentId400JSONResponse) VisitGetAttachmentsAttachmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAttachmentsAttachmentId403JSONResponse Error
+
+func (response GetAttachmentsAttachmentId403JSONResponse) VisitGetAttachmentsAttachmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAttachmentsAttachmentId500JSONResponse Error
+
+func (response GetAttachmentsAttachmentId500JSONResponse) VisitGetAttachmentsAttachmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostDummyLoginRequestObject struct {
+	Body *PostDummyLoginJSONRequestBody
+}
+
+type PostDummyLoginResponseObject interface {
+	VisitPostDummyLoginResponse(w http.ResponseWriter) error
+}
+
+type PostDummyLogin200JSONResponse Token
+
+func (response PostDummyLogin200JSONResponse) VisitPostDummyLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostDummyLogin400JSONResponse Error
+
+func (response PostDummyLogin400JSONResponse) VisitPostDummyLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostDummyLogin500JSONResponse Error
+
+func (response PostDummyLogin500JSONResponse) VisitPostDummyLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExportPvzRequestObject struct {
+	Params GetExportPvzParams
+}
+
+type GetExportPvzResponseObject interface {
+	VisitGetExportPvzResponse(w http.ResponseWriter) error
+}
+
+type GetExportPvz200ResponseHeaders struct {
+	ContentDisposition string
+}
+
+type GetExportPvz200ApplicationvndOpenxmlformatsOfficedocumentSpreadsheetmlSheetResponse struct {
+	Body          io.Reader
+	Headers       GetExportPvz200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetExportPvz200ApplicationvndOpenxmlformatsOfficedocumentSpreadsheetmlSheetResponse) VisitGetExportPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetExportPvz200TextcsvResponse struct {
+	Body          io.Reader
+	Headers       GetExportPvz200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetExportPvz200TextcsvResponse) VisitGetExportPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetExportPvz400JSONResponse Error
+
+func (response GetExportPvz400JSONResponse) VisitGetExportPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExportPvz500JSONResponse Error
+
+func (response GetExportPvz500JSONResponse) VisitGetExportPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExportReceptionsRequestObject struct {
+	Params GetExportReceptionsParams
+}
+
+type GetExportReceptionsResponseObject interface {
+	VisitGetExportReceptionsResponse(w http.ResponseWriter) error
+}
+
+type GetExportReceptions200ResponseHeaders struct {
+	ContentDisposition string
+}
+
+type GetExportReceptions200ApplicationvndOpenxmlformatsOfficedocumentSpreadsheetmlSheetResponse struct {
+	Body          io.Reader
+	Headers       GetExportReceptions200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetExportReceptions200ApplicationvndOpenxmlformatsOfficedocumentSpreadsheetmlSheetResponse) VisitGetExportReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetExportReceptions200TextcsvResponse struct {
+	Body          io.Reader
+	Headers       GetExportReceptions200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetExportReceptions200TextcsvResponse) VisitGetExportReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetExportReceptions400JSONResponse Error
+
+func (response GetExportReceptions400JSONResponse) VisitGetExportReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExportReceptions500JSONResponse Error
+
+func (response GetExportReceptions500JSONResponse) VisitGetExportReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLoginRequestObject struct {
+	Body *PostLoginJSONRequestBody
+}
+
+type PostLoginResponseObject interface {
+	VisitPostLoginResponse(w http.ResponseWriter) error
+}
+
+type PostLogin200JSONResponse Token
+
+func (response PostLogin200JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLogin401JSONResponse Error
+
+func (response PostLogin401JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLogin500JSONResponse Error
+
+func (response PostLogin500JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostManifestsRequestObject struct {
+	Body *PostManifestsJSONRequestBody
+}
+
+type PostManifestsResponseObject interface {
+	VisitPostManifestsResponse(w http.ResponseWriter) error
+}
+
+type PostManifests201JSONResponse Manifest
+
+func (response PostManifests201JSONResponse) VisitPostManifestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostManifests400JSONResponse Error
+
+func (response PostManifests400JSONResponse) VisitPostManifestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostManifests403JSONResponse Error
+
+func (response PostManifests403JSONResponse) VisitPostManifestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostManifests500JSONResponse Error
+
+func (response PostManifests500JSONResponse) VisitPostManifestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetManifestsManifestIdRequestObject struct {
+	ManifestId openapi_types.UUID `json:"manifestId"`
+}
+
+type GetManifestsManifestIdResponseObject interface {
+	VisitGetManifestsManifestIdResponse(w http.ResponseWriter) error
+}
+
+type GetManifestsManifestId200JSONResponse Manifest
+
+func (response GetManifestsManifestId200JSONResponse) VisitGetManifestsManifestIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetManifestsManifestId400JSONResponse Error
+
+func (response GetManifestsManifestId400JSONResponse) VisitGetManifestsManifestIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetManifestsManifestId500JSONResponse Error
+
+func (response GetManifestsManifestId500JSONResponse) VisitGetManifestsManifestIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostOrdersRequestObject struct {
+	Body *PostOrdersJSONRequestBody
+}
+
+type PostOrdersResponseObject interface {
+	VisitPostOrdersResponse(w http.ResponseWriter) error
+}
+
+type PostOrders201JSONResponse Order
+
+func (response PostOrders201JSONResponse) VisitPostOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostOrders400JSONResponse Error
+
+func (response PostOrders400JSONResponse) VisitPostOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostOrders403JSONResponse Error
+
+func (response PostOrders403JSONResponse) VisitPostOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostOrders500JSONResponse Error
+
+func (response PostOrders500JSONResponse) VisitPostOrdersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrdersOrderIdRequestObject struct {
+	OrderId openapi_types.UUID `json:"orderId"`
+}
+
+type GetOrdersOrderIdResponseObject interface {
+	VisitGetOrdersOrderIdResponse(w http.ResponseWriter) error
+}
+
+type GetOrdersOrderId200JSONResponse Order
+
+func (response GetOrdersOrderId200JSONResponse) VisitGetOrdersOrderIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrdersOrderId400JSONResponse Error
+
+func (response GetOrdersOrderId400JSONResponse) VisitGetOrdersOrderIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrdersOrderId403JSONResponse Error
+
+func (response GetOrdersOrderId403JSONResponse) VisitGetOrdersOrderIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrdersOrderId500JSONResponse Error
+
+func (response GetOrdersOrderId500JSONResponse) VisitGetOrdersOrderIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostOrdersOrderIdIssueRequestObject struct {
+	OrderId openapi_types.UUID `json:"orderId"`
+	Body    *PostOrdersOrderIdIssueJSONRequestBody
+}
+
+type PostOrdersOrderIdIssueResponseObject interface {
+	VisitPostOrdersOrderIdIssueResponse(w http.ResponseWriter) error
+}
+
+type PostOrdersOrderIdIssue200JSONResponse Order
+
+func (response PostOrdersOrderIdIssue200JSONResponse) VisitPostOrdersOrderIdIssueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostOrdersOrderIdIssue400JSONResponse Error
+
+func (response PostOrdersOrderIdIssue400JSONResponse) VisitPostOrdersOrderIdIssueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostOrdersOrderIdIssue403JSONResponse Error
+
+func (response PostOrdersOrderIdIssue403JSONResponse) VisitPostOrdersOrderIdIssueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostOrdersOrderIdIssue500JSONResponse Error
+
+func (response PostOrdersOrderIdIssue500JSONResponse) VisitPostOrdersOrderIdIssueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostOrdersOrderIdPickupCodeRequestObject struct {
+	OrderId openapi_types.UUID `json:"orderId"`
+}
+
+type PostOrdersOrderIdPickupCodeResponseObject interface {
+	VisitPostOrdersOrderIdPickupCodeResponse(w http.ResponseWriter) error
+}
+
+type PostOrdersOrderIdPickupCode200JSONResponse Order
+
+func (response PostOrdersOrderIdPickupCode200JSONResponse) VisitPostOrdersOrderIdPickupCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostOrdersOrderIdPickupCode400JSONResponse Error
+
+func (response PostOrdersOrderIdPickupCode400JSONResponse) VisitPostOrdersOrderIdPickupCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostOrdersOrderIdPickupCode403JSONResponse Error
+
+func (response PostOrdersOrderIdPickupCode403JSONResponse) VisitPostOrdersOrderIdPickupCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostOrdersOrderIdPickupCode500JSONResponse Error
+
+func (response PostOrdersOrderIdPickupCode500JSONResponse) VisitPostOrdersOrderIdPickupCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductTypesRequestObject struct {
+}
+
+type GetProductTypesResponseObject interface {
+	VisitGetProductTypesResponse(w http.ResponseWriter) error
+}
+
+type GetProductTypes200JSONResponse []ProductType
+
+func (response GetProductTypes200JSONResponse) VisitGetProductTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductTypes500JSONResponse Error
+
+func (response GetProductTypes500JSONResponse) VisitGetProductTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductTypesRequestObject struct {
+	Body *PostProductTypesJSONRequestBody
+}
+
+type PostProductTypesResponseObject interface {
+	VisitPostProductTypesResponse(w http.ResponseWriter) error
+}
+
+type PostProductTypes201JSONResponse ProductType
+
+func (response PostProductTypes201JSONResponse) VisitPostProductTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductTypes400JSONResponse Error
+
+func (response PostProductTypes400JSONResponse) VisitPostProductTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductTypes403JSONResponse Error
+
+func (response PostProductTypes403JSONResponse) VisitPostProductTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductTypes500JSONResponse Error
+
+func (response PostProductTypes500JSONResponse) VisitPostProductTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutProductTypesTypeNameRequestObject struct {
+	TypeName string `json:"typeName"`
+	Body     *PutProductTypesTypeNameJSONRequestBody
+}
+
+type PutProductTypesTypeNameResponseObject interface {
+	VisitPutProductTypesTypeNameResponse(w http.ResponseWriter) error
+}
+
+type PutProductTypesTypeName200JSONResponse ProductType
+
+func (response PutProductTypesTypeName200JSONResponse) VisitPutProductTypesTypeNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutProductTypesTypeName400JSONResponse Error
+
+func (response PutProductTypesTypeName400JSONResponse) VisitPutProductTypesTypeNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutProductTypesTypeName403JSONResponse Error
+
+func (response PutProductTypesTypeName403JSONResponse) VisitPutProductTypesTypeNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutProductTypesTypeName500JSONResponse Error
+
+func (response PutProductTypesTypeName500JSONResponse) VisitPutProductTypesTypeNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsRequestObject struct {
+	Body *PostProductsJSONRequestBody
+}
+
+type PostProductsResponseObject interface {
+	VisitPostProductsResponse(w http.ResponseWriter) error
+}
+
+type PostProducts201JSONResponse Product
+
+func (response PostProducts201JSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProducts400JSONResponse Error
+
+func (response PostProducts400JSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProducts403JSONResponse Error
+
+func (response PostProducts403JSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProducts409JSONResponse DuplicateProductError
+
+func (response PostProducts409JSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProducts500JSONResponse Error
+
+func (response PostProducts500JSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsBatchRequestObject struct {
+	Body *PostProductsBatchJSONRequestBody
+}
+
+type PostProductsBatchResponseObject interface {
+	VisitPostProductsBatchResponse(w http.ResponseWriter) error
+}
+
+type PostProductsBatch201JSONResponse []Product
+
+func (response PostProductsBatch201JSONResponse) VisitPostProductsBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsBatch400JSONResponse Error
+
+func (response PostProductsBatch400JSONResponse) VisitPostProductsBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsBatch403JSONResponse Error
+
+func (response PostProductsBatch403JSONResponse) VisitPostProductsBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsBatch409JSONResponse DuplicateProductError
+
+func (response PostProductsBatch409JSONResponse) VisitPostProductsBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsBatch500JSONResponse Error
+
+func (response PostProductsBatch500JSONResponse) VisitPostProductsBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProductsProductIdRequestObject struct {
+	ProductId openapi_types.UUID `json:"productId"`
+	Body      *DeleteProductsProductIdJSONRequestBody
+}
+
+type DeleteProductsProductIdResponseObject interface {
+	VisitDeleteProductsProductIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteProductsProductId200Response struct {
+}
+
+func (response DeleteProductsProductId200Response) VisitDeleteProductsProductIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteProductsProductId400JSONResponse Error
+
+func (response DeleteProductsProductId400JSONResponse) VisitDeleteProductsProductIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProductsProductId403JSONResponse Error
+
+func (response DeleteProductsProductId403JSONResponse) VisitDeleteProductsProductIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProductsProductId500JSONResponse Error
+
+func (response DeleteProductsProductId500JSONResponse) VisitDeleteProductsProductIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchProductsProductIdRequestObject struct {
+	ProductId openapi_types.UUID `json:"productId"`
+	Body      *PatchProductsProductIdJSONRequestBody
+}
+
+type PatchProductsProductIdResponseObject interface {
+	VisitPatchProductsProductIdResponse(w http.ResponseWriter) error
+}
+
+type PatchProductsProductId200JSONResponse Product
+
+func (response PatchProductsProductId200JSONResponse) VisitPatchProductsProductIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchProductsProductId400JSONResponse Error
+
+func (response PatchProductsProductId400JSONResponse) VisitPatchProductsProductIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchProductsProductId403JSONResponse Error
+
+func (response PatchProductsProductId403JSONResponse) VisitPatchProductsProductIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchProductsProductId500JSONResponse Error
+
+func (response PatchProductsProductId500JSONResponse) VisitPatchProductsProductIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductsProductIdAttachmentsRequestObject struct {
+	ProductId openapi_types.UUID `json:"productId"`
+}
+
+type GetProductsProductIdAttachmentsResponseObject interface {
+	VisitGetProductsProductIdAttachmentsResponse(w http.ResponseWriter) error
+}
+
+type GetProductsProductIdAttachments200JSONResponse []Attachment
+
+func (response GetProductsProductIdAttachments200JSONResponse) VisitGetProductsProductIdAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductsProductIdAttachments400JSONResponse Error
+
+func (response GetProductsProductIdAttachments400JSONResponse) VisitGetProductsProductIdAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductsProductIdAttachments403JSONResponse Error
+
+func (response GetProductsProductIdAttachments403JSONResponse) VisitGetProductsProductIdAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductsProductIdAttachments500JSONResponse Error
+
+func (response GetProductsProductIdAttachments500JSONResponse) VisitGetProductsProductIdAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdAttachmentsRequestObject struct {
+	ProductId openapi_types.UUID `json:"productId"`
+	Body      *multipart.Reader
+}
+
+type PostProductsProductIdAttachmentsResponseObject interface {
+	VisitPostProductsProductIdAttachmentsResponse(w http.ResponseWriter) error
+}
+
+type PostProductsProductIdAttachments201JSONResponse Attachment
+
+func (response PostProductsProductIdAttachments201JSONResponse) VisitPostProductsProductIdAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdAttachments400JSONResponse Error
+
+func (response PostProductsProductIdAttachments400JSONResponse) VisitPostProductsProductIdAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdAttachments403JSONResponse Error
+
+func (response PostProductsProductIdAttachments403JSONResponse) VisitPostProductsProductIdAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdAttachments500JSONResponse Error
+
+func (response PostProductsProductIdAttachments500JSONResponse) VisitPostProductsProductIdAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdIssueRequestObject struct {
+	ProductId openapi_types.UUID `json:"productId"`
+	Body      *PostProductsProductIdIssueJSONRequestBody
+}
+
+type PostProductsProductIdIssueResponseObject interface {
+	VisitPostProductsProductIdIssueResponse(w http.ResponseWriter) error
+}
+
+type PostProductsProductIdIssue200JSONResponse Product
+
+func (response PostProductsProductIdIssue200JSONResponse) VisitPostProductsProductIdIssueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdIssue400JSONResponse Error
+
+func (response PostProductsProductIdIssue400JSONResponse) VisitPostProductsProductIdIssueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdIssue403JSONResponse Error
+
+func (response PostProductsProductIdIssue403JSONResponse) VisitPostProductsProductIdIssueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdIssue500JSONResponse Error
+
+func (response PostProductsProductIdIssue500JSONResponse) VisitPostProductsProductIdIssueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductsProductIdLocationRequestObject struct {
+	ProductId openapi_types.UUID `json:"productId"`
+}
+
+type GetProductsProductIdLocationResponseObject interface {
+	VisitGetProductsProductIdLocationResponse(w http.ResponseWriter) error
+}
+
+type GetProductsProductIdLocation200JSONResponse ProductLocation
+
+func (response GetProductsProductIdLocation200JSONResponse) VisitGetProductsProductIdLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductsProductIdLocation400JSONResponse Error
+
+func (response GetProductsProductIdLocation400JSONResponse) VisitGetProductsProductIdLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductsProductIdLocation403JSONResponse Error
+
+func (response GetProductsProductIdLocation403JSONResponse) VisitGetProductsProductIdLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductsProductIdLocation500JSONResponse Error
+
+func (response GetProductsProductIdLocation500JSONResponse) VisitGetProductsProductIdLocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdMoveRequestObject struct {
+	ProductId openapi_types.UUID `json:"productId"`
+	Body      *PostProductsProductIdMoveJSONRequestBody
+}
+
+type PostProductsProductIdMoveResponseObject interface {
+	VisitPostProductsProductIdMoveResponse(w http.ResponseWriter) error
+}
+
+type PostProductsProductIdMove200JSONResponse Product
+
+func (response PostProductsProductIdMove200JSONResponse) VisitPostProductsProductIdMoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdMove400JSONResponse Error
+
+func (response PostProductsProductIdMove400JSONResponse) VisitPostProductsProductIdMoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdMove403JSONResponse Error
+
+func (response PostProductsProductIdMove403JSONResponse) VisitPostProductsProductIdMoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdMove500JSONResponse Error
+
+func (response PostProductsProductIdMove500JSONResponse) VisitPostProductsProductIdMoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdPickupCodeRequestObject struct {
+	ProductId openapi_types.UUID `json:"productId"`
+}
+
+type PostProductsProductIdPickupCodeResponseObject interface {
+	VisitPostProductsProductIdPickupCodeResponse(w http.ResponseWriter) error
+}
+
+type PostProductsProductIdPickupCode200JSONResponse PickupCode
+
+func (response PostProductsProductIdPickupCode200JSONResponse) VisitPostProductsProductIdPickupCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdPickupCode400JSONResponse Error
+
+func (response PostProductsProductIdPickupCode400JSONResponse) VisitPostProductsProductIdPickupCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdPickupCode403JSONResponse Error
+
+func (response PostProductsProductIdPickupCode403JSONResponse) VisitPostProductsProductIdPickupCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdPickupCode500JSONResponse Error
+
+func (response PostProductsProductIdPickupCode500JSONResponse) VisitPostProductsProductIdPickupCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdReturnToSenderRequestObject struct {
+	ProductId openapi_types.UUID `json:"productId"`
+	Body      *PostProductsProductIdReturnToSenderJSONRequestBody
+}
+
+type PostProductsProductIdReturnToSenderResponseObject interface {
+	VisitPostProductsProductIdReturnToSenderResponse(w http.ResponseWriter) error
+}
+
+type PostProductsProductIdReturnToSender200JSONResponse Product
+
+func (response PostProductsProductIdReturnToSender200JSONResponse) VisitPostProductsProductIdReturnToSenderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdReturnToSender400JSONResponse Error
+
+func (response PostProductsProductIdReturnToSender400JSONResponse) VisitPostProductsProductIdReturnToSenderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdReturnToSender403JSONResponse Error
+
+func (response PostProductsProductIdReturnToSender403JSONResponse) VisitPostProductsProductIdReturnToSenderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdReturnToSender500JSONResponse Error
+
+func (response PostProductsProductIdReturnToSender500JSONResponse) VisitPostProductsProductIdReturnToSenderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzRequestObject struct {
+	Params GetPvzParams
+}
+
+type GetPvzResponseObject interface {
+	VisitGetPvzResponse(w http.ResponseWriter) error
+}
+
+type GetPvz200JSONResponse []struct {
+	Pvz        *PVZ `json:"pvz,omitempty"`
+	Receptions *[]struct {
+		// ProductCounts Количество неаннулированных товаров приемки по типам
+		ProductCounts *ProductCounts `json:"productCounts,omitempty"`
+		Products      *[]Product     `json:"products,omitempty"`
+		Reception     *Reception     `json:"reception,omitempty"`
+	} `json:"receptions,omitempty"`
+
+	// ReturnShipments Отправки возвратов ПВЗ, открытые в том же диапазоне дат
+	ReturnShipments *[]ReturnShipment `json:"returnShipments,omitempty"`
+}
+
+func (response GetPvz200JSONResponse) VisitGetPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvz200ApplicationxNdjsonResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetPvz200ApplicationxNdjsonResponse) VisitGetPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetPvz500JSONResponse Error
+
+func (response GetPvz500JSONResponse) VisitGetPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzRequestObject struct {
+	Body *PostPvzJSONRequestBody
+}
+
+type PostPvzResponseObject interface {
+	VisitPostPvzResponse(w http.ResponseWriter) error
+}
+
+type PostPvz201JSONResponse PVZ
+
+func (response PostPvz201JSONResponse) VisitPostPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvz400JSONResponse Error
+
+func (response PostPvz400JSONResponse) VisitPostPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvz403JSONResponse Error
+
+func (response PostPvz403JSONResponse) VisitPostPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvz500JSONResponse Error
+
+func (response PostPvz500JSONResponse) VisitPostPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdCapacityRequestObject struct {
+	PvzId openapi_types.UUID `json:"pvzId"`
+}
+
+type GetPvzPvzIdCapacityResponseObject interface {
+	VisitGetPvzPvzIdCapacityResponse(w http.ResponseWriter) error
+}
+
+type GetPvzPvzIdCapacity200JSONResponse PvzCapacity
+
+func (response GetPvzPvzIdCapacity200JSONResponse) VisitGetPvzPvzIdCapacityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdCapacity400JSONResponse Error
+
+func (response GetPvzPvzIdCapacity400JSONResponse) VisitGetPvzPvzIdCapacityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdCapacity403JSONResponse Error
+
+func (response GetPvzPvzIdCapacity403JSONResponse) VisitGetPvzPvzIdCapacityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdCapacity500JSONResponse Error
+
+func (response GetPvzPvzIdCapacity500JSONResponse) VisitGetPvzPvzIdCapacityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdCellsRequestObject struct {
+	PvzId openapi_types.UUID `json:"pvzId"`
+}
+
+type GetPvzPvzIdCellsResponseObject interface {
+	VisitGetPvzPvzIdCellsResponse(w http.ResponseWriter) error
+}
+
+type GetPvzPvzIdCells200JSONResponse []StorageCell
+
+func (response GetPvzPvzIdCells200JSONResponse) VisitGetPvzPvzIdCellsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdCells400JSONResponse Error
+
+func (response GetPvzPvzIdCells400JSONResponse) VisitGetPvzPvzIdCellsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdCells403JSONResponse Error
+
+func (response GetPvzPvzIdCells403JSONResponse) VisitGetPvzPvzIdCellsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdCells500JSONResponse Error
+
+func (response GetPvzPvzIdCells500JSONResponse) VisitGetPvzPvzIdCellsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCellsRequestObject struct {
+	PvzId openapi_types.UUID `json:"pvzId"`
+	Body  *PostPvzPvzIdCellsJSONRequestBody
+}
+
+type PostPvzPvzIdCellsResponseObject interface {
+	VisitPostPvzPvzIdCellsResponse(w http.ResponseWriter) error
+}
+
+type PostPvzPvzIdCells201JSONResponse StorageCell
+
+func (response PostPvzPvzIdCells201JSONResponse) VisitPostPvzPvzIdCellsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCells400JSONResponse Error
+
+func (response PostPvzPvzIdCells400JSONResponse) VisitPostPvzPvzIdCellsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCells403JSONResponse Error
+
+func (response PostPvzPvzIdCells403JSONResponse) VisitPostPvzPvzIdCellsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCells500JSONResponse Error
+
+func (response PostPvzPvzIdCells500JSONResponse) VisitPostPvzPvzIdCellsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCloseLastReceptionRequestObject struct {
+	PvzId openapi_types.UUID `json:"pvzId"`
+}
+
+type PostPvzPvzIdCloseLastReceptionResponseObject interface {
+	VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error
+}
+
+type PostPvzPvzIdCloseLastReception200JSONResponse Reception
+
+func (response PostPvzPvzIdCloseLastReception200JSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCloseLastReception400JSONResponse Error
+
+func (response PostPvzPvzIdCloseLastReception400JSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCloseLastReception403JSONResponse Error
+
+func (response PostPvzPvzIdCloseLastReception403JSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCloseLastReception500JSONResponse Error
+
+func (response PostPvzPvzIdCloseLastReception500JSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCloseLastReturnShipmentRequestObject struct {
+	PvzId openapi_types.UUID `json:"pvzId"`
+}
+
+type PostPvzPvzIdCloseLastReturnShipmentResponseObject interface {
+	VisitPostPvzPvzIdCloseLastReturnShipmentResponse(w http.ResponseWriter) error
+}
+
+type PostPvzPvzIdCloseLastReturnShipment200JSONResponse ReturnShipment
+
+func (response PostPvzPvzIdCloseLastReturnShipment200JSONResponse) VisitPostPvzPvzIdCloseLastReturnShipmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCloseLastReturnShipment400JSONResponse Error
+
+func (response PostPvzPvzIdCloseLastReturnShipment400JSONResponse) VisitPostPvzPvzIdCloseLastReturnShipmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCloseLastReturnShipment403JSONResponse Error
+
+func (response PostPvzPvzIdCloseLastReturnShipment403JSONResponse) VisitPostPvzPvzIdCloseLastReturnShipmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCloseLastReturnShipment500JSONResponse Error
+
+func (response PostPvzPvzIdCloseLastReturnShipment500JSONResponse) VisitPostPvzPvzIdCloseLastReturnShipmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdDeleteLastProductRequestObject struct {
+	PvzId openapi_types.UUID `json:"pvzId"`
+}
+
+type PostPvzPvzIdDeleteLastProductResponseObject interface {
+	VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error
+}
+
+type PostPvzPvzIdDeleteLastProduct200Response struct {
+}
+
+func (response PostPvzPvzIdDeleteLastProduct200Response) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostPvzPvzIdDeleteLastProduct400JSONResponse Error
+
+func (response PostPvzPvzIdDeleteLastProduct400JSONResponse) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdDeleteLastProduct403JSONResponse Error
+
+func (response PostPvzPvzIdDeleteLastProduct403JSONResponse) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdDeleteLastProduct500JSONResponse Error
+
+func (response PostPvzPvzIdDeleteLastProduct500JSONResponse) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutPvzPvzIdLimitsRequestObject struct {
+	PvzId openapi_types.UUID `json:"pvzId"`
+	Body  *PutPvzPvzIdLimitsJSONRequestBody
+}
+
+type PutPvzPvzIdLimitsResponseObject interface {
+	VisitPutPvzPvzIdLimitsResponse(w http.ResponseWriter) error
+}
+
+type PutPvzPvzIdLimits200JSONResponse PvzLimits
+
+func (response PutPvzPvzIdLimits200JSONResponse) VisitPutPvzPvzIdLimitsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutPvzPvzIdLimits400JSONResponse Error
+
+func (response PutPvzPvzIdLimits400JSONResponse) VisitPutPvzPvzIdLimitsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutPvzPvzIdLimits403JSONResponse Error
+
+func (response PutPvzPvzIdLimits403JSONResponse) VisitPutPvzPvzIdLimitsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutPvzPvzIdLimits500JSONResponse Error
+
+func (response PutPvzPvzIdLimits500JSONResponse) VisitPutPvzPvzIdLimitsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdStockRequestObject struct {
+	PvzId  openapi_types.UUID `json:"pvzId"`
+	Params GetPvzPvzIdStockParams
+}
+
+type GetPvzPvzIdStockResponseObject interface {
+	VisitGetPvzPvzIdStockResponse(w http.ResponseWriter) error
+}
+
+type GetPvzPvzIdStock200JSONResponse PvzStock
+
+func (response GetPvzPvzIdStock200JSONResponse) VisitGetPvzPvzIdStockResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdStock400JSONResponse Error
+
+func (response GetPvzPvzIdStock400JSONResponse) VisitGetPvzPvzIdStockResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdStock403JSONResponse Error
+
+func (response GetPvzPvzIdStock403JSONResponse) VisitGetPvzPvzIdStockResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdStock500JSONResponse Error
+
+func (response GetPvzPvzIdStock500JSONResponse) VisitGetPvzPvzIdStockResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptionsRequestObject struct {
+	Params GetReceptionsParams
+}
+
+type GetReceptionsResponseObject interface {
+	VisitGetReceptionsResponse(w http.ResponseWriter) error
+}
+
+type GetReceptions200JSONResponse []ReceptionSummary
+
+func (response GetReceptions200JSONResponse) VisitGetReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptions400JSONResponse Error
+
+func (response GetReceptions400JSONResponse) VisitGetReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptions500JSONResponse Error
+
+func (response GetReceptions500JSONResponse) VisitGetReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsRequestObject struct {
+	Body *PostReceptionsJSONRequestBody
+}
+
+type PostReceptionsResponseObject interface {
+	VisitPostReceptionsResponse(w http.ResponseWriter) error
+}
+
+type PostReceptions201JSONResponse Reception
+
+func (response PostReceptions201JSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptions400JSONResponse Error
+
+func (response PostReceptions400JSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptions403JSONResponse Error
+
+func (response PostReceptions403JSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptions500JSONResponse Error
+
+func (response PostReceptions500JSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptionsReceptionIdRequestObject struct {
+	ReceptionId openapi_types.UUID `json:"receptionId"`
+	Params      GetReceptionsReceptionIdParams
+}
+
+type GetReceptionsReceptionIdResponseObject interface {
+	VisitGetReceptionsReceptionIdResponse(w http.ResponseWriter) error
+}
+
+type GetReceptionsReceptionId200JSONResponse ReceptionDetail
+
+func (response GetReceptionsReceptionId200JSONResponse) VisitGetReceptionsReceptionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptionsReceptionId400JSONResponse Error
+
+func (response GetReceptionsReceptionId400JSONResponse) VisitGetReceptionsReceptionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptionsReceptionId500JSONResponse Error
+
+func (response GetReceptionsReceptionId500JSONResponse) VisitGetReceptionsReceptionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptionsReceptionIdAttachmentsRequestObject struct {
+	ReceptionId openapi_types.UUID `json:"receptionId"`
+}
+
+type GetReceptionsReceptionIdAttachmentsResponseObject interface {
+	VisitGetReceptionsReceptionIdAttachmentsResponse(w http.ResponseWriter) error
+}
+
+type GetReceptionsReceptionIdAttachments200JSONResponse []Attachment
+
+func (response GetReceptionsReceptionIdAttachments200JSONResponse) VisitGetReceptionsReceptionIdAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptionsReceptionIdAttachments400JSONResponse Error
+
+func (response GetReceptionsReceptionIdAttachments400JSONResponse) VisitGetReceptionsReceptionIdAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptionsReceptionIdAttachments403JSONResponse Error
+
+func (response GetReceptionsReceptionIdAttachments403JSONResponse) VisitGetReceptionsReceptionIdAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptionsReceptionIdAttachments500JSONResponse Error
+
+func (response GetReceptionsReceptionIdAttachments500JSONResponse) VisitGetReceptionsReceptionIdAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdAttachmentsRequestObject struct {
+	ReceptionId openapi_types.UUID `json:"receptionId"`
+	Body        *multipart.Reader
+}
+
+type PostReceptionsReceptionIdAttachmentsResponseObject interface {
+	VisitPostReceptionsReceptionIdAttachmentsResponse(w http.ResponseWriter) error
+}
+
+type PostReceptionsReceptionIdAttachments201JSONResponse Attachment
+
+func (response PostReceptionsReceptionIdAttachments201JSONResponse) VisitPostReceptionsReceptionIdAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdAttachments400JSONResponse Error
+
+func (response PostReceptionsReceptionIdAttachments400JSONResponse) VisitPostReceptionsReceptionIdAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdAttachments403JSONResponse Error
+
+func (response PostReceptionsReceptionIdAttachments403JSONResponse) VisitPostReceptionsReceptionIdAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdAttachments500JSONResponse Error
+
+func (response PostReceptionsReceptionIdAttachments500JSONResponse) VisitPostReceptionsReceptionIdAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdCancelRequestObject struct {
+	ReceptionId openapi_types.UUID `json:"receptionId"`
+	Body        *PostReceptionsReceptionIdCancelJSONRequestBody
+}
+
+type PostReceptionsReceptionIdCancelResponseObject interface {
+	VisitPostReceptionsReceptionIdCancelResponse(w http.ResponseWriter) error
+}
+
+type PostReceptionsReceptionIdCancel200JSONResponse Reception
+
+func (response PostReceptionsReceptionIdCancel200JSONResponse) VisitPostReceptionsReceptionIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdCancel400JSONResponse Error
+
+func (response PostReceptionsReceptionIdCancel400JSONResponse) VisitPostReceptionsReceptionIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdCancel403JSONResponse Error
+
+func (response PostReceptionsReceptionIdCancel403JSONResponse) VisitPostReceptionsReceptionIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdCancel500JSONResponse Error
+
+func (response PostReceptionsReceptionIdCancel500JSONResponse) VisitPostReceptionsReceptionIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdCloseWithOverrideRequestObject struct {
+	ReceptionId openapi_types.UUID `json:"receptionId"`
+	Body        *PostReceptionsReceptionIdCloseWithOverrideJSONRequestBody
+}
+
+type PostReceptionsReceptionIdCloseWithOverrideResponseObject interface {
+	VisitPostReceptionsReceptionIdCloseWithOverrideResponse(w http.ResponseWriter) error
+}
+
+type PostReceptionsReceptionIdCloseWithOverride200JSONResponse Reception
+
+func (response PostReceptionsReceptionIdCloseWithOverride200JSONResponse) VisitPostReceptionsReceptionIdCloseWithOverrideResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdCloseWithOverride400JSONResponse Error
+
+func (response PostReceptionsReceptionIdCloseWithOverride400JSONResponse) VisitPostReceptionsReceptionIdCloseWithOverrideResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdCloseWithOverride403JSONResponse Error
+
+func (response PostReceptionsReceptionIdCloseWithOverride403JSONResponse) VisitPostReceptionsReceptionIdCloseWithOverrideResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdCloseWithOverride500JSONResponse Error
+
+func (response PostReceptionsReceptionIdCloseWithOverride500JSONResponse) VisitPostReceptionsReceptionIdCloseWithOverrideResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptionsReceptionIdDeletedProductsRequestObject struct {
+	ReceptionId openapi_types.UUID `json:"receptionId"`
+}
+
+type GetReceptionsReceptionIdDeletedProductsResponseObject interface {
+	VisitGetReceptionsReceptionIdDeletedProductsResponse(w http.ResponseWriter) error
+}
+
+type GetReceptionsReceptionIdDeletedProducts200JSONResponse []Product
+
+func (response GetReceptionsReceptionIdDeletedProducts200JSONResponse) VisitGetReceptionsReceptionIdDeletedProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptionsReceptionIdDeletedProducts400JSONResponse Error
+
+func (response GetReceptionsReceptionIdDeletedProducts400JSONResponse) VisitGetReceptionsReceptionIdDeletedProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptionsReceptionIdDeletedProducts403JSONResponse Error
+
+func (response GetReceptionsReceptionIdDeletedProducts403JSONResponse) VisitGetReceptionsReceptionIdDeletedProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptionsReceptionIdDeletedProducts500JSONResponse Error
+
+func (response GetReceptionsReceptionIdDeletedProducts500JSONResponse) VisitGetReceptionsReceptionIdDeletedProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptionsReceptionIdDiscrepancyRequestObject struct {
+	ReceptionId openapi_types.UUID `json:"receptionId"`
+}
+
+type GetReceptionsReceptionIdDiscrepancyResponseObject interface {
+	VisitGetReceptionsReceptionIdDiscrepancyResponse(w http.ResponseWriter) error
+}
+
+type GetReceptionsReceptionIdDiscrepancy200JSONResponse DiscrepancyReport
+
+func (response GetReceptionsReceptionIdDiscrepancy200JSONResponse) VisitGetReceptionsReceptionIdDiscrepancyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptionsReceptionIdDiscrepancy400JSONResponse Error
+
+func (response GetReceptionsReceptionIdDiscrepancy400JSONResponse) VisitGetReceptionsReceptionIdDiscrepancyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptionsReceptionIdDiscrepancy500JSONResponse Error
+
+func (response GetReceptionsReceptionIdDiscrepancy500JSONResponse) VisitGetReceptionsReceptionIdDiscrepancyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptionsReceptionIdHistoryRequestObject struct {
+	ReceptionId openapi_types.UUID `json:"receptionId"`
+}
+
+type GetReceptionsReceptionIdHistoryResponseObject interface {
+	VisitGetReceptionsReceptionIdHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetReceptionsReceptionIdHistory200JSONResponse []ReceptionStatusChange
+
+func (response GetReceptionsReceptionIdHistory200JSONResponse) VisitGetReceptionsReceptionIdHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptionsReceptionIdHistory400JSONResponse Error
+
+func (response GetReceptionsReceptionIdHistory400JSONResponse) VisitGetReceptionsReceptionIdHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptionsReceptionIdHistory500JSONResponse Error
+
+func (response GetReceptionsReceptionIdHistory500JSONResponse) VisitGetReceptionsReceptionIdHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdReopenRequestObject struct {
+	ReceptionId openapi_types.UUID `json:"receptionId"`
+	Body        *PostReceptionsReceptionIdReopenJSONRequestBody
+}
+
+type PostReceptionsReceptionIdReopenResponseObject interface {
+	VisitPostReceptionsReceptionIdReopenResponse(w http.ResponseWriter) error
+}
+
+type PostReceptionsReceptionIdReopen200JSONResponse Reception
+
+func (response PostReceptionsReceptionIdReopen200JSONResponse) VisitPostReceptionsReceptionIdReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdReopen400JSONResponse Error
+
+func (response PostReceptionsReceptionIdReopen400JSONResponse) VisitPostReceptionsReceptionIdReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdReopen403JSONResponse Error
+
+func (response PostReceptionsReceptionIdReopen403JSONResponse) VisitPostReceptionsReceptionIdReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdReopen500JSONResponse Error
+
+func (response PostReceptionsReceptionIdReopen500JSONResponse) VisitPostReceptionsReceptionIdReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdStartRequestObject struct {
+	ReceptionId openapi_types.UUID `json:"receptionId"`
+}
+
+type PostReceptionsReceptionIdStartResponseObject interface {
+	VisitPostReceptionsReceptionIdStartResponse(w http.ResponseWriter) error
+}
+
+type PostReceptionsReceptionIdStart200JSONResponse Reception
+
+func (response PostReceptionsReceptionIdStart200JSONResponse) VisitPostReceptionsReceptionIdStartResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdStart400JSONResponse Error
+
+func (response PostReceptionsReceptionIdStart400JSONResponse) VisitPostReceptionsReceptionIdStartResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdStart403JSONResponse Error
+
+func (response PostReceptionsReceptionIdStart403JSONResponse) VisitPostReceptionsReceptionIdStartResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdStart500JSONResponse Error
+
+func (response PostReceptionsReceptionIdStart500JSONResponse) VisitPostReceptionsReceptionIdStartResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdVerifyRequestObject struct {
+	ReceptionId openapi_types.UUID `json:"receptionId"`
+	Body        *PostReceptionsReceptionIdVerifyJSONRequestBody
+}
+
+type PostReceptionsReceptionIdVerifyResponseObject interface {
+	VisitPostReceptionsReceptionIdVerifyResponse(w http.ResponseWriter) error
+}
+
+type PostReceptionsReceptionIdVerify200JSONResponse Reception
+
+func (response PostReceptionsReceptionIdVerify200JSONResponse) VisitPostReceptionsReceptionIdVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdVerify400JSONResponse Error
+
+func (response PostReceptionsReceptionIdVerify400JSONResponse) VisitPostReceptionsReceptionIdVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdVerify403JSONResponse Error
+
+func (response PostReceptionsReceptionIdVerify403JSONResponse) VisitPostReceptionsReceptionIdVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdVerify500JSONResponse Error
+
+func (response PostReceptionsReceptionIdVerify500JSONResponse) VisitPostReceptionsReceptionIdVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRegisterRequestObject struct {
+	Body *PostRegisterJSONRequestBody
+}
+
+type PostRegisterResponseObject interface {
+	VisitPostRegisterResponse(w http.ResponseWriter) error
+}
+
+type PostRegister201JSONResponse User
+
+func (response PostRegister201JSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRegister400JSONResponse Error
+
+func (response PostRegister400JSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRegister500JSONResponse Error
+
+func (response PostRegister500JSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReportsProductivityRequestObject struct {
+	Params GetReportsProductivityParams
+}
+
+type GetReportsProductivityResponseObject interface {
+	VisitGetReportsProductivityResponse(w http.ResponseWriter) error
+}
+
+type GetReportsProductivity200JSONResponse ProductivityReport
+
+func (response GetReportsProductivity200JSONResponse) VisitGetReportsProductivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReportsProductivity400JSONResponse Error
+
+func (response GetReportsProductivity400JSONResponse) VisitGetReportsProductivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReportsProductivity403JSONResponse Error
+
+func (response GetReportsProductivity403JSONResponse) VisitGetReportsProductivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReportsProductivity500JSONResponse Error
+
+func (response GetReportsProductivity500JSONResponse) VisitGetReportsProductivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReportsProductivityDailyDateRequestObject struct {
+	Date openapi_types.Date `json:"date"`
+}
+
+type GetReportsProductivityDailyDateResponseObject interface {
+	VisitGetReportsProductivityDailyDateResponse(w http.ResponseWriter) error
+}
+
+type GetReportsProductivityDailyDate200JSONResponse ProductivityReport
+
+func (response GetReportsProductivityDailyDate200JSONResponse) VisitGetReportsProductivityDailyDateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReportsProductivityDailyDate403JSONResponse Error
+
+func (response GetReportsProductivityDailyDate403JSONResponse) VisitGetReportsProductivityDailyDateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReportsProductivityDailyDate404JSONResponse Error
+
+func (response GetReportsProductivityDailyDate404JSONResponse) VisitGetReportsProductivityDailyDateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReportsProductivityDailyDate500JSONResponse Error
+
+func (response GetReportsProductivityDailyDate500JSONResponse) VisitGetReportsProductivityDailyDateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReturnShipmentsRequestObject struct {
+	Body *PostReturnShipmentsJSONRequestBody
+}
+
+type PostReturnShipmentsResponseObject interface {
+	VisitPostReturnShipmentsResponse(w http.ResponseWriter) error
+}
+
+type PostReturnShipments201JSONResponse ReturnShipment
+
+func (response PostReturnShipments201JSONResponse) VisitPostReturnShipmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReturnShipments400JSONResponse Error
+
+func (response PostReturnShipments400JSONResponse) VisitPostReturnShipmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReturnShipments403JSONResponse Error
+
+func (response PostReturnShipments403JSONResponse) VisitPostReturnShipmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReturnShipments500JSONResponse Error
+
+func (response PostReturnShipments500JSONResponse) VisitPostReturnShipmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReturnShipmentsShipmentIdRequestObject struct {
+	ShipmentId openapi_types.UUID `json:"shipmentId"`
+}
+
+type GetReturnShipmentsShipmentIdResponseObject interface {
+	VisitGetReturnShipmentsShipmentIdResponse(w http.ResponseWriter) error
+}
+
+type GetReturnShipmentsShipmentId200JSONResponse ReturnShipment
+
+func (response GetReturnShipmentsShipmentId200JSONResponse) VisitGetReturnShipmentsShipmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReturnShipmentsShipmentId400JSONResponse Error
+
+func (response GetReturnShipmentsShipmentId400JSONResponse) VisitGetReturnShipmentsShipmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReturnShipmentsShipmentId403JSONResponse Error
+
+func (response GetReturnShipmentsShipmentId403JSONResponse) VisitGetReturnShipmentsShipmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReturnShipmentsShipmentId500JSONResponse Error
+
+func (response GetReturnShipmentsShipmentId500JSONResponse) VisitGetReturnShipmentsShipmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReturnsRequestObject struct {
+	Body *PostReturnsJSONRequestBody
+}
+
+type PostReturnsResponseObject interface {
+	VisitPostReturnsResponse(w http.ResponseWriter) error
+}
+
+type PostReturns201JSONResponse Return
+
+func (response PostReturns201JSONResponse) VisitPostReturnsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReturns400JSONResponse Error
+
+func (response PostReturns400JSONResponse) VisitPostReturnsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReturns403JSONResponse Error
+
+func (response PostReturns403JSONResponse) VisitPostReturnsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReturns500JSONResponse Error
+
+func (response PostReturns500JSONResponse) VisitPostReturnsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsReceptionsRequestObject struct {
+	Params GetStatsReceptionsParams
+}
+
+type GetStatsReceptionsResponseObject interface {
+	VisitGetStatsReceptionsResponse(w http.ResponseWriter) error
+}
+
+type GetStatsReceptions200JSONResponse []ReceptionStatsRow
+
+func (response GetStatsReceptions200JSONResponse) VisitGetStatsReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsReceptions400JSONResponse Error
+
+func (response GetStatsReceptions400JSONResponse) VisitGetStatsReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsReceptions403JSONResponse Error
+
+func (response GetStatsReceptions403JSONResponse) VisitGetStatsReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsReceptions500JSONResponse Error
+
+func (response GetStatsReceptions500JSONResponse) VisitGetStatsReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransfersRequestObject struct {
+	Body *PostTransfersJSONRequestBody
+}
+
+type PostTransfersResponseObject interface {
+	VisitPostTransfersResponse(w http.ResponseWriter) error
+}
+
+type PostTransfers201JSONResponse Transfer
+
+func (response PostTransfers201JSONResponse) VisitPostTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransfers400JSONResponse Error
+
+func (response PostTransfers400JSONResponse) VisitPostTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransfers403JSONResponse Error
+
+func (response PostTransfers403JSONResponse) VisitPostTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransfers500JSONResponse Error
+
+func (response PostTransfers500JSONResponse) VisitPostTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransfersInTransitRequestObject struct {
+	Params GetTransfersInTransitParams
+}
+
+type GetTransfersInTransitResponseObject interface {
+	VisitGetTransfersInTransitResponse(w http.ResponseWriter) error
+}
+
+type GetTransfersInTransit200JSONResponse []Transfer
+
+func (response GetTransfersInTransit200JSONResponse) VisitGetTransfersInTransitResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransfersInTransit400JSONResponse Error
+
+func (response GetTransfersInTransit400JSONResponse) VisitGetTransfersInTransitResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransfersInTransit403JSONResponse Error
+
+func (response GetTransfersInTransit403JSONResponse) VisitGetTransfersInTransitResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransfersInTransit500JSONResponse Error
+
+func (response GetTransfersInTransit500JSONResponse) VisitGetTransfersInTransitResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransfersTransferIdRequestObject struct {
+	TransferId openapi_types.UUID `json:"transferId"`
+}
+
+type GetTransfersTransferIdResponseObject interface {
+	VisitGetTransfersTransferIdResponse(w http.ResponseWriter) error
+}
+
+type GetTransfersTransferId200JSONResponse Transfer
+
+func (response GetTransfersTransferId200JSONResponse) VisitGetTransfersTransferIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransfersTransferId400JSONResponse Error
+
+func (response GetTransfersTransferId400JSONResponse) VisitGetTransfersTransferIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransfersTransferId403JSONResponse Error
+
+func (response GetTransfersTransferId403JSONResponse) VisitGetTransfersTransferIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransfersTransferId500JSONResponse Error
+
+func (response GetTransfersTransferId500JSONResponse) VisitGetTransfersTransferIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransfersTransferIdDispatchRequestObject struct {
+	TransferId openapi_types.UUID `json:"transferId"`
+}
+
+type PostTransfersTransferIdDispatchResponseObject interface {
+	VisitPostTransfersTransferIdDispatchResponse(w http.ResponseWriter) error
+}
+
+type PostTransfersTransferIdDispatch200JSONResponse Transfer
+
+func (response PostTransfersTransferIdDispatch200JSONResponse) VisitPostTransfersTransferIdDispatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransfersTransferIdDispatch400JSONResponse Error
+
+func (response PostTransfersTransferIdDispatch400JSONResponse) VisitPostTransfersTransferIdDispatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransfersTransferIdDispatch403JSONResponse Error
+
+func (response PostTransfersTransferIdDispatch403JSONResponse) VisitPostTransfersTransferIdDispatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransfersTransferIdDispatch500JSONResponse Error
+
+func (response PostTransfersTransferIdDispatch500JSONResponse) VisitPostTransfersTransferIdDispatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransfersTransferIdReceiveRequestObject struct {
+	TransferId openapi_types.UUID `json:"transferId"`
+}
+
+type PostTransfersTransferIdReceiveResponseObject interface {
+	VisitPostTransfersTransferIdReceiveResponse(w http.ResponseWriter) error
+}
+
+type PostTransfersTransferIdReceive200JSONResponse Transfer
+
+func (response PostTransfersTransferIdReceive200JSONResponse) VisitPostTransfersTransferIdReceiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransfersTransferIdReceive400JSONResponse Error
+
+func (response PostTransfersTransferIdReceive400JSONResponse) VisitPostTransfersTransferIdReceiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransfersTransferIdReceive403JSONResponse Error
+
+func (response PostTransfersTransferIdReceive403JSONResponse) VisitPostTransfersTransferIdReceiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransfersTransferIdReceive500JSONResponse Error
+
+func (response PostTransfersTransferIdReceive500JSONResponse) VisitPostTransfersTransferIdReceiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Скачивание вложения (для всех ролей)
+	// (GET /attachments/{attachmentId})
+	GetAttachmentsAttachmentId(ctx context.Context, request GetAttachmentsAttachmentIdRequestObject) (GetAttachmentsAttachmentIdResponseObject, error)
+	// Получение тестового токена
+	// (POST /dummyLogin)
+	PostDummyLogin(ctx context.Context, request PostDummyLoginRequestObject) (PostDummyLoginResponseObject, error)
+	// Выгрузка ПВЗ с приемками и товарами в CSV или XLSX (для всех ролей)
+	// (GET /export/pvz)
+	GetExportPvz(ctx context.Context, request GetExportPvzRequestObject) (GetExportPvzResponseObject, error)
+	// Выгрузка списка приемок в CSV или XLSX (для всех ролей)
+	// (GET /export/receptions)
+	GetExportReceptions(ctx context.Context, request GetExportReceptionsRequestObject) (GetExportReceptionsResponseObject, error)
+	// Авторизация пользователя
+	// (POST /login)
+	PostLogin(ctx context.Context, request PostLoginRequestObject) (PostLoginResponseObject, error)
+	// Регистрация ожидаемой поставки в ПВЗ (только для модераторов)
+	// (POST /manifests)
+	PostManifests(ctx context.Context, request PostManifestsRequestObject) (PostManifestsResponseObject, error)
+	// Получение манифеста поставки (для всех ролей)
+	// (GET /manifests/{manifestId})
+	GetManifestsManifestId(ctx context.Context, request GetManifestsManifestIdRequestObject) (GetManifestsManifestIdResponseObject, error)
+	// Регистрация заказа покупателя в ПВЗ (только для модераторов)
+	// (POST /orders)
+	PostOrders(ctx context.Context, request PostOrdersRequestObject) (PostOrdersResponseObject, error)
+	// Получение заказа покупателя (для всех ролей)
+	// (GET /orders/{orderId})
+	GetOrdersOrderId(ctx context.Context, request GetOrdersOrderIdRequestObject) (GetOrdersOrderIdResponseObject, error)
+	// Выдача заказа по одноразовому коду (только для сотрудников ПВЗ)
+	// (POST /orders/{orderId}/issue)
+	PostOrdersOrderIdIssue(ctx context.Context, request PostOrdersOrderIdIssueRequestObject) (PostOrdersOrderIdIssueResponseObject, error)
+	// Выпуск нового кода выдачи заказа со сбросом попыток (только для модераторов)
+	// (POST /orders/{orderId}/pickup_code)
+	PostOrdersOrderIdPickupCode(ctx context.Context, request PostOrdersOrderIdPickupCodeRequestObject) (PostOrdersOrderIdPickupCodeResponseObject, error)
+	// Справочник типов товаров (для всех ролей)
+	// (GET /product_types)
+	GetProductTypes(ctx context.Context, request GetProductTypesRequestObject) (GetProductTypesResponseObject, error)
+	// Добавление типа товаров в справочник (только для модераторов)
+	// (POST /product_types)
+	PostProductTypes(ctx context.Context, request PostProductTypesRequestObject) (PostProductTypesResponseObject, error)
+	// Изменение схемы атрибутов типа товаров (только для модераторов)
+	// (PUT /product_types/{typeName})
+	PutProductTypesTypeName(ctx context.Context, request PutProductTypesTypeNameRequestObject) (PutProductTypesTypeNameResponseObject, error)
+	// Добавление товара в текущую приемку (только для сотрудников ПВЗ)
+	// (POST /products)
+	PostProducts(ctx context.Context, request PostProductsRequestObject) (PostProductsResponseObject, error)
+	// Пакетное добавление товаров в текущую приемку (только для сотрудников ПВЗ)
+	// (POST /products/batch)
+	PostProductsBatch(ctx context.Context, request PostProductsBatchRequestObject) (PostProductsBatchResponseObject, error)
+	// Удаление товара из приемки в работе с указанием причины (только для сотрудников ПВЗ)
+	// (DELETE /products/{productId})
+	DeleteProductsProductId(ctx context.Context, request DeleteProductsProductIdRequestObject) (DeleteProductsProductIdResponseObject, error)
+	// Исправление типа или атрибутов товара в приемке в работе (только для сотрудников ПВЗ)
+	// (PATCH /products/{productId})
+	PatchProductsProductId(ctx context.Context, request PatchProductsProductIdRequestObject) (PatchProductsProductIdResponseObject, error)
+	// Вложения товара (для всех ролей)
+	// (GET /products/{productId}/attachments)
+	GetProductsProductIdAttachments(ctx context.Context, request GetProductsProductIdAttachmentsRequestObject) (GetProductsProductIdAttachmentsResponseObject, error)
+	// Загрузка вложения товара (для всех ролей)
+	// (POST /products/{productId}/attachments)
+	PostProductsProductIdAttachments(ctx context.Context, request PostProductsProductIdAttachmentsRequestObject) (PostProductsProductIdAttachmentsResponseObject, error)
+	// Выдача товара получателю по коду выдачи (только для сотрудников ПВЗ)
+	// (POST /products/{productId}/issue)
+	PostProductsProductIdIssue(ctx context.Context, request PostProductsProductIdIssueRequestObject) (PostProductsProductIdIssueResponseObject, error)
+	// Местонахождение товара (для всех ролей)
+	// (GET /products/{productId}/location)
+	GetProductsProductIdLocation(ctx context.Context, request GetProductsProductIdLocationRequestObject) (GetProductsProductIdLocationResponseObject, error)
+	// Перемещение товара в другую ячейку хранения ПВЗ (только для сотрудников ПВЗ)
+	// (POST /products/{productId}/move)
+	PostProductsProductIdMove(ctx context.Context, request PostProductsProductIdMoveRequestObject) (PostProductsProductIdMoveResponseObject, error)
+	// Выпуск кода выдачи товара для передачи получателю со сбросом попыток (только для модераторов)
+	// (POST /products/{productId}/pickup_code)
+	PostProductsProductIdPickupCode(ctx context.Context, request PostProductsProductIdPickupCodeRequestObject) (PostProductsProductIdPickupCodeResponseObject, error)
+	// Возврат товара отправителю (для сотрудников ПВЗ и модераторов)
+	// (POST /products/{productId}/return_to_sender)
+	PostProductsProductIdReturnToSender(ctx context.Context, request PostProductsProductIdReturnToSenderRequestObject) (PostProductsProductIdReturnToSenderResponseObject, error)
+	// Получение списка ПВЗ с фильтрацией по дате приемки и пагинацией (для всех ролей)
+	// (GET /pvz)
+	GetPvz(ctx context.Context, request GetPvzRequestObject) (GetPvzResponseObject, error)
+	// Создание ПВЗ (только для модераторов)
+	// (POST /pvz)
+	PostPvz(ctx context.Context, request PostPvzRequestObject) (PostPvzResponseObject, error)
+	// Занятые вес и объем ПВЗ и его ячеек хранения (для всех ролей)
+	// (GET /pvz/{pvzId}/capacity)
+	GetPvzPvzIdCapacity(ctx context.Context, request GetPvzPvzIdCapacityRequestObject) (GetPvzPvzIdCapacityResponseObject, error)
+	// Ячейки хранения ПВЗ с заполненностью (для всех ролей)
+	// (GET /pvz/{pvzId}/cells)
+	GetPvzPvzIdCells(ctx context.Context, request GetPvzPvzIdCellsRequestObject) (GetPvzPvzIdCellsResponseObject, error)
+	// Создание ячейки хранения в ПВЗ (только для модераторов)
+	// (POST /pvz/{pvzId}/cells)
+	PostPvzPvzIdCells(ctx context.Context, request PostPvzPvzIdCellsRequestObject) (PostPvzPvzIdCellsResponseObject, error)
+	// Закрытие последней открытой приемки товаров в рамках ПВЗ (только для сотрудников ПВЗ)
+	// (POST /pvz/{pvzId}/close_last_reception)
+	PostPvzPvzIdCloseLastReception(ctx context.Context, request PostPvzPvzIdCloseLastReceptionRequestObject) (PostPvzPvzIdCloseLastReceptionResponseObject, error)
+	// Закрытие открытой отправки возвратов ПВЗ, товары отправки возвращаются отправителю (только для сотрудников ПВЗ)
+	// (POST /pvz/{pvzId}/close_last_return_shipment)
+	PostPvzPvzIdCloseLastReturnShipment(ctx context.Context, request PostPvzPvzIdCloseLastReturnShipmentRequestObject) (PostPvzPvzIdCloseLastReturnShipmentResponseObject, error)
+	// Удаление последнего добавленного товара из текущей приемки (LIFO, только для сотрудников ПВЗ)
+	// (POST /pvz/{pvzId}/delete_last_product)
+	PostPvzPvzIdDeleteLastProduct(ctx context.Context, request PostPvzPvzIdDeleteLastProductRequestObject) (PostPvzPvzIdDeleteLastProductResponseObject, error)
+	// Установка ограничений ПВЗ по весу и объему товаров (только для модераторов)
+	// (PUT /pvz/{pvzId}/limits)
+	PutPvzPvzIdLimits(ctx context.Context, request PutPvzPvzIdLimitsRequestObject) (PutPvzPvzIdLimitsResponseObject, error)
+	// Остатки ПВЗ - товары, которые еще не выданы и не возвращены (для всех ролей)
+	// (GET /pvz/{pvzId}/stock)
+	GetPvzPvzIdStock(ctx context.Context, request GetPvzPvzIdStockRequestObject) (GetPvzPvzIdStockResponseObject, error)
+	// Получение списка приемок с фильтрацией и пагинацией (для всех ролей)
+	// (GET /receptions)
+	GetReceptions(ctx context.Context, request GetReceptionsRequestObject) (GetReceptionsResponseObject, error)
+	// Создание новой приемки товаров (только для сотрудников ПВЗ)
+	// (POST /receptions)
+	PostReceptions(ctx context.Context, request PostReceptionsRequestObject) (PostReceptionsResponseObject, error)
+	// Получение приемки с постраничным списком товаров (для всех ролей)
+	// (GET /receptions/{receptionId})
+	GetReceptionsReceptionId(ctx context.Context, request GetReceptionsReceptionIdRequestObject) (GetReceptionsReceptionIdResponseObject, error)
+	// Вложения приемки (для всех ролей)
+	// (GET /receptions/{receptionId}/attachments)
+	GetReceptionsReceptionIdAttachments(ctx context.Context, request GetReceptionsReceptionIdAttachmentsRequestObject) (GetReceptionsReceptionIdAttachmentsResponseObject, error)
+	// Загрузка вложения приемки (для всех ролей)
+	// (POST /receptions/{receptionId}/attachments)
+	PostReceptionsReceptionIdAttachments(ctx context.Context, request PostReceptionsReceptionIdAttachmentsRequestObject) (PostReceptionsReceptionIdAttachmentsResponseObject, error)
+	// Отмена приемки с аннулированием её товаров (только для модераторов)
+	// (POST /receptions/{receptionId}/cancel)
+	PostReceptionsReceptionIdCancel(ctx context.Context, request PostReceptionsReceptionIdCancelRequestObject) (PostReceptionsReceptionIdCancelResponseObject, error)
+	// Закрытие приемки с расхождениями по манифесту (только для модераторов)
+	// (POST /receptions/{receptionId}/close_with_override)
+	PostReceptionsReceptionIdCloseWithOverride(ctx context.Context, request PostReceptionsReceptionIdCloseWithOverrideRequestObject) (PostReceptionsReceptionIdCloseWithOverrideResponseObject, error)
+	// Удаленные из приемки товары с автором и причиной удаления (только для модераторов)
+	// (GET /receptions/{receptionId}/deleted_products)
+	GetReceptionsReceptionIdDeletedProducts(ctx context.Context, request GetReceptionsReceptionIdDeletedProductsRequestObject) (GetReceptionsReceptionIdDeletedProductsResponseObject, error)
+	// Отчет о расхождениях приемки с манифестами (для всех ролей)
+	// (GET /receptions/{receptionId}/discrepancy)
+	GetReceptionsReceptionIdDiscrepancy(ctx context.Context, request GetReceptionsReceptionIdDiscrepancyRequestObject) (GetReceptionsReceptionIdDiscrepancyResponseObject, error)
+	// История смены статусов приемки (для всех ролей)
+	// (GET /receptions/{receptionId}/history)
+	GetReceptionsReceptionIdHistory(ctx context.Context, request GetReceptionsReceptionIdHistoryRequestObject) (GetReceptionsReceptionIdHistoryResponseObject, error)
+	// Повторное открытие закрытой приемки (только для модераторов)
+	// (POST /receptions/{receptionId}/reopen)
+	PostReceptionsReceptionIdReopen(ctx context.Context, request PostReceptionsReceptionIdReopenRequestObject) (PostReceptionsReceptionIdReopenResponseObject, error)
+	// Перевод черновика приемки в работу (только для сотрудников ПВЗ)
+	// (POST /receptions/{receptionId}/start)
+	PostReceptionsReceptionIdStart(ctx context.Context, request PostReceptionsReceptionIdStartRequestObject) (PostReceptionsReceptionIdStartResponseObject, error)
+	// Подтверждение закрытой приемки (только для модераторов)
+	// (POST /receptions/{receptionId}/verify)
+	PostReceptionsReceptionIdVerify(ctx context.Context, request PostReceptionsReceptionIdVerifyRequestObject) (PostReceptionsReceptionIdVerifyResponseObject, error)
+	// Регистрация пользователя
+	// (POST /register)
+	PostRegister(ctx context.Context, request PostRegisterRequestObject) (PostRegisterResponseObject, error)
+	// Отчет о скорости приемок и нарушениях SLA по сотрудникам и ПВЗ (только для модераторов)
+	// (GET /reports/productivity)
+	GetReportsProductivity(ctx context.Context, request GetReportsProductivityRequestObject) (GetReportsProductivityResponseObject, error)
+	// Сохраненный ежедневный отчет о скорости приемок (только для модераторов)
+	// (GET /reports/productivity/daily/{date})
+	GetReportsProductivityDailyDate(ctx context.Context, request GetReportsProductivityDailyDateRequestObject) (GetReportsProductivityDailyDateResponseObject, error)
+	// Открытие отправки возвратов в ПВЗ (только для сотрудников ПВЗ)
+	// (POST /return_shipments)
+	PostReturnShipments(ctx context.Context, request PostReturnShipmentsRequestObject) (PostReturnShipmentsResponseObject, error)
+	// Получение отправки возвратов вместе с возвратами (для всех ролей)
+	// (GET /return_shipments/{shipmentId})
+	GetReturnShipmentsShipmentId(ctx context.Context, request GetReturnShipmentsShipmentIdRequestObject) (GetReturnShipmentsShipmentIdResponseObject, error)
+	// Оформление возврата или отказа от товара на стойке ПВЗ (только для сотрудников ПВЗ)
+	// (POST /returns)
+	PostReturns(ctx context.Context, request PostReturnsRequestObject) (PostReturnsResponseObject, error)
+	// Статистика приемок по ПВЗ, городам, типам товаров и периодам (только для модераторов)
+	// (GET /stats/receptions)
+	GetStatsReceptions(ctx context.Context, request GetStatsReceptionsRequestObject) (GetStatsReceptionsResponseObject, error)
+	// Создание перемещения товаров на хранении в другой ПВЗ (только для сотрудников ПВЗ)
+	// (POST /transfers)
+	PostTransfers(ctx context.Context, request PostTransfersRequestObject) (PostTransfersResponseObject, error)
+	// Перемещения в пути (только для модераторов)
+	// (GET /transfers/in_transit)
+	GetTransfersInTransit(ctx context.Context, request GetTransfersInTransitRequestObject) (GetTransfersInTransitResponseObject, error)
+	// Получение перемещения (для всех ролей)
+	// (GET /transfers/{transferId})
+	GetTransfersTransferId(ctx context.Context, request GetTransfersTransferIdRequestObject) (GetTransfersTransferIdResponseObject, error)
+	// Отправка перемещения из ПВЗ отправления, товары переходят в статус in_transit (только для сотрудников ПВЗ)
+	// (POST /transfers/{transferId}/dispatch)
+	PostTransfersTransferIdDispatch(ctx context.Context, request PostTransfersTransferIdDispatchRequestObject) (PostTransfersTransferIdDispatchResponseObject, error)
+	// Получение перемещения в ПВЗ назначения через приемку типа transfer (только для сотрудников ПВЗ)
+	// (POST /transfers/{transferId}/receive)
+	PostTransfersTransferIdReceive(ctx context.Context, request PostTransfersTransferIdReceiveRequestObject) (PostTransfersTransferIdReceiveResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// GetAttachmentsAttachmentId operation middleware
+func (sh *strictHandler) GetAttachmentsAttachmentId(w http.ResponseWriter, r *http.Request, attachmentId openapi_types.UUID) {
+	var request GetAttachmentsAttachmentIdRequestObject
+
+	request.AttachmentId = attachmentId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAttachmentsAttachmentId(ctx, request.(GetAttachmentsAttachmentIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAttachmentsAttachmentId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAttachmentsAttachmentIdResponseObject); ok {
+		if err := validResponse.VisitGetAttachmentsAttachmentIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostDummyLogin operation middleware
+func (sh *strictHandler) PostDummyLogin(w http.ResponseWriter, r *http.Request) {
+	var request PostDummyLoginRequestObject
+
+	var body PostDummyLoginJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostDummyLogin(ctx, request.(PostDummyLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostDummyLogin")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostDummyLoginResponseObject); ok {
+		if err := validResponse.VisitPostDummyLoginResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetExportPvz operation middleware
+func (sh *strictHandler) GetExportPvz(w http.ResponseWriter, r *http.Request, params GetExportPvzParams) {
+	var request GetExportPvzRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetExportPvz(ctx, request.(GetExportPvzRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetExportPvz")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetExportPvzResponseObject); ok {
+		if err := validResponse.VisitGetExportPvzResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetExportReceptions operation middleware
+func (sh *strictHandler) GetExportReceptions(w http.ResponseWriter, r *http.Request, params GetExportReceptionsParams) {
+	var request GetExportReceptionsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetExportReceptions(ctx, request.(GetExportReceptionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetExportReceptions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetExportReceptionsResponseObject); ok {
+		if err := validResponse.VisitGetExportReceptionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostLogin operation middleware
+func (sh *strictHandler) PostLogin(w http.ResponseWriter, r *http.Request) {
+	var request PostLoginRequestObject
+
+	var body PostLoginJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostLogin(ctx, request.(PostLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostLogin")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostLoginResponseObject); ok {
+		if err := validResponse.VisitPostLoginResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostManifests operation middleware
+func (sh *strictHandler) PostManifests(w http.ResponseWriter, r *http.Request) {
+	var request PostManifestsRequestObject
+
+	var body PostManifestsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostManifests(ctx, request.(PostManifestsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostManifests")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostManifestsResponseObject); ok {
+		if err := validResponse.VisitPostManifestsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetManifestsManifestId operation middleware
+func (sh *strictHandler) GetManifestsManifestId(w http.ResponseWriter, r *http.Request, manifestId openapi_types.UUID) {
+	var request GetManifestsManifestIdRequestObject
+
+	request.ManifestId = manifestId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetManifestsManifestId(ctx, request.(GetManifestsManifestIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetManifestsManifestId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetManifestsManifestIdResponseObject); ok {
+		if err := validResponse.VisitGetManifestsManifestIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostOrders operation middleware
+func (sh *strictHandler) PostOrders(w http.ResponseWriter, r *http.Request) {
+	var request PostOrdersRequestObject
+
+	var body PostOrdersJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostOrders(ctx, request.(PostOrdersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostOrders")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostOrdersResponseObject); ok {
+		if err := validResponse.VisitPostOrdersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetOrdersOrderId operation middleware
+func (sh *strictHandler) GetOrdersOrderId(w http.ResponseWriter, r *http.Request, orderId openapi_types.UUID) {
+	var request GetOrdersOrderIdRequestObject
+
+	request.OrderId = orderId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOrdersOrderId(ctx, request.(GetOrdersOrderIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOrdersOrderId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetOrdersOrderIdResponseObject); ok {
+		if err := validResponse.VisitGetOrdersOrderIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostOrdersOrderIdIssue operation middleware
+func (sh *strictHandler) PostOrdersOrderIdIssue(w http.ResponseWriter, r *http.Request, orderId openapi_types.UUID) {
+	var request PostOrdersOrderIdIssueRequestObject
+
+	request.OrderId = orderId
+
+	var body PostOrdersOrderIdIssueJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostOrdersOrderIdIssue(ctx, request.(PostOrdersOrderIdIssueRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostOrdersOrderIdIssue")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostOrdersOrderIdIssueResponseObject); ok {
+		if err := validResponse.VisitPostOrdersOrderIdIssueResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostOrdersOrderIdPickupCode operation middleware
+func (sh *strictHandler) PostOrdersOrderIdPickupCode(w http.ResponseWriter, r *http.Request, orderId openapi_types.UUID) {
+	var request PostOrdersOrderIdPickupCodeRequestObject
+
+	request.OrderId = orderId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostOrdersOrderIdPickupCode(ctx, request.(PostOrdersOrderIdPickupCodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostOrdersOrderIdPickupCode")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostOrdersOrderIdPickupCodeResponseObject); ok {
+		if err := validResponse.VisitPostOrdersOrderIdPickupCodeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetProductTypes operation middleware
+func (sh *strictHandler) GetProductTypes(w http.ResponseWriter, r *http.Request) {
+	var request GetProductTypesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProductTypes(ctx, request.(GetProductTypesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProductTypes")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetProductTypesResponseObject); ok {
+		if err := validResponse.VisitGetProductTypesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostProductTypes operation middleware
+func (sh *strictHandler) PostProductTypes(w http.ResponseWriter, r *http.Request) {
+	var request PostProductTypesRequestObject
+
+	var body PostProductTypesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProductTypes(ctx, request.(PostProductTypesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProductTypes")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostProductTypesResponseObject); ok {
+		if err := validResponse.VisitPostProductTypesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutProductTypesTypeName operation middleware
+func (sh *strictHandler) PutProductTypesTypeName(w http.ResponseWriter, r *http.Request, typeName string) {
+	var request PutProductTypesTypeNameRequestObject
+
+	request.TypeName = typeName
+
+	var body PutProductTypesTypeNameJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutProductTypesTypeName(ctx, request.(PutProductTypesTypeNameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutProductTypesTypeName")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutProductTypesTypeNameResponseObject); ok {
+		if err := validResponse.VisitPutProductTypesTypeNameResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostProducts operation middleware
+func (sh *strictHandler) PostProducts(w http.ResponseWriter, r *http.Request) {
+	var request PostProductsRequestObject
+
+	var body PostProductsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProducts(ctx, request.(PostProductsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProducts")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostProductsResponseObject); ok {
+		if err := validResponse.VisitPostProductsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostProductsBatch operation middleware
+func (sh *strictHandler) PostProductsBatch(w http.ResponseWriter, r *http.Request) {
+	var request PostProductsBatchRequestObject
+
+	var body PostProductsBatchJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProductsBatch(ctx, request.(PostProductsBatchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProductsBatch")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostProductsBatchResponseObject); ok {
+		if err := validResponse.VisitPostProductsBatchResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteProductsProductId operation middleware
+func (sh *strictHandler) DeleteProductsProductId(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID) {
+	var request DeleteProductsProductIdRequestObject
+
+	request.ProductId = productId
+
+	var body DeleteProductsProductIdJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteProductsProductId(ctx, request.(DeleteProductsProductIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteProductsProductId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteProductsProductIdResponseObject); ok {
+		if err := validResponse.VisitDeleteProductsProductIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchProductsProductId operation middleware
+func (sh *strictHandler) PatchProductsProductId(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID) {
+	var request PatchProductsProductIdRequestObject
+
+	request.ProductId = productId
+
+	var body PatchProductsProductIdJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchProductsProductId(ctx, request.(PatchProductsProductIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchProductsProductId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchProductsProductIdResponseObject); ok {
+		if err := validResponse.VisitPatchProductsProductIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetProductsProductIdAttachments operation middleware
+func (sh *strictHandler) GetProductsProductIdAttachments(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID) {
+	var request GetProductsProductIdAttachmentsRequestObject
+
+	request.ProductId = productId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProductsProductIdAttachments(ctx, request.(GetProductsProductIdAttachmentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProductsProductIdAttachments")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetProductsProductIdAttachmentsResponseObject); ok {
+		if err := validResponse.VisitGetProductsProductIdAttachmentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostProductsProductIdAttachments operation middleware
+func (sh *strictHandler) PostProductsProductIdAttachments(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID) {
+	var request PostProductsProductIdAttachmentsRequestObject
+
+	request.ProductId = productId
+
+	if reader, err := r.MultipartReader(); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode multipart body: %w", err))
+		return
+	} else {
+		request.Body = reader
+	}
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProductsProductIdAttachments(ctx, request.(PostProductsProductIdAttachmentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProductsProductIdAttachments")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostProductsProductIdAttachmentsResponseObject); ok {
+		if err := validResponse.VisitPostProductsProductIdAttachmentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostProductsProductIdIssue operation middleware
+func (sh *strictHandler) PostProductsProductIdIssue(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID) {
+	var request PostProductsProductIdIssueRequestObject
+
+	request.ProductId = productId
+
+	var body PostProductsProductIdIssueJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProductsProductIdIssue(ctx, request.(PostProductsProductIdIssueRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProductsProductIdIssue")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostProductsProductIdIssueResponseObject); ok {
+		if err := validResponse.VisitPostProductsProductIdIssueResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetProductsProductIdLocation operation middleware
+func (sh *strictHandler) GetProductsProductIdLocation(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID) {
+	var request GetProductsProductIdLocationRequestObject
+
+	request.ProductId = productId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProductsProductIdLocation(ctx, request.(GetProductsProductIdLocationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProductsProductIdLocation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetProductsProductIdLocationResponseObject); ok {
+		if err := validResponse.VisitGetProductsProductIdLocationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostProductsProductIdMove operation middleware
+func (sh *strictHandler) PostProductsProductIdMove(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID) {
+	var request PostProductsProductIdMoveRequestObject
+
+	request.ProductId = productId
+
+	var body PostProductsProductIdMoveJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProductsProductIdMove(ctx, request.(PostProductsProductIdMoveRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProductsProductIdMove")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostProductsProductIdMoveResponseObject); ok {
+		if err := validResponse.VisitPostProductsProductIdMoveResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostProductsProductIdPickupCode operation middleware
+func (sh *strictHandler) PostProductsProductIdPickupCode(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID) {
+	var request PostProductsProductIdPickupCodeRequestObject
+
+	request.ProductId = productId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProductsProductIdPickupCode(ctx, request.(PostProductsProductIdPickupCodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProductsProductIdPickupCode")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostProductsProductIdPickupCodeResponseObject); ok {
+		if err := validResponse.VisitPostProductsProductIdPickupCodeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostProductsProductIdReturnToSender operation middleware
+func (sh *strictHandler) PostProductsProductIdReturnToSender(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID) {
+	var request PostProductsProductIdReturnToSenderRequestObject
+
+	request.ProductId = productId
+
+	var body PostProductsProductIdReturnToSenderJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProductsProductIdReturnToSender(ctx, request.(PostProductsProductIdReturnToSenderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProductsProductIdReturnToSender")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostProductsProductIdReturnToSenderResponseObject); ok {
+		if err := validResponse.VisitPostProductsProductIdReturnToSenderResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPvz operation middleware
+func (sh *strictHandler) GetPvz(w http.ResponseWriter, r *http.Request, params GetPvzParams) {
+	var request GetPvzRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPvz(ctx, request.(GetPvzRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPvz")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPvzResponseObject); ok {
+		if err := validResponse.VisitGetPvzResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPvz operation middleware
+func (sh *strictHandler) PostPvz(w http.ResponseWriter, r *http.Request) {
+	var request PostPvzRequestObject
+
+	var body PostPvzJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPvz(ctx, request.(PostPvzRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPvz")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPvzResponseObject); ok {
+		if err := validResponse.VisitPostPvzResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPvzPvzIdCapacity operation middleware
+func (sh *strictHandler) GetPvzPvzIdCapacity(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
+	var request GetPvzPvzIdCapacityRequestObject
+
+	request.PvzId = pvzId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPvzPvzIdCapacity(ctx, request.(GetPvzPvzIdCapacityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPvzPvzIdCapacity")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPvzPvzIdCapacityResponseObject); ok {
+		if err := validResponse.VisitGetPvzPvzIdCapacityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPvzPvzIdCells operation middleware
+func (sh *strictHandler) GetPvzPvzIdCells(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
+	var request GetPvzPvzIdCellsRequestObject
+
+	request.PvzId = pvzId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPvzPvzIdCells(ctx, request.(GetPvzPvzIdCellsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPvzPvzIdCells")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPvzPvzIdCellsResponseObject); ok {
+		if err := validResponse.VisitGetPvzPvzIdCellsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPvzPvzIdCells operation middleware
+func (sh *strictHandler) PostPvzPvzIdCells(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
+	var request PostPvzPvzIdCellsRequestObject
+
+	request.PvzId = pvzId
+
+	var body PostPvzPvzIdCellsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPvzPvzIdCells(ctx, request.(PostPvzPvzIdCellsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPvzPvzIdCells")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPvzPvzIdCellsResponseObject); ok {
+		if err := validResponse.VisitPostPvzPvzIdCellsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPvzPvzIdCloseLastReception operation middleware
+func (sh *strictHandler) PostPvzPvzIdCloseLastReception(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
+	var request PostPvzPvzIdCloseLastReceptionRequestObject
+
+	request.PvzId = pvzId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPvzPvzIdCloseLastReception(ctx, request.(PostPvzPvzIdCloseLastReceptionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPvzPvzIdCloseLastReception")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPvzPvzIdCloseLastReceptionResponseObject); ok {
+		if err := validResponse.VisitPostPvzPvzIdCloseLastReceptionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPvzPvzIdCloseLastReturnShipment operation middleware
+func (sh *strictHandler) PostPvzPvzIdCloseLastReturnShipment(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
+	var request PostPvzPvzIdCloseLastReturnShipmentRequestObject
+
+	request.PvzId = pvzId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPvzPvzIdCloseLastReturnShipment(ctx, request.(PostPvzPvzIdCloseLastReturnShipmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPvzPvzIdCloseLastReturnShipment")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPvzPvzIdCloseLastReturnShipmentResponseObject); ok {
+		if err := validResponse.VisitPostPvzPvzIdCloseLastReturnShipmentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPvzPvzIdDeleteLastProduct operation middleware
+func (sh *strictHandler) PostPvzPvzIdDeleteLastProduct(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
+	var request PostPvzPvzIdDeleteLastProductRequestObject
+
+	request.PvzId = pvzId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPvzPvzIdDeleteLastProduct(ctx, request.(PostPvzPvzIdDeleteLastProductRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPvzPvzIdDeleteLastProduct")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPvzPvzIdDeleteLastProductResponseObject); ok {
+		if err := validResponse.VisitPostPvzPvzIdDeleteLastProductResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutPvzPvzIdLimits operation middleware
+func (sh *strictHandler) PutPvzPvzIdLimits(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
+	var request PutPvzPvzIdLimitsRequestObject
+
+	request.PvzId = pvzId
+
+	var body PutPvzPvzIdLimitsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutPvzPvzIdLimits(ctx, request.(PutPvzPvzIdLimitsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutPvzPvzIdLimits")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutPvzPvzIdLimitsResponseObject); ok {
+		if err := validResponse.VisitPutPvzPvzIdLimitsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPvzPvzIdStock operation middleware
+func (sh *strictHandler) GetPvzPvzIdStock(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID, params GetPvzPvzIdStockParams) {
+	var request GetPvzPvzIdStockRequestObject
+
+	request.PvzId = pvzId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPvzPvzIdStock(ctx, request.(GetPvzPvzIdStockRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPvzPvzIdStock")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPvzPvzIdStockResponseObject); ok {
+		if err := validResponse.VisitGetPvzPvzIdStockResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostPvzPvzIdDeleteLastProduct400JSONResponse Error
+// GetReceptions operation middleware
+func (sh *strictHandler) GetReceptions(w http.ResponseWriter, r *http.Request, params GetReceptionsParams) {
+	var request GetReceptionsRequestObject
 
-func (response PostPvzPvzIdDeleteLastProduct400JSONResponse) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetReceptions(ctx, request.(GetReceptionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetReceptions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetReceptionsResponseObject); ok {
+		if err := validResponse.VisitGetReceptionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostPvzPvzIdDeleteLastProduct403JSONResponse Error
+// PostReceptions operation middleware
+func (sh *strictHandler) PostReceptions(w http.ResponseWriter, r *http.Request) {
+	var request PostReceptionsRequestObject
 
-func (response PostPvzPvzIdDeleteLastProduct403JSONResponse) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	var body PostReceptionsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostReceptions(ctx, request.(PostReceptionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostReceptions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostReceptionsResponseObject); ok {
+		if err := validResponse.VisitPostReceptionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostPvzPvzIdDeleteLastProduct500JSONResponse Error
+// GetReceptionsReceptionId operation middleware
+func (sh *strictHandler) GetReceptionsReceptionId(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID, params GetReceptionsReceptionIdParams) {
+	var request GetReceptionsReceptionIdRequestObject
 
-func (response PostPvzPvzIdDeleteLastProduct500JSONResponse) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.ReceptionId = receptionId
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetReceptionsReceptionId(ctx, request.(GetReceptionsReceptionIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetReceptionsReceptionId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetReceptionsReceptionIdResponseObject); ok {
+		if err := validResponse.VisitGetReceptionsReceptionIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostReceptionsRequestObject struct {
-	Body *PostReceptionsJSONRequestBody
+// GetReceptionsReceptionIdAttachments operation middleware
+func (sh *strictHandler) GetReceptionsReceptionIdAttachments(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID) {
+	var request GetReceptionsReceptionIdAttachmentsRequestObject
+
+	request.ReceptionId = receptionId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetReceptionsReceptionIdAttachments(ctx, request.(GetReceptionsReceptionIdAttachmentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetReceptionsReceptionIdAttachments")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetReceptionsReceptionIdAttachmentsResponseObject); ok {
+		if err := validResponse.VisitGetReceptionsReceptionIdAttachmentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostReceptionsResponseObject interface {
-	VisitPostReceptionsResponse(w http.ResponseWriter) error
+// PostReceptionsReceptionIdAttachments operation middleware
+func (sh *strictHandler) PostReceptionsReceptionIdAttachments(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID) {
+	var request PostReceptionsReceptionIdAttachmentsRequestObject
+
+	request.ReceptionId = receptionId
+
+	if reader, err := r.MultipartReader(); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode multipart body: %w", err))
+		return
+	} else {
+		request.Body = reader
+	}
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostReceptionsReceptionIdAttachments(ctx, request.(PostReceptionsReceptionIdAttachmentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostReceptionsReceptionIdAttachments")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostReceptionsReceptionIdAttachmentsResponseObject); ok {
+		if err := validResponse.VisitPostReceptionsReceptionIdAttachmentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostReceptions201JSONResponse Reception
+// PostReceptionsReceptionIdCancel operation middleware
+func (sh *strictHandler) PostReceptionsReceptionIdCancel(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID) {
+	var request PostReceptionsReceptionIdCancelRequestObject
 
-func (response PostReceptions201JSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	request.ReceptionId = receptionId
 
-	return json.NewEncoder(w).Encode(response)
+	var body PostReceptionsReceptionIdCancelJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostReceptionsReceptionIdCancel(ctx, request.(PostReceptionsReceptionIdCancelRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostReceptionsReceptionIdCancel")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostReceptionsReceptionIdCancelResponseObject); ok {
+		if err := validResponse.VisitPostReceptionsReceptionIdCancelResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostReceptions400JSONResponse Error
+// PostReceptionsReceptionIdCloseWithOverride operation middleware
+func (sh *strictHandler) PostReceptionsReceptionIdCloseWithOverride(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID) {
+	var request PostReceptionsReceptionIdCloseWithOverrideRequestObject
 
-func (response PostReceptions400JSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.ReceptionId = receptionId
 
-	return json.NewEncoder(w).Encode(response)
+	var body PostReceptionsReceptionIdCloseWithOverrideJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostReceptionsReceptionIdCloseWithOverride(ctx, request.(PostReceptionsReceptionIdCloseWithOverrideRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostReceptionsReceptionIdCloseWithOverride")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostReceptionsReceptionIdCloseWithOverrideResponseObject); ok {
+		if err := validResponse.VisitPostReceptionsReceptionIdCloseWithOverrideResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetReceptionsReceptionIdDeletedProducts operation middleware
+func (sh *strictHandler) GetReceptionsReceptionIdDeletedProducts(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID) {
+	var request GetReceptionsReceptionIdDeletedProductsRequestObject
+
+	request.ReceptionId = receptionId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetReceptionsReceptionIdDeletedProducts(ctx, request.(GetReceptionsReceptionIdDeletedProductsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetReceptionsReceptionIdDeletedProducts")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetReceptionsReceptionIdDeletedProductsResponseObject); ok {
+		if err := validResponse.VisitGetReceptionsReceptionIdDeletedProductsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetReceptionsReceptionIdDiscrepancy operation middleware
+func (sh *strictHandler) GetReceptionsReceptionIdDiscrepancy(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID) {
+	var request GetReceptionsReceptionIdDiscrepancyRequestObject
+
+	request.ReceptionId = receptionId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetReceptionsReceptionIdDiscrepancy(ctx, request.(GetReceptionsReceptionIdDiscrepancyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetReceptionsReceptionIdDiscrepancy")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetReceptionsReceptionIdDiscrepancyResponseObject); ok {
+		if err := validResponse.VisitGetReceptionsReceptionIdDiscrepancyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetReceptionsReceptionIdHistory operation middleware
+func (sh *strictHandler) GetReceptionsReceptionIdHistory(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID) {
+	var request GetReceptionsReceptionIdHistoryRequestObject
+
+	request.ReceptionId = receptionId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetReceptionsReceptionIdHistory(ctx, request.(GetReceptionsReceptionIdHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetReceptionsReceptionIdHistory")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetReceptionsReceptionIdHistoryResponseObject); ok {
+		if err := validResponse.VisitGetReceptionsReceptionIdHistoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostReceptionsReceptionIdReopen operation middleware
+func (sh *strictHandler) PostReceptionsReceptionIdReopen(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID) {
+	var request PostReceptionsReceptionIdReopenRequestObject
+
+	request.ReceptionId = receptionId
+
+	var body PostReceptionsReceptionIdReopenJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostReceptionsReceptionIdReopen(ctx, request.(PostReceptionsReceptionIdReopenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostReceptionsReceptionIdReopen")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostReceptionsReceptionIdReopenResponseObject); ok {
+		if err := validResponse.VisitPostReceptionsReceptionIdReopenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostReceptions403JSONResponse Error
-
-func (response PostReceptions403JSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+// PostReceptionsReceptionIdStart operation middleware
+func (sh *strictHandler) PostReceptionsReceptionIdStart(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID) {
+	var request PostReceptionsReceptionIdStartRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.ReceptionId = receptionId
 
-type PostReceptions500JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostReceptionsReceptionIdStart(ctx, request.(PostReceptionsReceptionIdStartRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostReceptionsReceptionIdStart")
+	}
 
-func (response PostReceptions500JSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostReceptionsReceptionIdStartResponseObject); ok {
+		if err := validResponse.VisitPostReceptionsReceptionIdStartResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostRegisterRequestObject struct {
-	Body *PostRegisterJSONRequestBody
-}
+// PostReceptionsReceptionIdVerify operation middleware
+func (sh *strictHandler) PostReceptionsReceptionIdVerify(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID) {
+	var request PostReceptionsReceptionIdVerifyRequestObject
 
-type PostRegisterResponseObject interface {
-	VisitPostRegisterResponse(w http.ResponseWriter) error
-}
+	request.ReceptionId = receptionId
 
-type PostRegister201JSONResponse User
+	var body PostReceptionsReceptionIdVerifyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func (response PostRegister201JSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostReceptionsReceptionIdVerify(ctx, request.(PostReceptionsReceptionIdVerifyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostReceptionsReceptionIdVerify")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostReceptionsReceptionIdVerifyResponseObject); ok {
+		if err := validResponse.VisitPostReceptionsReceptionIdVerifyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostRegister400JSONResponse Error
+// PostRegister operation middleware
+func (sh *strictHandler) PostRegister(w http.ResponseWriter, r *http.Request) {
+	var request PostRegisterRequestObject
 
-func (response PostRegister400JSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var body PostRegisterJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostRegister(ctx, request.(PostRegisterRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostRegister")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostRegisterResponseObject); ok {
+		if err := validResponse.VisitPostRegisterResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostRegister500JSONResponse Error
+// GetReportsProductivity operation middleware
+func (sh *strictHandler) GetReportsProductivity(w http.ResponseWriter, r *http.Request, params GetReportsProductivityParams) {
+	var request GetReportsProductivityRequestObject
 
-func (response PostRegister500JSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetReportsProductivity(ctx, request.(GetReportsProductivityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetReportsProductivity")
+	}
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Получение тестового токена
-	// (POST /dummyLogin)
-	PostDummyLogin(ctx context.Context, request PostDummyLoginRequestObject) (PostDummyLoginResponseObject, error)
-	// Авторизация пользователя
-	// (POST /login)
-	PostLogin(ctx context.Context, request PostLoginRequestObject) (PostLoginResponseObject, error)
-	// Добавление товара в текущую приемку (только для сотрудников ПВЗ)
-	// (POST /products)
-	PostProducts(ctx context.Context, request PostProductsRequestObject) (PostProductsResponseObject, error)
-	// Получение списка ПВЗ с фильтрацией по дате приемки и пагинацией (для всех ролей)
-	// (GET /pvz)
-	GetPvz(ctx context.Context, request GetPvzRequestObject) (GetPvzResponseObject, error)
-	// Создание ПВЗ (только для модераторов)
-	// (POST /pvz)
-	PostPvz(ctx context.Context, request PostPvzRequestObject) (PostPvzResponseObject, error)
-	// Закрытие последней открытой приемки товаров в рамках ПВЗ (только для сотрудников ПВЗ)
-	// (POST /pvz/{pvzId}/close_last_reception)
-	PostPvzPvzIdCloseLastReception(ctx context.Context, request PostPvzPvzIdCloseLastReceptionRequestObject) (PostPvzPvzIdCloseLastReceptionResponseObject, error)
-	// Удаление последнего добавленного товара из текущей приемки (LIFO, только для сотрудников ПВЗ)
-	// (POST /pvz/{pvzId}/delete_last_product)
-	PostPvzPvzIdDeleteLastProduct(ctx context.Context, request PostPvzPvzIdDeleteLastProductRequestObject) (PostPvzPvzIdDeleteLastProductResponseObject, error)
-	// Создание новой приемки товаров (только для сотрудников ПВЗ)
-	// (POST /receptions)
-	PostReceptions(ctx context.Context, request PostReceptionsRequestObject) (PostReceptionsResponseObject, error)
-	// Регистрация пользователя
-	// (POST /register)
-	PostRegister(ctx context.Context, request PostRegisterRequestObject) (PostRegisterResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetReportsProductivityResponseObject); ok {
+		if err := validResponse.VisitGetReportsProductivityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+// GetReportsProductivityDailyDate operation middleware
+func (sh *strictHandler) GetReportsProductivityDailyDate(w http.ResponseWriter, r *http.Request, date openapi_types.Date) {
+	var request GetReportsProductivityDailyDateRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	request.Date = date
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetReportsProductivityDailyDate(ctx, request.(GetReportsProductivityDailyDateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetReportsProductivityDailyDate")
+	}
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetReportsProductivityDailyDateResponseObject); ok {
+		if err := validResponse.VisitGetReportsProductivityDailyDateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// PostDummyLogin operation middleware
-func (sh *strictHandler) PostDummyLogin(w http.ResponseWriter, r *http.Request) {
-	var request PostDummyLoginRequestObject
+// PostReturnShipments operation middleware
+func (sh *strictHandler) PostReturnShipments(w http.ResponseWriter, r *http.Request) {
+	var request PostReturnShipmentsRequestObject
 
-	var body PostDummyLoginJSONRequestBody
+	var body PostReturnShipmentsJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1088,18 +8204,18 @@ func (sh *strictHandler) PostDummyLogin(w http.ResponseWriter, r *http.Request)
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostDummyLogin(ctx, request.(PostDummyLoginRequestObject))
+		return sh.ssi.PostReturnShipments(ctx, request.(PostReturnShipmentsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostDummyLogin")
+		handler = middleware(handler, "PostReturnShipments")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostDummyLoginResponseObject); ok {
-		if err := validResponse.VisitPostDummyLoginResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostReturnShipmentsResponseObject); ok {
+		if err := validResponse.VisitPostReturnShipmentsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1107,30 +8223,25 @@ func (sh *strictHandler) PostDummyLogin(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// PostLogin operation middleware
-func (sh *strictHandler) PostLogin(w http.ResponseWriter, r *http.Request) {
-	var request PostLoginRequestObject
+// GetReturnShipmentsShipmentId operation middleware
+func (sh *strictHandler) GetReturnShipmentsShipmentId(w http.ResponseWriter, r *http.Request, shipmentId openapi_types.UUID) {
+	var request GetReturnShipmentsShipmentIdRequestObject
 
-	var body PostLoginJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.ShipmentId = shipmentId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostLogin(ctx, request.(PostLoginRequestObject))
+		return sh.ssi.GetReturnShipmentsShipmentId(ctx, request.(GetReturnShipmentsShipmentIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostLogin")
+		handler = middleware(handler, "GetReturnShipmentsShipmentId")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostLoginResponseObject); ok {
-		if err := validResponse.VisitPostLoginResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetReturnShipmentsShipmentIdResponseObject); ok {
+		if err := validResponse.VisitGetReturnShipmentsShipmentIdResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1138,11 +8249,11 @@ func (sh *strictHandler) PostLogin(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// PostProducts operation middleware
-func (sh *strictHandler) PostProducts(w http.ResponseWriter, r *http.Request) {
-	var request PostProductsRequestObject
+// PostReturns operation middleware
+func (sh *strictHandler) PostReturns(w http.ResponseWriter, r *http.Request) {
+	var request PostReturnsRequestObject
 
-	var body PostProductsJSONRequestBody
+	var body PostReturnsJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1150,18 +8261,18 @@ func (sh *strictHandler) PostProducts(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostProducts(ctx, request.(PostProductsRequestObject))
+		return sh.ssi.PostReturns(ctx, request.(PostReturnsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostProducts")
+		handler = middleware(handler, "PostReturns")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostProductsResponseObject); ok {
-		if err := validResponse.VisitPostProductsResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostReturnsResponseObject); ok {
+		if err := validResponse.VisitPostReturnsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1169,25 +8280,25 @@ func (sh *strictHandler) PostProducts(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetPvz operation middleware
-func (sh *strictHandler) GetPvz(w http.ResponseWriter, r *http.Request, params GetPvzParams) {
-	var request GetPvzRequestObject
+// GetStatsReceptions operation middleware
+func (sh *strictHandler) GetStatsReceptions(w http.ResponseWriter, r *http.Request, params GetStatsReceptionsParams) {
+	var request GetStatsReceptionsRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetPvz(ctx, request.(GetPvzRequestObject))
+		return sh.ssi.GetStatsReceptions(ctx, request.(GetStatsReceptionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetPvz")
+		handler = middleware(handler, "GetStatsReceptions")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetPvzResponseObject); ok {
-		if err := validResponse.VisitGetPvzResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetStatsReceptionsResponseObject); ok {
+		if err := validResponse.VisitGetStatsReceptionsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1195,11 +8306,11 @@ func (sh *strictHandler) GetPvz(w http.ResponseWriter, r *http.Request, params G
 	}
 }
 
-// PostPvz operation middleware
-func (sh *strictHandler) PostPvz(w http.ResponseWriter, r *http.Request) {
-	var request PostPvzRequestObject
+// PostTransfers operation middleware
+func (sh *strictHandler) PostTransfers(w http.ResponseWriter, r *http.Request) {
+	var request PostTransfersRequestObject
 
-	var body PostPvzJSONRequestBody
+	var body PostTransfersJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1207,18 +8318,18 @@ func (sh *strictHandler) PostPvz(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPvz(ctx, request.(PostPvzRequestObject))
+		return sh.ssi.PostTransfers(ctx, request.(PostTransfersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPvz")
+		handler = middleware(handler, "PostTransfers")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostPvzResponseObject); ok {
-		if err := validResponse.VisitPostPvzResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostTransfersResponseObject); ok {
+		if err := validResponse.VisitPostTransfersResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1226,25 +8337,25 @@ func (sh *strictHandler) PostPvz(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// PostPvzPvzIdCloseLastReception operation middleware
-func (sh *strictHandler) PostPvzPvzIdCloseLastReception(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
-	var request PostPvzPvzIdCloseLastReceptionRequestObject
+// GetTransfersInTransit operation middleware
+func (sh *strictHandler) GetTransfersInTransit(w http.ResponseWriter, r *http.Request, params GetTransfersInTransitParams) {
+	var request GetTransfersInTransitRequestObject
 
-	request.PvzId = pvzId
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPvzPvzIdCloseLastReception(ctx, request.(PostPvzPvzIdCloseLastReceptionRequestObject))
+		return sh.ssi.GetTransfersInTransit(ctx, request.(GetTransfersInTransitRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPvzPvzIdCloseLastReception")
+		handler = middleware(handler, "GetTransfersInTransit")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostPvzPvzIdCloseLastReceptionResponseObject); ok {
-		if err := validResponse.VisitPostPvzPvzIdCloseLastReceptionResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetTransfersInTransitResponseObject); ok {
+		if err := validResponse.VisitGetTransfersInTransitResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1252,25 +8363,25 @@ func (sh *strictHandler) PostPvzPvzIdCloseLastReception(w http.ResponseWriter, r
 	}
 }
 
-// PostPvzPvzIdDeleteLastProduct operation middleware
-func (sh *strictHandler) PostPvzPvzIdDeleteLastProduct(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
-	var request PostPvzPvzIdDeleteLastProductRequestObject
+// GetTransfersTransferId operation middleware
+func (sh *strictHandler) GetTransfersTransferId(w http.ResponseWriter, r *http.Request, transferId openapi_types.UUID) {
+	var request GetTransfersTransferIdRequestObject
 
-	request.PvzId = pvzId
+	request.TransferId = transferId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPvzPvzIdDeleteLastProduct(ctx, request.(PostPvzPvzIdDeleteLastProductRequestObject))
+		return sh.ssi.GetTransfersTransferId(ctx, request.(GetTransfersTransferIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPvzPvzIdDeleteLastProduct")
+		handler = middleware(handler, "GetTransfersTransferId")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostPvzPvzIdDeleteLastProductResponseObject); ok {
-		if err := validResponse.VisitPostPvzPvzIdDeleteLastProductResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetTransfersTransferIdResponseObject); ok {
+		if err := validResponse.VisitGetTransfersTransferIdResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1278,30 +8389,25 @@ func (sh *strictHandler) PostPvzPvzIdDeleteLastProduct(w http.ResponseWriter, r
 	}
 }
 
-// PostReceptions operation middleware
-func (sh *strictHandler) PostReceptions(w http.ResponseWriter, r *http.Request) {
-	var request PostReceptionsRequestObject
+// PostTransfersTransferIdDispatch operation middleware
+func (sh *strictHandler) PostTransfersTransferIdDispatch(w http.ResponseWriter, r *http.Request, transferId openapi_types.UUID) {
+	var request PostTransfersTransferIdDispatchRequestObject
 
-	var body PostReceptionsJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.TransferId = transferId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostReceptions(ctx, request.(PostReceptionsRequestObject))
+		return sh.ssi.PostTransfersTransferIdDispatch(ctx, request.(PostTransfersTransferIdDispatchRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostReceptions")
+		handler = middleware(handler, "PostTransfersTransferIdDispatch")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostReceptionsResponseObject); ok {
-		if err := validResponse.VisitPostReceptionsResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostTransfersTransferIdDispatchResponseObject); ok {
+		if err := validResponse.VisitPostTransfersTransferIdDispatchResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1309,30 +8415,25 @@ func (sh *strictHandler) PostReceptions(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// PostRegister operation middleware
-func (sh *strictHandler) PostRegister(w http.ResponseWriter, r *http.Request) {
-	var request PostRegisterRequestObject
+// PostTransfersTransferIdReceive operation middleware
+func (sh *strictHandler) PostTransfersTransferIdReceive(w http.ResponseWriter, r *http.Request, transferId openapi_types.UUID) {
+	var request PostTransfersTransferIdReceiveRequestObject
 
-	var body PostRegisterJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.TransferId = transferId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostRegister(ctx, request.(PostRegisterRequestObject))
+		return sh.ssi.PostTransfersTransferIdReceive(ctx, request.(PostTransfersTransferIdReceiveRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostRegister")
+		handler = middleware(handler, "PostTransfersTransferIdReceive")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostRegisterResponseObject); ok {
-		if err := validResponse.VisitPostRegisterResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostTransfersTransferIdReceiveResponseObject); ok {
+		if err := validResponse.VisitPostTransfersTransferIdReceiveResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1343,38 +8444,193 @@ func (sh *strictHandler) PostRegister(w http.ResponseWriter, r *http.Request) {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xabW8bxxH+K4dtPzgAY1J1Cwj81tZNkUJAhdRNgTiCcSFX1CW8l+wt1dIGAZGsrQRS",
-	"46IIECBo6rr5AydKtM58Of2F2X9UzOwdySOPIiWxChvoi0Te7cvszDwzz8zyGSu5tuc63JE+Kz5jfmmP",
-	"2yZ9/I0QrsAPnnA9LqTF6bHNfd+scPwo6x5nReZLYTkV1mjkmOCf1yzBy6z4eDRwJ5cMdD/5lJcka+TY",
-	"9ocfza5csmQd/3OnZuMC8E+IVBN60IGA5Ri8hgCG0FOtd+EVdFULuuoATlRbHcApvv8WAjjHMep4YtNE",
-	"uhyzyrj6ritsU7Iiq9WsMssYJnjF8qUwpeU6D03JU5PKpuTvSsvmszOnjk+nyTy7cMu1kpw9P679CJde",
-	"csMrnKjEPTzO+8uN1w/GhlB/gz50UfPqACIYQgg9bZIIzqALb+As+Xqi2tDJ1P+UeuhtWrQsZX2QvL9F",
-	"dXn7T5dUlC9NWfMnVWU5TzzhVgT3fZZjparr88W6GJ0k2Xu0cpZKHrmfcScDfjn2R59nAJbbplVNHUc/",
-	"uYE/udWUf3Dbq7p1jvLbbpkLU7pi8akTKWi12YOienmpJixZ/wMGJX2YT7gpuPhlTe6Nv72XyPu7Pz1C",
-	"1dFoVozfjg+wJ6XHGriw5ey65ELcLwkr9i8MMBhROhCqpgFn0FcvDdWGC3UAAXQIAkMI1UsDXsE/4BsD",
-	"QoNehtCFAfQggreGakGE8YqA0sG9LVklYczSZ9wpGz4X+1YJVbXPha833rhfuF9Axboed0zPYkX2gB7l",
-	"mGfKPTp4vlyz7fqWW7E0FFyfIgga2kygzbZdXz4cj9P65r78lVumyFpyHckdmmh6XtUq0dT8p77Glw7+",
-	"sx60GnvPs3NqmBQ1Tg98z3V8vf3PCoUrCf9TwXdZkf0kP05t+Tiv5TV4aNMp43+vmnABXfUFDCFAIwfQ",
-	"QWuSgc8hUC/Q9miln69QHp1ks+T5DrrQIYccqiN4a1BqQ3eLVBOl+MWtSPEv9QWEcILx3lDNGB/4N9AI",
-	"rdm2Keo48hVE0FdtdahhAl2DMnQzRkQEpxBpePRohF4gX13s0at15iuEQ8/0/T+7oryY6yRLjGb8OPx8",
-	"49b9vGtoF1Kt+CtSCxjqL+vo9n/P0p4BF4SGYziP00ELuphPtM97mgH6l7v9djJqVZ6/PK+5RQKohboe",
-	"XFbnngknz/KF/yQZHX0xgpMxGViPZGBACH3kIkOEDeK5p1oQQgeGRElSHCXUMj+4BZm/RuFUCxnUWN6u",
-	"+jLR3HogOaaYrPg4TS4f7zR2UkD/Om37JMMlbC8woEMZD3qqrb5UbfVVSvOqbdyj4RgVehCNCGYTIoSV",
-	"asNZDKwIOjHFfCeOF/tPUQEVnhEpfsvl9v5TSj3CtLnkwqezzDhQoA4hoN3juH9GYSnADyFah6rniDIz",
-	"MmRWZJ/XuKizHHNMWwPZFJIq4tyEWZYrjWcE+pa26qrDa4vDnfKqhPkOIhigVxjksQeUckL1Qh3N2dsz",
-	"K+mNy3zXrFUlK27kmG05lo2Bc2O0t+VIXuFirib6EFLWQ7bUQZ6kA+4APU07GcI7mBIPunPEq1q2JefI",
-	"V8gx2/yLFvBBYYG0OzekKJbktp+ZiRZG5A8/SrUu/MuWm8inoyFLhfvRkU0hzHpqw0VrjDsTjUZGlZ5e",
-	"d4kRs6HrNVxgKYqMOY4H/4dhM6MsaMbnwrXjUlo1DfVXzGPqWDs48ijoUvbSsZIo1FQu0xU4BHAKIYWR",
-	"ZNK9OLhCh2R/bhBtQUS9fYeI/XzSRbH0unxroUPfMqtJtpwybqJziOBc8+v1KWvvuMk1QPZ6bEmCWGzh",
-	"TMIBA03aCWW6comgM2Ya+WdEyRt5al4+qZq+fJIKipeCZxvn/hpnbpm+HMfIGX5Cacsz5d5EUo17n2mA",
-	"ZKb37MLlxulq2XifAamJuBRol+qpA3WElGbNyoSLlKiqDW/QYzIkvgPilYH4zYQWCYgXdIWFmQfZPSW0",
-	"SLVGY2brs6kOMlUVSPjIWur5pdBespYYIbzMq1zGEPcmbqUWAvwhTUSEJ0zqB8X33IKdFBGsU7GeW7JM",
-	"nyrqp51idDkxOt64aXcH2yvD9vtJPWbB9lSDLN0BGE42tUddgBDOJ/oAMYdNmfbe1vvv/T5nXBfB6ZJo",
-	"Plg/GI+77Q7iVKtvPXp8V0ngk9x47RI4tQnUMcWGdN6mzvPkQe4iwqoY9TC+xVqUr+9dH9YVy5fxBf4l",
-	"oI5H/cDXYbPWhz4SSRhq7OgC4w2WGOipAyrQQxioNgyMTRwTwoAU2kdV3P/YSa0BHehBX32lDpMV+lSt",
-	"hDCkxoHuI+jrCHWYXBWF2vNOcTDmVf30RLWhBx11NL1JhqDqORnqJc46Muh1qEdeQFe9mBT7/sdY1diW",
-	"s8WdCvrQ5v/8lxIjA+Rucpm+uohKvzfJbjBk3X4dr2nLYd2uFP9NhCNMer2LrxQpson9hHrXRDX+tUsx",
-	"n6+6JbO65/qyuFnYLLDGTuO/AQAA//+KeFsL8ycAAA==",
+	"H4sIAAAAAAAC/+x9W3Mbx5noX5masw/2nqFIxc6WVy+nZMn2KiXbLFF2tnw5qjHQJCcCZpCZAXUrVYmE",
+	"ZdkrRUyy3pOcxLHj7Kna8xYIIiSIBMG/0P2Ptr6vu2e6Z3qAwYUUKMMPFgH09PTlu1/v2JWg3gh84seR",
+	"fe6OHVU2Sd3FP8/HsVvZrBM/hk9VElVCrxF7gW+fs+nv6QEd0Ge0Sw9pj3YtesTuwR+0T/dpz6I9ekB7",
+	"FtuhA9qhbXaPtq3X2Jd0AN9Y9Ai+Zvdolz6je2KOF47Ftuk+bdNDix7SNt2nB7RN9+ghHdAXr+OvAxjN",
+	"7tFntEf7dEC7FrsPc8MEbIdts12LdpTvYBHsGxj2JW3TF7jmju3YjTBokDD2CG60Evgx8eOrtxoEPhK/",
+	"WbfPfWp7dXeDLP+qQTZsR3xo+PC322jUvIoLR7HcqK7bnzt2jM/aURx6/oZ917ErIXFjUn37Fsy4HoR1",
+	"N7bP2c2mV7UNo6tuTK56daINhi+XYvjW8MS6VyMfuPyJ3I9etdRbG2FQbVbiS+VGh6RC8PpLjo+828QA",
+	"OH+lbfqc9uEa01tpw7XRJ/CJ7dA2u59O6Pkx2SChfReX8OumF5Iq3E5yAI52f+K96ZUEX/yKVGJYUArP",
+	"HzVqgYu70AEB5swv+Rer77znWKsfvCehevXiuxa7l+6DDmjfont0YJ1dseh39He2kx7PF57vhrfyB2TY",
+	"jnHVF9yGW/HiWx9F7obpPP8AkM522Q57CGjYoV22bb1Gn+IC+7TPHr5uAUIO6BP2b4Cf1mt0n7XoE9pj",
+	"D2Aw4CtgyDZOtIOY1WU77B48qSIw7cM8h7RtsXusBZjK7lv0B/p7+occSgnQigwL/hMd0AP5crZDO3Sg",
+	"vmZAO9mXGIDBsbeCWlMDf+W3G8Tb2IxNv2VOPVlm8kwysfEuSK0m7yMPPRVSq5XEjUpQNWNuMyI4wz+E",
+	"ZN0+Z/+P5ZQ6LwvSvKxDRHZLYhXiHWJG02YuelElJA3Xr9y6FJN6fj9uJW66NfMJk5sNUolJ1fzrdc+v",
+	"qpS07saVTQKLqntR5CERJTfj0OXfyJ9NdDS63jTA0P8H+KQ9dp/uA0+wAJAQdpD39AVD+FJAWNsB7Dxg",
+	"u8o45EEWgvsRa+mMCrgU22Yt/P8O7bAWIITpHmPBMobjdswJU3JmjjxacVIjbucKaQRhnL+f8ZnGphul",
+	"84pZxKAvgqBGXH8M7uHFpI4zJH8MA9ostCWHZ7th6N6Cz8EWCUOvWiW+eV3p7yW5qniAXCFuFPhGfBuP",
+	"qWXuVX3YcLjajuR5Ge+6yeUJssrJ0TthGIQGqP+bhFGLbQPEtpF09y32tY4OyI9YC6QzJKXsPn6bSEhI",
+	"XbcT+aqbo95fuGEhhaqTSHKiExUoMmcvl+Eki1Vfr09uOvPkjPWdF++u4P3GuW8Cwr4r9pO7xv9HB+we",
+	"ECm2Y9EOewi8mrXoc7hNB6SgLn0O5EkyXWTGKCKxh8i24euuvEwgY/Q5bdOnyFcBQvZZyzpfgf1br+Hv",
+	"rIXS8gF7ICjjY6sSbb1uOwmFrkRbtmPfrEU3jVT4fdf31kk0EyJ0LORFLrCItjS2bk8GlZm7+0HRctqO",
+	"0Hr4+e+DxIQMpWcBHgJLQV7zIseRLPqEPaQHgIQdFCC79NB2Rq8tit24GamcVeEpsHBvq4iNxqFXiQvE",
+	"R9hEl30DAMkeZTeTU+2A9tyjbbaNZCXR39gulw8T8B3QPbYj9qeMsmg/UePa4ozatmOg91ET9CwSjkZG",
+	"frvKE8mGkzMbRn814MmLdUGTa8B1z/fqcOxnTfJoOUEFT6xH9wCD8SCe6tJv+4xFf0xkGSDTVnS9mQAK",
+	"200Qn22rz6EGkr+n33B53hI00rFwM6ir0AOuvSMs4sWftR277t68TPyNeNM+909vDhF46p4vx511yok/",
+	"/BxN5/9hWCXhSZKWKGqS6vl4jInHIka4nyJK5DfrXxhh2rEbXuV6s3E+jkm9UVp1ooe0K7DskD1k9znq",
+	"HSH2opzbgWEAcZaQDgQn2aNt9oD2jMrVOOTSrd4a5yhDUvEaHvHjd+quVysSyviQ1c3AN0saKSUceRFr",
+	"fGgWKsU9OCnx0AlF7joKIddMNobJUJqcVCjkcdVa2L2O6IDrMrRDD5CWHrKH9AVgOfxGn9Me+wqx/4Wg",
+	"4GifaEsWJU1rKD7gG+BvvP7xBC+5r8LjWEuuJrOzH2H5bIe12La2RMeSxFEBS/7hCAbjuFTewe3QI843",
+	"hWgLhg8LQVGRadwbrhdzZVP+xDHfyCFXP/7EQPuFoi+npN/BNdB9OD/bsemPeIz7bGeJ/gBLxEU9YS12",
+	"jz6F3//Ed0gP2SP788kJVkg2vCgO0eJ40Y1LU8SsaQB2Y7q3VYT0CwJcM5Yc/Tc3jkkI1/m/P11Z+ufP",
+	"7/zT3X8Y+V5lCuPbOTbkX+3Gceh90YzJSEQXU5xPH7jrqBg4ynygmanfOf/B0tk3pLEPln32Z28hosHo",
+	"Q8GdD9gjRME+oBbYzDpC/u6/brT4JMahzFr+DtScvuDcXhqupaykLYx2BEkoIypq9uesECuW/1xMvkO7",
+	"8NnhcsETpDE92mFfg1VeWUOZ947PtKukRmJFRzdJ3EARuNraQtnpwHhCjqVbcga04+hPpBySbeNX4F3o",
+	"0b6682Qk7TvIXTmxOsLrPUwlsOK9CNEi6zFBOb/PdvUl5a+5h/KzKss5BUvgD8JV7oMk0AFQPKI9pFBd",
+	"0871w7Gdsa5oTGBKXj8xKHl14kde4JdF/4vpA+PQ1jHdGqUkD7EiKXukgnPm9P6CDEJy464wSEoogOtE",
+	"itChA/YAhyCZ4IPAUp670Nx6twKvOhIi8f2HrIXSpSIf5GBTcF9QbvsIV1n1sDRM3XBD3/M3ogKU7wL/",
+	"Z18nuNhN3p3F1F7Wz4gg10dz18NEiEJPB9Bz/oVcNWI/Fy+USdhDLlfhOvZYS/yV+iq7tG8rWkGBmpQK",
+	"/alDInsF6K7JkfnEe1POFyY0rFE2rzyXBCZbrXrwjFtbVZhvHDaJk13sbznbRAEHzlanvXhIg1RPpX1x",
+	"baDhgrEAIKRr0a7UeRHQ7eJ1vg1ugQLXxMwkg6GK7NQ0qJS6PCV4SCb1BLlDl3atsxZ6K+ruTWGvWMH/",
+	"nKEWDBNIDQGiC6DNDwEgk1uorDZrJkYmDpYLOzhSQAtxtGj9F7WLzazs32kbSAxMnQN0wWYzvlJxEaCp",
+	"PEMVhvuahAUQJJfcJa1YuEAdsDcTSNBuzxlhfKoJ4BrzsRtedeynMnAi3izncuQOhoDO5YDHThgO/jsB",
+	"CoPEbaHQXO0a8kEcpFYbhaNrcRC6GwTcuOO7KxKbSE5h74LFnX2DIo5gL1nSKAVOiwtxrIVSvzCICl0W",
+	"Yemb5OslORecBX0O/08fKiM/jWHJtjT7wj7t5Tagad3qSnlMQNfi8oAmHh+PLGV24aMJR5pydMeceMsQ",
+	"iFwFTmM4oz8qAtiBEQwdC00aKlfU+SB7rDpqVD7Yw3me4tBBlifOlN2VNdoWHU8pi05GEVNlKXPMiCWo",
+	"52MQvbbTmdhDS7oyIHAlioOQVB0LT+k5Ro212TeaXJhglNA+MMpFX8EzEOPYDpdc5ZUix+hoL6ddKyRx",
+	"M/TRpSLNPnI9CEywHNuxPf9aHLp+5MWpXcmxlYfln9fi4FpE/CoJzXYnfsYy+Kzo4tfwgscT2H6x9uEH",
+	"Fn8wA6YG3YE7CwYjhbPxFXxfhKkpvoWfcyZT2oXg80Cv3HEMwWlvy4uV0IkM5H7PdlDuAIjgtlHk1AgK",
+	"PVWqSENFtjEs5B6qtUITA8NBqlHwEBP9WUdzp3HrQweG9lCfb6PaDOTz0yh2wxiMe45F/Cr88XqODogf",
+	"yh/9BvFJ6MZjOjuqNfKe23jf8yXFyUsOYXCjvD8kfyNXghsmNSmquUPfmpzRhAbQ9HknOUvtrbnN60co",
+	"9l0O6mCPZnOJsHsLYtUzgEgG/qQOC8RLGzdIDFgG8BwY5Et3a+NikxuS10gl8KsFJ03qjVpwixCj6PBj",
+	"9lXq0hJjT86EVWxKM+NO4ktu047iKB7t3uNXWNqBdpRg/gDMDtaS+AolmmdILve5stHnRgDW0q6Cr9gp",
+	"cLFIv3guSqDLzY4HaIU4RJ0gB3z5i4Ehys3lGHJLqIX3UF7cFW+QVjl6yHfKHok9pts2vw3Qe5WEfEXD",
+	"vFX8gPCMeqC8ATvFHQN7VRbFWuzxiEUlAJAuSTjr7qJSWwqA6+7NS/w0h5wWUHD0oqX3wD9m7x+hMb19",
+	"QDbuNk/vN2sFyy9pzJhYHoVoACmzIsxxJW/zndq/K0Tp0sseTsyMy4lq7tshcSubZCykVShFAUCxx0Ll",
+	"Zo/AlmhppD6/jjiI3ZoBvKbEMmWdAymDJnSAPz3IUAe2a1hgQdSLckWOGtFs3I1jYgFGtMphv0JZdTpk",
+	"wjb9Uo0cc+v28Fjq8gKGFpdtkCtqXt2LR4spW7cv84FjYcj0IdvyIsUyxZSOOISCs3vXI7VqYX4FGE0O",
+	"LPYbrgyhZr5D26p/qJ06E6Wa09i6nQUnrsSsbXqNOmzIrMFs3b7kV2pNo6v130E1g6hFbgTbUyhYFjcy",
+	"7iu+vHOf+X7gE2tJUrisfJ0uF3gsBhlF1pI6bD8NS8uqPMKnm6EutJ8dmLErOp/5Es/yr8rowsAdPvOV",
+	"U4btDMFavoOig76cQHJWoRFG4UO+FWlaks4OdA/iDltDk0NYa5z0EMcQKs8eS0tY4oaxUj/RIBvHlpNQ",
+	"gZTEpP5xkt4x3IQphv8ytZuOGn4VyOIY0+P4svMH/js3K4QYEDMkgLzWkgIcaVjffeW0aFs5L81DBefl",
+	"WOA4s5YKfi7lt0rNGrAkm/viDBCXIVPJ3goo0locVK7nSTlSNbOQ1tBDrfOyUuplKKFfisEZSWscDXW6",
+	"yGHkt6vFQt739AkarrpGojMiByql1iWlAv0E84LBavq5wQPp+UWZLpcHZ1whv24aY8DDJHZjHJuOeMr8",
+	"PkEb8++q1IJo7LCWRLQyKqesdWwBNagoPEeuN/mbjy0OtjxoR7FbI8NjCEQU31MeYHqkexcw3F24GZB/",
+	"cBbV5h6zfX5DHWD97GvuPPsS7WIDjALscwZ1D71yA7TcpfaNcidSztOQwF0+bqPUY2jEzcJ5cn25UNOh",
+	"oH+RxCI+9pUip6GK2qUO9RhIa9YyBa6AA/YYPQiFwTDobRhNf9MNzpoGK7AZmY2LeuYA92ogUu0gwvTy",
+	"xuleksOciIwdLn3wpKQjFMj5Iewnrj7NiCc9XHmPrNDs8nSHhF5QXQNjrDkkCi4CYilBXzhE0nKIsXrc",
+	"XPqajHlOvkSVGy3yr8uwTdzVNttlX+lGw6FUoqH7Yop+H9NIIbKzx7DZoEw+JApi/m06RUihYcFIMNdz",
+	"naqhux5zz1sjDDZCEqG+hAIB5G2T0Fv38M+K64PuXBDpnXnFhU3X3yDG9OcgLHmiOPZKUDODzQTVHcKg",
+	"vjYpyyod+DijrFggZhMudmhCbTKrk9yFetLKuQ4HpGa9DhUYchc8HVOchJGVZhVDN3TVHEo6IqBD0kVd",
+	"MstGSxsiPCRFAtvLHjKFp8JNnDMgYf4d1roAz/h6gcf7CtqUTIl2dVlsxlAwwede79GnDZNfSIafQCmW",
+	"Y6q2Atgp0y5G7/hKOh7EXWGwmySzWg2oUebRlqReiBlW9Vsw+xB5gNyurCBkCPAwJEgq4Ob5sYv2i6BB",
+	"eMxFlawTcMKSIYB3RTvZodkG2pJ2aDtBIrTgywSrAdspWmFI1oUtt+pCASH460YY+BvXQI62HdsP4mtu",
+	"dI2v4gvOvJAfVa/VPR8+BvHmUDyStllz+BLPj2K7mN27mw1/ye2Q04BOYjfs8xpPImOZezRU70U7k4HA",
+	"/dGG4Dxk0uMEIah6/ni6+WnQp7lZvbxCJUimKWQiJyYZxaORxr0SqikqHkncrAnedGWiWIsw6SY5PwSq",
+	"D7pMbsICXNUqahQmfMbl9Hh6YfnFCIv5Hn7fVc04MmQz4+1NZFQX1nyDkOu2Y9cDP94sWHQaiDpmSphA",
+	"zddwsaAQHUDMsSPC4HhZArabzpCPIqooXrdcvHlfiP1YX004MZXpeMCcQuxQGRluE5eRuWru4PmlT9yl",
+	"25hBeNY5u2JKIkyrJBk0lL3sonhSvUV/pH+j/0H/TP9Mf0v/D2Rlfk//TP9Ef7tE/07/i/4H/b/w4USp",
+	"QlCpNBseqRbsZCIzMN5CegDdaRVCt3J9ohuKNkltfYInizzZsA45qwAcJ4VXE1G6GlwnZiXmqpRBi6iC",
+	"HsbczZ28Eu1jLnJ23GynSqLY89Epv1r6Lqte1OD1tMaL+RtPhtVZ18jnTFZAb2u8JY4Vzt4ZFjzvWOyB",
+	"gIDnSqkWHpqkxedruR/sYamY9qAZVsjqOKb1DAMXYGWrdzm8vks2zFFZggGKkndq92lCro8iU10OIgs3",
+	"JHvj30yR3y4sJ/IEZPQh8tAqCd04CEdvW64CZ8tvB86aVJqhF9/C6GFRpoG4IQnPN+PN9JOs3GT/4pdX",
+	"4bRwtH1O/JpuYDOOG/ZdmNjz1wOjkgNQhn6NpAJdK5tRkPL0rHo+0FJmZYKnF9dwMW7lOvGrVkTCLa9C",
+	"uAGMC2b22TMrZ1aQ9zSI7zY8+5z9Bn4FduZ4Eze+7CblMKPlO+mHS9W78PMGQdSEe3cl0tnvkTgtohmd",
+	"V57BmUO3TmISRva5T+/YHiwE3mbLCHDb1R9I744Hr0dJiPsohfVzeDhqBH7EL/FnKytKHVf48x+X/zGt",
+	"ZqtNWViPM5+g9qOh3izeW1L4Fq3em8St4q7v2Bf4CpYuelEjiBLdN11G7qV3HfvN3OrVArO/irJzDFMU",
+	"eFkz02b+opaJkUVCRAyojat44wRW8a0QKlv0KF2BYMKwip+fyFl8j77YJ8JPIpC0i7q7SiYQjlUC8enn",
+	"AHqRNCgChOzzciVKCncWQqzXBOrTDr7rvsXrR4Ds9jq+b7narNdvXQ42PNxdI4gMuLcaRPHFdBxHHxLF",
+	"bwfVW2MdWcZ3PxPSW0Ry72ax/O5IzJ38vrkoaLrv/8TAsi77WoZrphHlPYzE/wpReW5wcT6wIAXzH/Sc",
+	"RS4ry0zNjmqk28cRfIJlgnUQl0GdTzlKPnvS4oUq91IvKwhovFA46pvvvXPVglkcaWAaYBwFoBnbTvkk",
+	"RAccYBkkLIKU1lGkT7k9D64Z/L1Ktdi2ULDUwhQ5nsfrOa5u3c5zuSLfJXskQW1Pmg6zOT9tdGXZ5+xf",
+	"N0l4K+WSapKKgX8NzXYxapiHGG8x6XKUPJmxF2OaTzzrlAVftZZmGc6vYsqWXz0DItDNeo2/NloK1te9",
+	"CqkGlSbIIWeiBpSGijYJieu1M/jvuHKDY8fkZrwMFTSnljh+r1UDbTuZwsbSOQOXqOWVSrMbx5tErESf",
+	"ze/ot6+MkHLKxIPMdSbJ4dsZN5zMEMpXW+9YF9Y+lm6Hf7289q8lpAlBdnUv/wjqy76Ed7BHPLDXRH/T",
+	"6Zxi2lpMPa9oEQAGVSFDJhJ7dGmloIDeJLpuOagwuKgNdu49EVHfw3Pbl4VMjUmiuShAXgAlFwdoWnxq",
+	"YRrzJBZ8acGXFnxpwZfK8SU9LSiXljM+C6qN1mVnq8aOYZNsuFF0Iwiro8tKyymSJ14NDffsiSNM1+LK",
+	"I9sRH1Vlbx4V3t+aTk/6BrJx/rsc5uuiing0HO7fT4bNCvanq5Rf9/xL/Lmz0yS/qAXm191mLbbPrbu1",
+	"iIwq7D5OxoihzHtRUfcyeDk7PJBnaoS67zK1/9VgAnth/j3N5t+/oizfE8JQQiayNf5fGKIxO2kghxZB",
+	"JThrvkfCgHZezxCa5TvyzxFOm4TmJMhfzmFTV4cfp7vmZFBvPrBNyVDQCQPPrTjElnh7pxQj8pbifDeu",
+	"PDaMFiiDUGoJkrMWFSJhDw0F85U6bJmC+bJ8h14ynw5yTZUgDGR4KX0rm1rUtR2DAPAh38qsuL+oZaoL",
+	"ACPqjYqk6QjrPY4QAdJ+FZneICPeMVY6SqYjxGghPt8iQolA+uyz//m/eHX6syvO2Z+XCEDKNYNITvVl",
+	"ixYILUac/YOE2DmUKBIa91xZpNqyDJ04afNM0awMKwl8Q7ta272FcDJT4SRL6LDaw1GqzEwlmnAyvXwH",
+	"/x0hlHA6+CEfWUocCZKx8ymLlMDVk0PPlDwY5YoFSs1GuhmJTmVlmxRplrGa6BBZ578Aq3l+7CTNnwa5",
+	"UjEDvUQx0AweIHmkRLzrEx+yluwfJtP0X4h3DJF6BLZfwg2eKMpPJmoNzQhMu9qcsBmwjEwgeym9VIkg",
+	"39bJUQGJpyXqwMYeGmQHkZHOA3WBP+2rk3YX9GwKPwA/w7ZBA5LBNqLVOY/0gQpRiUJkFBCMlUilTFFE",
+	"8niLqGsy46PYfKrREQUFX2354S8ZEqsn8h1wOpyrg80ez4W4kSD0AouPDYtFozzBjGV+tqnfo47ogKzw",
+	"vydcbxN9RDVWP74WILIKrgHuRMNUAKX2eWRPiVjjFKbhdXqytg5jBDj3i8I5FHddOo1xy7m+UsX7GyHC",
+	"OkOode6Cj0EMU6/0ZG0zuVfnbJI9epQ9zGz/qvkw2IgSO1gGH4gh9LQUruSkZ0WS/s4hZWG0OQbE/DbX",
+	"3EzpCZeFpI6xQdy0BHv5DvzzgVsnaL5pNM1FmvhKdPDgxu9MI5hMiYD9TJSj1nXyIGkc1tc1Up5ZnqZB",
+	"53XMpkZsrootlJIL43RwsWA4Q61yznt8ZGzjJZpunKz6O4rs/pgCZP5AMqDUPr3kd2HWmxXR/aNOXWg3",
+	"OXT20ARDRQR5YsI7ImRIKRM4a5Iz3+0Tx6i9e7obLcqtvmxvY1K40yjNJo3p51KEVc0MEE3RRiLaw3qW",
+	"g1w/mvkhlm+u/PPMVnGxyZ8k4iKLV/U3pQi56pjNhj2o7tmijiSvjpytYT/bkZ0dRWUINWFjctunpPnL",
+	"X8iug6MpP7bCfUkBo7luvGMGkJQl4gXBnicX4TldaeNhOAZiRIZmQpIVajjQC2GPG5jAzgTG0Y5QnRJ1",
+	"Wh25j4FIaQATULaORgilKXVBkBcE+RS51mHDmCHA62zsDafQ0gByzDT6TlIh5y6XGmuE90HTafVF/F5S",
+	"61W1Je1I64Nab3PunNx654fyin4xcCvFrudF907BKq9UO7leBjiiY6XtCGhXUjplIomhRzxHjlfyzsDn",
+	"QmGfkFj8ZwpDBsmtp3eJkkUL1fsCEtuSTjDZGUc8xKu/soeTUQ/AeCHWZcQ5+PoVpBBaC+2XYwkcpa72",
+	"sm28F4RnQXgmsxQWNYTn9kBxG8Pt8O18nkDuWmcquKiV3kp441PipNR6O2k6ddyBAOnWSmlyv8+U9KId",
+	"TSWTgaBpTnVvkdl3umNqMheu4e/U8RBzgWJFokC9WYu9hhvGyzDNUtWN3fJXk+7no0YtcKsnbcBW8XoU",
+	"HovC3lqF7cECb0813v5Bo8LtfDHGMRC5kJ/m4vNLIHr5kPf5lvZfWtB7SVl/bsPeDXHumUh4JVcjJ9Un",
+	"6kGmIQDtLUjFDILfdfHcEEiNX6aB71pA7WyF9VrAD2wsSf2yfOj0iOklcD3ZlQkO8CDzSnJPa36hXeyC",
+	"tZ9qfP0uqTgLgVH3kavvmU2AE/L1erA1Llt/Hx45FVw907qC1GqTeGXFc3MSAjhKGMg1s1uQgFOefzui",
+	"bwu3rYkuhegRTLkBaw1ppjRLDl6Qy2bsYpRpetcuCJpOInf7VhohQLu8V9vv6LfpznrieVFl9F/eP3/h",
+	"jIWJy7KimYDHjpo19Byj3JKwbSHonLHEKlU1WQnsFvVQ6JE6e7pI2SSux4vbqk1k+XcZH01PJiK12Ta0",
+	"ak9PQ+strkrF0si9x8eKyzlj6aEXacIT9xoL2U2vVJmIduno9me+MZc5xwXGzEM8BQJYRs0bmY6oyMMn",
+	"R2P/NtR7UqgxJWb6Du+OCDiTxNAoVRoWBHq6VERj9qFOrIWYptaPfSApSlYDO85URZ2A8+6M1+LgWkT8",
+	"KglVKl6CGvBmjVeDNf7wTzYG5ETNPtnesXqGdO/kE6LLRMYtDDzH68NRWttmCI8ROBK9cajoZ9HeCJqi",
+	"9TvJG28WfUSmXMxfZO0wWYacC75fsYcF7264G/qLkxq1Z0flapTr2fkbNC70RVeAtG+ntjzaLVhezat7",
+	"ccH6VpT0kjdWJlgtjyPVSy7na3zTfUAAzm+hyXNH0TMcURCxhaMP2AOxocdWI01MMu3L8yu1ZpWUrqkP",
+	"7QnFI6a9/BVhbA9x9WH2zNvKguHklQaPnJLtAmFjj4s3w41FtmOTm40a6m6igLFpa+seqVX1rhLlwse3",
+	"br8Lj+aiDhy76Xu/bhIRRy/YahTfqsnmBPbsIiN0W5CgV0OX/fEnWgvOaNh0HCguBE0Rb1KCvYvBaYfR",
+	"aPqAfGW5pZt+2HfTadI2mPl51cbrkUG5/15rs94ztFm3EgO2oipz3BTRQv2kL5ZOYLuC+Ca5EaV6h8vV",
+	"5vczesd3HQ2wbi751Txw5czzvMzLfqIqIjo+49UfUePGiBmkptiKm7Ws8xW4B4miaYuJroVpzkhTlQbI",
+	"XyLj7csm3UMJQg4znAlbQqYFQfhKXokydnoDiKRLkdoVSLT46Ypq1mlz9LwZJ9cXCB6aImgGBaZj8WYD",
+	"WTvhDMuPPzFerjzzRW34V6Y1aHKTXP6atKZqY+v28h3Mhru7rLbuH6JfYJ/nC3JsKQOE7MA+n+bIrdvJ",
+	"boyXlqtoydsMCThCOsP16UMBZI8U6r1AslMd7nXIdhPJCbP44d4H9An7N57UkSrt3CUiPEJdup8xtZQq",
+	"1qphI6nVolKoiANPBx6WkinX4iB0Nwjsq1T89N+TkIxeoRPOEFMtEx47CzQ91Wha5vrZttipiVqzxyNQ",
+	"c4T8+HKwcCaBGgrHH2b7cTA0I9MI4dPzS5+4S7d5KwTn7IqpE4Jjh27l+kQPRpuktj7Bk9nm4fB+OZvY",
+	"h5Nu/GXXRNFo3VDa1tbk90XA2SsmwbPdoWSsMyMRvxZE5FrNjeJrmv2qBH2DJy+7Udpr9xUQ/VXTnEFr",
+	"1oM/1QiT9pwVwdDjVGWWpmHFC0ydSA1II4u6SulI9N+hzUqNSMpXHzGVluClv6C58/3pA8WKMBwjDSJp",
+	"nB0XzzXL7quA7Lqp2ggzqmW9bbSsvzwywHYMgDYY6QxYIP6sEH+Cw088MWxHDRoc+uA3avhgQSzBLEgF",
+	"rzfDaYXwjJWjEbwgDRAJ6R97qdThVJWDKVevKlPdKl/lG++7pVYqYbsLRJ9RxZcch39KB/maUYdpreNM",
+	"fZi0bhTt5q/2tcuX3v3QsWaBwRjbESmFtPNlqwXKXuYj594+McJQL7Zx0mGC+ovL+QcWHoBXgDCIJq+8",
+	"78m+ub8ZFkkUVmb0XaOjgLV0VwFrcYyfqqCzgvpRHFSul3EPrOHAk0F8587sI+SONabtmP2K/OzNIIqQ",
+	"JbOzT5hYCGhd1HifEZ0w3aa1pMn8PMuIozT3J+J2ZeEtWVgAO9TJL7Nh51A2bqQPUQ+jK6IOV9JRZtKQ",
+	"RWFBG6amBVHsxs2odNRmss41/pwpdPOPHHpBnMaYon1JOk3yVNtR7egd9rUU7/QaZubAzEpI3JhU3741",
+	"7kksIrAXEdgvn1uVjOiUGCfo27itzPTw7/mRgF+xOEo9yH5IPOXMQyY13jEbR3T5XhyCf6hIZHs+2K82",
+	"QhJFdu5CNFrLY4UFs4ZUwoxybjs28QH9PrWrobse2442+efl6sq/bKfyOG6teXQqJ9avrgynAyOMZnhP",
+	"EsTlRhay66xCSWWTz1FerIlt0KmEunwn+XtEP/+U5lxJnyil1Yba+Kl029JySuaoXpbYot/XjESWN1b0",
+	"nkQvUcNOQOEiiV2vVoraZRoE0t6ckTxTWWZFRaftU0hi8qJMlrJsC5u7ApwP8JT6qtSDzSnG6R47jNqU",
+	"LVxspDzjVladFRFalC9eWMXnrnxx1r81A8ViXlBtUcZ4Ucb4p1fGeCyEHspjK65fIbXhUSVGrL/AH5wb",
+	"hD+lBW7GCnAF78TcNS9WI2EwBAMrQGHoTJ8PFvWdeLktzbizsApM4dGSsNA2Ceu82EcLVc6kMRwfA1ab",
+	"303v6y4mKRjgesOLN68FWyQMvSqZhL7ALL/04s0P5RwLUrOIpV8QmjmIrs8SG1GzU6vJzHbBdCPibfrC",
+	"YvClsBe3ZklueJBs9ZpaOmYsUwEPlq0qbdZfMXPBOE1r1UhLXixKj4vOmQ7MMa6ny1q3oAtTxuRySDG1",
+	"XtSghwsmnQS3+1ZyRbztIjo1shA1U2LhRZWQNFy/cmt8OqE8e5poxNAGxemerpBGEBZm3bAHIr2lgNqz",
+	"+3OG+IN0zaYoutMn7Y++AZMekGG93KMyjcFg04viIBwfe/5FPPfKcddMANyFTdffIKV47R/ZtqBc2JpK",
+	"FVdlEuJ9vJhDUSbtqfQfCleLzooXfrKTaACq3ZiIOHtouLyZWehCEjSIP4EGfYU/uFCbT9hCl6jNudYI",
+	"85TiNr4GnRbOT7IpkvCfJwKak+iURfzPTJ3zChBl81x72UIChsCgGUrQGNU8ATVaw+deFbl5LKIgkAqu",
+	"X/I+veWzbMm9sLD9pPsadfih8trVnIz2sqHEPBddg53Zx/ktb5HQW781AZZ/zB88JTKHHuIcopQBf+VD",
+	"iF9qu7MxiQ00MtoRoPhMN3MtCMxPVoLIw8RxCQ4bXhSP6ppzRY6aFfKSOsSXquSCf2NIUGi4UXQjCKuG",
+	"yhTfwmZ5nAUSULHLZ7IvQh+NlACsLdq33oIxPdqnHaGfd8585mtz5DsrHMAfMAsq8Tx6hxc6Zw8SM6os",
+	"jn6AxQzEt09Yi+5DHmL2JYaFAvqwHbiqJ1jMBJPEDnmkZpd9pS77DHY5q3v+ZeJvAPS8ZTiyMKihD1em",
+	"XpB6oxbcIgSeDKpwuUE4OvdC3khyAWLil52N8VFEirJwOew/FyoNL+7yaE7rdM8HUUqpzl8xcbMnY4Yh",
+	"r0m02zMc6q6kHmCEjmRjLm9Lr3addVaB1wCr/3a0hn45aUllI10LAxSqkhehrOORqpOvKtLNdi3sajnC",
+	"aHEFJobeigFvPqlnYwKuGkwEWNk0bda4b5DWWCuTkPzC4itKdD96cAa6P2KTE8wGVY3ekqqkL3kuBcku",
+	"3YNkZqx+IghQSyRGf3T1gqHpIZp08V5W1Wsp3cWIDnLnkg0xyAS1Dei+oxJPWVcJknEHJ5lu+9VkKxfZ",
+	"4eMsf5r03JnlgxvYoSKhycTjA31D0gaUzdMzXlLNfd/zmzGJCvJqfjZ+k6MfuC8R2osgIGOKK37Ejp5I",
+	"rJ/IuxJRCepS8b7awgHZRtVJwTxRQwiu3sIe/j22k7YmtSQBxvd2ab9g3161Rt5zG8P3/lJrYSiYXcYT",
+	"uIgwPu3Rg4o/Ef1JCRz3cv3BehxD7rEWVmOQ7sa1y+dlr558FQfh3J+0Jq5JEFiuul7t1vIdoIZ3i6WC",
+	"74uZIPQMUtMn4SJ519Eub1HUps+FwCBZYhHffGGJohaPgGuW5JkXYQOCzI+2kFT5wBKmETHyRJtyTEQw",
+	"5gFV31x580RQNcEwgCDewJvtpFDDhYQ0qW5wetOTteSTQymrPkvk6I78cjAO2ZmEaGj1dKNRNgi9j9tJ",
+	"l0qY08oEM6nBq/lB58z2mLgvZRXuxIuprTpb29W81YUEMkX+Qq5276hSvZ2pK3FnScTyHfnnyPoGGrVY",
+	"S54qxcojdfgrXH375FC9DBVaRPseZ4WAUgiL9Te2eYfO7eyQ8sGIAJoaO8+lxCrzapWA2W6qq+uMCfoB",
+	"Z3bBWtklmiuEZ/xUoF9k7YwWXzSp2k6h7DE7maMS1GUHgbp7U5ryz66srOSIiAPvqHrlGuPCKi8kw9MG",
+	"vSVrQZWvGsWdrxeCKim3qCvpeLMgpS5Vm17d/3xIWwVJ3hpED5J2t3NVLF1FiUNhEnyOqCYeaYFkpY/b",
+	"Qw79VBrWZIM+tZz6oovCiUpiGmhx4p4h1GpYPbyRW0UGeuX7dlrBCC6NVxaZUl6LYjeOzCVTsxUOOe3l",
+	"DicROJM312L1d27dER5XYDjbPPaGW7sU677w8ehqaeI+SlxHryc9kQ/ZY71gWz5bH1xCwmvDc/yPsHzO",
+	"PTowgfY36uIHeE5wGXicT5NIS6VZdtvswIHg9GhYTdlcydbnvHRWWnPAvNrCVvslW+lvhEGzkanXWrIJ",
+	"pRtHF7068SPBm8buqF/kOiGhF1RLV8DFhazyZ6auJetYKGTlvZq6l86C0mD0QOBMf84q0M50ExM7xk4+",
+	"+yO6EtwoV401QddeIjQqhKtnKtHU0zGSvijAyIVn5nRXezSwsaw/5iiRmxwLuABePvCbPkpaPcTFXCU2",
+	"no7dxanE8AlsrHHo+tE6CTVtLK/gXE2GzUrFqZIo9nwcu1parUh0gEjD6JHP1d2bgnHIAoryYxa7HTsK",
+	"mmGFrE5m9VUfdvJ71HbwsrUVeacFsVKcNPUl7iWlqRJZaDBfhmA9sV3TXo6UzSAaghazIGmzKmB7lIcV",
+	"tqvch1INVeuzKhhjqkC+mFq/SMjZsudfww9ePMwMnNC1S/5VMXqUKM1XqGmwaW57qveC9HQofNH4W1FR",
+	"2rEDe05EEEqJQwn55/vcWaShr2rXjSPN6ijGGKFnIfW8EikwOkXgebQtdA5PIaks35F/jvDxJMh9NRlf",
+	"yrsTq8Pn07szPu8+yWZHJtEh770ZLNBrhvWdjRg32hdjxioorNJw48pmSa0gRbCL8sGfLKKZRIPBHGKf",
+	"kwRMGNZrFqqfcJLNvuaTdc1C3QKtp4ih0HzgZqTmdZmGiaHZ7seadxMMujnvZiotz0L21khJSCrE2yJD",
+	"vL2FDVJSM79ixqcd5Re2zaM8Ib1C2zP/WpyckkxiANiuWgLBILifsbTolnwphCTsT3FnDZswKwj32GOB",
+	"TrDcr/n2jH5mA8G9Io73J0xv9dOcR1qr+kOH8IcFzTw+UWgESqp+L60zYWKBtST2TEghcVvhlkTPZliz",
+	"z9mbcdw4t7xcCypubTOI4nNvrby1Yt/9/O5/DwARN1hAyl8BAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -11,16 +11,20 @@ import (
 
 	"github.com/devWaylander/pvz_store/api"
 	"github.com/devWaylander/pvz_store/config"
+	"github.com/devWaylander/pvz_store/internal/blobstore"
 	"github.com/devWaylander/pvz_store/internal/grpc"
 	"github.com/devWaylander/pvz_store/internal/handler"
 	auth "github.com/devWaylander/pvz_store/internal/middleware/auth"
 	"github.com/devWaylander/pvz_store/internal/middleware/cors"
 	"github.com/devWaylander/pvz_store/internal/middleware/logger"
+	"github.com/devWaylander/pvz_store/internal/notifier"
 	"github.com/devWaylander/pvz_store/internal/pb/pvz_v1"
 	"github.com/devWaylander/pvz_store/internal/repo"
 	"github.com/devWaylander/pvz_store/internal/service"
+	"github.com/devWaylander/pvz_store/internal/worker"
 	errorgroup "github.com/devWaylander/pvz_store/pkg/error_group"
 	"github.com/devWaylander/pvz_store/pkg/log"
+	"github.com/devWaylander/pvz_store/pkg/metrics"
 	"github.com/getkin/kin-openapi/openapi3filter"
 	"github.com/go-chi/chi/v5"
 	"github.com/jmoiron/sqlx"
@@ -60,7 +64,15 @@ func main() {
 	repo := repo.New(db)
 	authRepo := auth.NewRepo(db)
 	// Service
-	service := service.New(repo)
+	placement, err := service.NewPlacementStrategy(cfg.Storage.PlacementStrategy)
+	if err != nil {
+		log.Logger.Fatal().Msg(err.Error())
+	}
+	blobs, err := newBlobStore(ctx, cfg.Blob)
+	if err != nil {
+		log.Logger.Fatal().Msg(err.Error())
+	}
+	service := service.New(repo, notifier.NewLogNotifier(), placement, blobs, []byte(cfg.Common.PickupCodeSecret))
 	// Auth
 	authMiddlewares := auth.NewMiddleware(authRepo, cfg.Common.JWTSecret)
 
@@ -86,6 +98,10 @@ func main() {
 	if err != nil {
 		log.Logger.Fatal().Msgf("failed to load swagger spec: %s", err)
 	}
+	// части multipart проверяются по своему Content-Type, файлы вложений валидатор пропускает как бинарные
+	for _, contentType := range []string{string(api.Imagejpeg), string(api.Imagepng), string(api.Applicationpdf)} {
+		openapi3filter.RegisterBodyDecoder(contentType, openapi3filter.FileBodyDecoder)
+	}
 	r.Use(nethttpmiddleware.OapiRequestValidatorWithOptions(swagger, opts))
 
 	// Инициализация хендлеров бизнес-логики (реализация интерфейса StrictServerInterface)
@@ -103,6 +119,14 @@ func main() {
 		Handler: r,
 	}
 
+	// HTTP-сервер метрик
+	metricsMux := http.NewServeMux()
+	metricsMux.Handle("/debug/vars", metrics.Handler())
+	metricsServer := &http.Server{
+		Addr:    fmt.Sprintf(":%s", cfg.Common.MetricsPort),
+		Handler: metricsMux,
+	}
+
 	// Запуск gRPC сервера
 	grpcServer := grpcPkg.NewServer()
 	go func(grpcServer *grpcPkg.Server) {
@@ -142,8 +166,64 @@ func main() {
 		return nil
 	})
 
+	g.Go(func() error {
+		log.Logger.Info().Msgf("сервер метрик запущен на порту: %s", cfg.Common.MetricsPort)
+		return metricsServer.ListenAndServe()
+	})
+	g.Go(func() error {
+		<-gCtx.Done()
+		return metricsServer.Shutdown(context.Background())
+	})
+
+	// Фоновый обработчик зависших приемок
+	if cfg.Worker.StaleReceptionEnabled {
+		staleReceptionWorker := worker.NewStaleReceptionWorker(
+			service,
+			repo,
+			cfg.Worker.StaleReceptionInterval,
+			cfg.Worker.StaleReceptionThreshold,
+			cfg.Worker.StaleReceptionAction,
+		)
+		g.Go(func() error {
+			return staleReceptionWorker.Run(gCtx)
+		})
+	}
+
+	// Ежедневный отчет о скорости приемок
+	if cfg.Worker.ProductivityReportEnabled {
+		productivityReportWorker := worker.NewProductivityReportWorker(
+			service,
+			repo,
+			cfg.Worker.ProductivityReportAt,
+			cfg.Worker.ProductivityReportSLA,
+			cfg.Worker.ProductivityReportIdleGap,
+		)
+		g.Go(func() error {
+			return productivityReportWorker.Run(gCtx)
+		})
+	}
+
 	// Ожидание завершения всех горутин
 	if err := g.Wait(); err != nil {
 		log.Logger.Info().Msgf("Причина выхода: %s", err)
 	}
 }
+
+// newBlobStore выбирает хранилище содержимого вложений по конфигурации
+func newBlobStore(ctx context.Context, cfg config.Blob) (service.BlobStore, error) {
+	switch cfg.Store {
+	case "local":
+		return blobstore.NewLocalStore(cfg.LocalDir)
+	case "s3":
+		return blobstore.NewS3Store(ctx, blobstore.S3Config{
+			Endpoint:  cfg.S3Endpoint,
+			AccessKey: cfg.S3AccessKey,
+			SecretKey: cfg.S3SecretKey,
+			Bucket:    cfg.S3Bucket,
+			Region:    cfg.S3Region,
+			UseSSL:    cfg.S3UseSSL,
+		})
+	default:
+		return nil, fmt.Errorf("unknown blob store %q", cfg.Store)
+	}
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/devWaylander/pvz_store/config"
+	"github.com/devWaylander/pvz_store/internal/repo"
+	"github.com/devWaylander/pvz_store/pkg/log"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq"
+)
+
+// Пересчет суточных сводок приемок по сырым данным за диапазон дней.
+// Без -from и -to пересчитываются все дни от первой до последней приемки
+func main() {
+	fromFlag := flag.String("from", "", "первый пересчитываемый день, YYYY-MM-DD")
+	toFlag := flag.String("to", "", "последний пересчитываемый день включительно, YYYY-MM-DD")
+	flag.Parse()
+
+	// Config
+	cfg, err := config.Parse()
+	if err != nil {
+		log.Logger.Fatal().Msg(err.Error())
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	// DB
+	db, err := sqlx.Connect("postgres", cfg.DB.DBUrl)
+	if err != nil {
+		log.Logger.Fatal().Msg(err.Error())
+	}
+	defer db.Close()
+
+	repo := repo.New(db)
+
+	from, to, err := repo.GetReceptionsDateRange(ctx)
+	if err != nil {
+		log.Logger.Fatal().Msg(err.Error())
+	}
+	if *fromFlag != "" {
+		if from, err = time.Parse(time.DateOnly, *fromFlag); err != nil {
+			log.Logger.Fatal().Msgf("неверная дата -from: %s", err)
+		}
+	}
+	if *toFlag != "" {
+		if to, err = time.Parse(time.DateOnly, *toFlag); err != nil {
+			log.Logger.Fatal().Msgf("неверная дата -to: %s", err)
+		}
+	}
+	if from.IsZero() || to.IsZero() {
+		log.Logger.Info().Msg("приемок нет, пересчитывать нечего")
+		return
+	}
+	if from.After(to) {
+		log.Logger.Fatal().Msgf("-from %s позже -to %s", from.Format(time.DateOnly), to.Format(time.DateOnly))
+	}
+
+	// каждый день пересчитывается в своей транзакции, прерванный пересчет можно продолжить с -from
+	days := 0
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		if err := ctx.Err(); err != nil {
+			log.Logger.Fatal().Msgf("пересчет прерван перед %s", day.Format(time.DateOnly))
+		}
+		if err := repo.RebuildDailySummaries(ctx, day); err != nil {
+			log.Logger.Fatal().Msgf("пересчет сводок за %s: %s", day.Format(time.DateOnly), err)
+		}
+		days++
+	}
+
+	log.Logger.Info().
+		Str("from", from.Format(time.DateOnly)).
+		Str("to", to.Format(time.DateOnly)).
+		Int("days", days).
+		Msg("суточные сводки пересчитаны")
+}
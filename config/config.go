@@ -16,13 +16,50 @@ var (
 )
 
 type Config struct {
-	Common Common `envPrefix:"COMMON_"`
-	DB     DB     `envPrefix:"DB_"`
+	Common  Common  `envPrefix:"COMMON_"`
+	DB      DB      `envPrefix:"DB_"`
+	Worker  Worker  `envPrefix:"WORKER_"`
+	Storage Storage `envPrefix:"STORAGE_"`
+	Blob    Blob    `envPrefix:"BLOB_"`
 }
 
 type Common struct {
-	Port      string `env:"API_PORT,required"`
-	JWTSecret string `env:"JWT_SECRET,required"`
+	Port        string `env:"API_PORT,required"`
+	MetricsPort string `env:"METRICS_PORT" envDefault:"9000"`
+	JWTSecret   string `env:"JWT_SECRET,required"`
+	// Ключ HMAC, которым хешируются коды выдачи перед сохранением в БД
+	PickupCodeSecret string `env:"PICKUP_CODE_SECRET,required"`
+}
+
+type Worker struct {
+	StaleReceptionEnabled   bool          `env:"STALE_RECEPTION_ENABLED" envDefault:"true"`
+	StaleReceptionInterval  time.Duration `env:"STALE_RECEPTION_INTERVAL" envDefault:"10m"`
+	StaleReceptionThreshold time.Duration `env:"STALE_RECEPTION_THRESHOLD" envDefault:"12h"`
+	// close - закрывать зависшие приемки, flag - только помечать
+	StaleReceptionAction string `env:"STALE_RECEPTION_ACTION" envDefault:"close"`
+	// Ежедневный отчет о скорости приемок строится через PRODUCTIVITY_REPORT_AT после полуночи UTC
+	ProductivityReportEnabled bool          `env:"PRODUCTIVITY_REPORT_ENABLED" envDefault:"true"`
+	ProductivityReportAt      time.Duration `env:"PRODUCTIVITY_REPORT_AT" envDefault:"1h"`
+	ProductivityReportSLA     time.Duration `env:"PRODUCTIVITY_REPORT_SLA" envDefault:"2h"`
+	ProductivityReportIdleGap time.Duration `env:"PRODUCTIVITY_REPORT_IDLE_GAP" envDefault:"10m"`
+}
+
+type Storage struct {
+	// first_fit - первая по коду ячейка со свободным местом, least_loaded - наименее заполненная ячейка
+	PlacementStrategy string `env:"PLACEMENT_STRATEGY" envDefault:"first_fit"`
+}
+
+// Blob хранилище содержимого вложений приемок и товаров
+type Blob struct {
+	// local - каталог на диске, s3 - S3-совместимое хранилище
+	Store       string `env:"STORE" envDefault:"local"`
+	LocalDir    string `env:"LOCAL_DIR" envDefault:"./data/attachments"`
+	S3Endpoint  string `env:"S3_ENDPOINT"`
+	S3AccessKey string `env:"S3_ACCESS_KEY"`
+	S3SecretKey string `env:"S3_SECRET_KEY"`
+	S3Bucket    string `env:"S3_BUCKET" envDefault:"attachments"`
+	S3Region    string `env:"S3_REGION" envDefault:"us-east-1"`
+	S3UseSSL    bool   `env:"S3_USE_SSL" envDefault:"false"`
 }
 
 type DB struct {
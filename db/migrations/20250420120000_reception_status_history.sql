@@ -0,0 +1,32 @@
+-- migrate:up
+
+-- Расширение набора статусов приемки
+ALTER TABLE shop.receptions DROP CONSTRAINT IF EXISTS receptions_status_check;
+UPDATE shop.receptions SET status = 'closed' WHERE status = 'close';
+ALTER TABLE shop.receptions ADD CONSTRAINT receptions_status_check
+    CHECK (status IN ('draft', 'in_progress', 'closed', 'verified', 'cancelled'));
+
+-- Таблица истории смены статусов приемки (ReceptionStatusHistory)
+CREATE TABLE shop.reception_status_history (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    reception_id UUID NOT NULL REFERENCES shop.receptions(id),
+    from_status VARCHAR(50) DEFAULT NULL,
+    to_status VARCHAR(50) NOT NULL,
+    actor_id UUID NOT NULL,
+    actor_role VARCHAR(50) NOT NULL,
+    reason TEXT DEFAULT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_reception_status_history_reception_id_created_at
+    ON shop.reception_status_history (reception_id, created_at);
+
+-- migrate:down
+DROP INDEX IF EXISTS shop.idx_reception_status_history_reception_id_created_at;
+DROP TABLE IF EXISTS shop.reception_status_history;
+
+ALTER TABLE shop.receptions DROP CONSTRAINT IF EXISTS receptions_status_check;
+UPDATE shop.receptions SET status = 'close' WHERE status IN ('closed', 'verified', 'cancelled');
+UPDATE shop.receptions SET status = 'in_progress' WHERE status = 'draft';
+ALTER TABLE shop.receptions ADD CONSTRAINT receptions_status_check
+    CHECK (status IN ('in_progress', 'close'));
@@ -0,0 +1,7 @@
+-- migrate:up
+
+-- Товары отменённой приемки не удаляются, а помечаются аннулированными
+ALTER TABLE shop.products ADD COLUMN voided_at TIMESTAMP DEFAULT NULL;
+
+-- migrate:down
+ALTER TABLE shop.products DROP COLUMN IF EXISTS voided_at;
@@ -0,0 +1,22 @@
+-- migrate:up
+
+-- До появления индекса параллельные запросы могли открыть несколько приемок в одном ПВЗ.
+-- Оставляем открытой только самую свежую, остальные закрываем
+UPDATE shop.receptions r
+SET status = 'closed'
+WHERE r.status IN ('draft', 'in_progress')
+  AND EXISTS (
+      SELECT 1
+      FROM shop.receptions n
+      WHERE n.pvz_id = r.pvz_id
+        AND n.status IN ('draft', 'in_progress')
+        AND n.created_at > r.created_at
+  );
+
+-- Не более одной незакрытой приемки на ПВЗ
+CREATE UNIQUE INDEX uq_receptions_pvz_id_open
+    ON shop.receptions (pvz_id)
+    WHERE status IN ('draft', 'in_progress');
+
+-- migrate:down
+DROP INDEX IF EXISTS shop.uq_receptions_pvz_id_open;
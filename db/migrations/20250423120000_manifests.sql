@@ -0,0 +1,57 @@
+-- migrate:up
+
+-- Таблица манифестов ожидаемых поставок (Manifest)
+CREATE TABLE shop.manifests (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    pvz_id UUID NOT NULL REFERENCES shop.pvz(id),
+    supplier VARCHAR(255) NOT NULL,
+    strict BOOLEAN NOT NULL DEFAULT FALSE,
+    status VARCHAR(50) CHECK (status IN ('expected', 'received')) NOT NULL DEFAULT 'expected',
+    reception_id UUID DEFAULT NULL REFERENCES shop.receptions(id),
+    created_by UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Таблица позиций манифеста (ManifestItem). Строка по штрихкоду (SKU) сверяется с товаром приемки с этим barcode,
+-- у строк по типу товара sku = NULL, ключ строки - тип и штрихкод
+CREATE TABLE shop.manifest_items (
+    manifest_id UUID NOT NULL REFERENCES shop.manifests(id) ON DELETE CASCADE,
+    product_type VARCHAR(50) NOT NULL,
+    sku VARCHAR(64) DEFAULT NULL,
+    expected_count INTEGER NOT NULL CHECK (expected_count > 0)
+);
+
+CREATE UNIQUE INDEX manifest_items_manifest_id_type_sku_key
+    ON shop.manifest_items (manifest_id, product_type, COALESCE(sku, ''));
+
+-- Таблица отчетов о расхождениях при закрытии приемки (DiscrepancyReport)
+CREATE TABLE shop.discrepancy_reports (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    reception_id UUID NOT NULL UNIQUE REFERENCES shop.receptions(id),
+    has_discrepancies BOOLEAN NOT NULL,
+    overridden_by UUID DEFAULT NULL,
+    override_reason TEXT DEFAULT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Таблица строк отчета о расхождениях (DiscrepancyItem)
+CREATE TABLE shop.discrepancy_report_items (
+    report_id UUID NOT NULL REFERENCES shop.discrepancy_reports(id) ON DELETE CASCADE,
+    product_type VARCHAR(50) NOT NULL,
+    sku VARCHAR(64) DEFAULT NULL,
+    expected_count INTEGER NOT NULL,
+    actual_count INTEGER NOT NULL,
+    kind VARCHAR(50) CHECK (kind IN ('matched', 'missing', 'extra', 'mismatched')) NOT NULL
+);
+
+CREATE UNIQUE INDEX discrepancy_report_items_report_id_type_sku_key
+    ON shop.discrepancy_report_items (report_id, product_type, COALESCE(sku, ''));
+
+CREATE INDEX idx_manifests_pvz_id_status ON shop.manifests (pvz_id, status);
+
+-- migrate:down
+DROP INDEX IF EXISTS shop.idx_manifests_pvz_id_status;
+DROP TABLE IF EXISTS shop.discrepancy_report_items;
+DROP TABLE IF EXISTS shop.discrepancy_reports;
+DROP TABLE IF EXISTS shop.manifest_items;
+DROP TABLE IF EXISTS shop.manifests;
@@ -0,0 +1,11 @@
+-- migrate:up
+
+-- Отметка о зависшей приемке, выставляемая фоновым обработчиком
+ALTER TABLE shop.receptions ADD COLUMN stale_at TIMESTAMP DEFAULT NULL;
+
+CREATE INDEX idx_receptions_status_open ON shop.receptions (status)
+    WHERE status = 'in_progress';
+
+-- migrate:down
+DROP INDEX IF EXISTS shop.idx_receptions_status_open;
+ALTER TABLE shop.receptions DROP COLUMN IF EXISTS stale_at;
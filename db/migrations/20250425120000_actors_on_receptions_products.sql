@@ -0,0 +1,46 @@
+-- migrate:up
+
+-- Авторы приемок
+ALTER TABLE shop.receptions
+    ADD COLUMN created_by UUID DEFAULT NULL,
+    ADD COLUMN closed_by UUID DEFAULT NULL;
+
+-- Автор приемки - автор первой записи истории статусов
+UPDATE shop.receptions r
+SET created_by = h.actor_id
+FROM shop.reception_status_history h
+WHERE h.reception_id = r.id AND h.from_status IS NULL;
+
+-- Закрывший приемку - автор последнего перехода в статус closed
+UPDATE shop.receptions r
+SET closed_by = (
+    SELECT h.actor_id
+    FROM shop.reception_status_history h
+    WHERE h.reception_id = r.id AND h.to_status = 'closed'
+    ORDER BY h.created_at DESC
+    LIMIT 1
+)
+WHERE r.status IN ('closed', 'verified');
+
+CREATE INDEX idx_receptions_created_by ON shop.receptions (created_by);
+
+-- Авторы товаров и мягкое удаление
+ALTER TABLE shop.products
+    ADD COLUMN created_by UUID DEFAULT NULL,
+    ADD COLUMN deleted_by UUID DEFAULT NULL,
+    ADD COLUMN deleted_at TIMESTAMP DEFAULT NULL;
+
+-- migrate:down
+-- Без колонки deleted_at мягко удалённые товары снова стали бы видимыми
+DELETE FROM shop.products WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE shop.products
+    DROP COLUMN IF EXISTS deleted_at,
+    DROP COLUMN IF EXISTS deleted_by,
+    DROP COLUMN IF EXISTS created_by;
+
+DROP INDEX IF EXISTS shop.idx_receptions_created_by;
+
+ALTER TABLE shop.receptions
+    DROP COLUMN IF EXISTS closed_by,
+    DROP COLUMN IF EXISTS created_by;
@@ -0,0 +1,35 @@
+-- migrate:up
+
+-- Справочник типов товаров (ProductType) со схемой атрибутов в формате JSON Schema
+CREATE TABLE shop.product_types (
+    name VARCHAR(50) PRIMARY KEY,
+    attributes_schema JSONB NOT NULL DEFAULT '{"type": "object"}',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Каждый тип описывает свои атрибуты и объявляет обязательные: серийный номер для электроники, размер для обуви
+INSERT INTO shop.product_types (name, attributes_schema) VALUES
+    ('электроника', '{"type": "object", "properties": {"serialNumber": {"type": "string", "minLength": 1}}, "required": ["serialNumber"]}'),
+    ('одежда', '{"type": "object", "properties": {"size": {"type": "string", "enum": ["XS", "S", "M", "L", "XL", "XXL"]}}}'),
+    ('обувь', '{"type": "object", "properties": {"size": {"type": "number", "minimum": 15, "maximum": 55}}, "required": ["size"]}');
+
+-- Типы товаров берутся из справочника вместо CHECK-ограничения
+ALTER TABLE shop.products DROP CONSTRAINT IF EXISTS products_type_check;
+ALTER TABLE shop.products
+    ADD CONSTRAINT products_type_fkey FOREIGN KEY (type) REFERENCES shop.product_types(name),
+    ADD COLUMN attributes JSONB NOT NULL DEFAULT '{}';
+
+ALTER TABLE shop.manifest_items
+    ADD CONSTRAINT manifest_items_product_type_fkey FOREIGN KEY (product_type) REFERENCES shop.product_types(name);
+
+-- migrate:down
+ALTER TABLE shop.manifest_items DROP CONSTRAINT IF EXISTS manifest_items_product_type_fkey;
+
+ALTER TABLE shop.products
+    DROP COLUMN IF EXISTS attributes,
+    DROP CONSTRAINT IF EXISTS products_type_fkey;
+-- NOT VALID: товары добавленных позже типов остаются, ограничение действует для новых строк
+ALTER TABLE shop.products ADD CONSTRAINT products_type_check
+    CHECK (type IN ('электроника', 'одежда', 'обувь')) NOT VALID;
+
+DROP TABLE IF EXISTS shop.product_types;
@@ -0,0 +1,26 @@
+-- migrate:up
+
+-- Порядок сканирования товаров. created_at хранит реальное время добавления и совпадает у товаров одного пакета,
+-- поэтому удаление последнего товара и списки товаров упорядочиваются по seq. Уже добавленные товары
+-- нумеруются по времени добавления
+CREATE SEQUENCE shop.products_seq_seq;
+ALTER TABLE shop.products ADD COLUMN seq BIGINT;
+
+UPDATE shop.products p
+SET seq = o.n
+FROM (SELECT id, row_number() OVER (ORDER BY created_at, id) AS n FROM shop.products) o
+WHERE o.id = p.id;
+
+SELECT setval('shop.products_seq_seq', COALESCE((SELECT MAX(seq) FROM shop.products), 0) + 1, false);
+
+ALTER TABLE shop.products
+    ALTER COLUMN seq SET DEFAULT nextval('shop.products_seq_seq'),
+    ALTER COLUMN seq SET NOT NULL;
+ALTER SEQUENCE shop.products_seq_seq OWNED BY shop.products.seq;
+
+CREATE INDEX idx_products_reception_id_seq ON shop.products (reception_id, seq);
+
+-- migrate:down
+DROP INDEX IF EXISTS shop.idx_products_reception_id_seq;
+ALTER TABLE shop.products DROP COLUMN IF EXISTS seq;
+DROP SEQUENCE IF EXISTS shop.products_seq_seq;
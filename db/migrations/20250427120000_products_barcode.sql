@@ -0,0 +1,12 @@
+-- migrate:up
+
+-- Штрихкод товара, необязателен для обратной совместимости
+ALTER TABLE shop.products ADD COLUMN barcode VARCHAR(64) DEFAULT NULL;
+
+-- Штрихкод уникален среди товаров на складе: удаленные и аннулированные товары не учитываются
+CREATE UNIQUE INDEX products_barcode_in_stock_key ON shop.products (barcode)
+    WHERE barcode IS NOT NULL AND deleted_at IS NULL AND voided_at IS NULL;
+
+-- migrate:down
+DROP INDEX IF EXISTS shop.products_barcode_in_stock_key;
+ALTER TABLE shop.products DROP COLUMN IF EXISTS barcode;
@@ -0,0 +1,7 @@
+-- migrate:up
+
+-- Причина удаления товара; у товаров, удаленных по LIFO, причина не указывается
+ALTER TABLE shop.products ADD COLUMN delete_reason TEXT DEFAULT NULL;
+
+-- migrate:down
+ALTER TABLE shop.products DROP COLUMN IF EXISTS delete_reason;
@@ -0,0 +1,53 @@
+-- migrate:up
+
+-- Жизненный цикл товара: received -> stored -> issued / returned_to_sender.
+-- Код выдачи хранится как HMAC, сам код генерируется сервисом через crypto/rand и возвращается один раз при выпуске
+ALTER TABLE shop.products
+    ADD COLUMN status VARCHAR(50) CHECK (status IN ('received', 'stored', 'issued', 'returned_to_sender')) NOT NULL DEFAULT 'received',
+    ADD COLUMN pickup_code_hash VARCHAR(64) DEFAULT NULL,
+    ADD COLUMN pickup_attempts INTEGER NOT NULL DEFAULT 0;
+
+-- Товары закрытых приемок уже лежат на хранении. HMAC кода нельзя посчитать без ключа сервиса,
+-- поэтому код выдачи им выпускается через POST /products/{productId}/pickup_code
+UPDATE shop.products p
+SET status = 'stored'
+FROM shop.receptions r
+WHERE r.id = p.reception_id AND r.status IN ('closed', 'verified');
+
+-- Таблица истории статусов товаров (ProductStatusChange)
+CREATE TABLE shop.product_status_history (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    product_id UUID NOT NULL REFERENCES shop.products(id),
+    from_status VARCHAR(50) NOT NULL,
+    to_status VARCHAR(50) NOT NULL,
+    actor_id UUID NOT NULL,
+    actor_role VARCHAR(50) NOT NULL,
+    reason TEXT DEFAULT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_product_status_history_product_id_created_at
+    ON shop.product_status_history (product_id, created_at);
+
+-- Остатки ПВЗ считаются по товарам, которые еще на руках
+CREATE INDEX idx_products_reception_id_status ON shop.products (reception_id, status)
+    WHERE deleted_at IS NULL AND voided_at IS NULL;
+
+-- Выданные и возвращенные отправителю товары освобождают штрихкод
+DROP INDEX IF EXISTS shop.products_barcode_in_stock_key;
+CREATE UNIQUE INDEX products_barcode_in_stock_key ON shop.products (barcode)
+    WHERE barcode IS NOT NULL AND deleted_at IS NULL AND voided_at IS NULL AND status IN ('received', 'stored');
+
+-- migrate:down
+DROP INDEX IF EXISTS shop.products_barcode_in_stock_key;
+CREATE UNIQUE INDEX products_barcode_in_stock_key ON shop.products (barcode)
+    WHERE barcode IS NOT NULL AND deleted_at IS NULL AND voided_at IS NULL;
+
+DROP INDEX IF EXISTS shop.idx_products_reception_id_status;
+DROP INDEX IF EXISTS shop.idx_product_status_history_product_id_created_at;
+DROP TABLE IF EXISTS shop.product_status_history;
+
+ALTER TABLE shop.products
+    DROP COLUMN IF EXISTS pickup_attempts,
+    DROP COLUMN IF EXISTS pickup_code_hash,
+    DROP COLUMN IF EXISTS status;
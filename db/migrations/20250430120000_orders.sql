@@ -0,0 +1,39 @@
+-- migrate:up
+
+-- Таблица заказов покупателей (Order)
+CREATE TABLE shop.orders (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    number VARCHAR(64) NOT NULL UNIQUE,
+    pvz_id UUID NOT NULL REFERENCES shop.pvz(id),
+    recipient_phone VARCHAR(16) DEFAULT NULL,
+    recipient_email VARCHAR(255) DEFAULT NULL,
+    status VARCHAR(50) CHECK (status IN ('awaiting', 'ready', 'issued')) NOT NULL DEFAULT 'awaiting',
+    -- хранится только хеш одноразового кода выдачи
+    pickup_code_hash VARCHAR(64) DEFAULT NULL,
+    pickup_attempts INTEGER NOT NULL DEFAULT 0,
+    ready_at TIMESTAMP DEFAULT NULL,
+    issued_at TIMESTAMP DEFAULT NULL,
+    issued_by UUID DEFAULT NULL,
+    created_by UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CHECK (recipient_phone IS NOT NULL OR recipient_email IS NOT NULL)
+);
+
+-- Таблица позиций заказа (OrderItem), товар сопоставляется с позицией по штрихкоду при сканировании
+CREATE TABLE shop.order_items (
+    order_id UUID NOT NULL REFERENCES shop.orders(id) ON DELETE CASCADE,
+    barcode VARCHAR(64) NOT NULL,
+    product_id UUID DEFAULT NULL REFERENCES shop.products(id),
+    PRIMARY KEY (order_id, barcode)
+);
+
+CREATE INDEX idx_orders_pvz_id_status ON shop.orders (pvz_id, status);
+CREATE INDEX idx_order_items_barcode ON shop.order_items (barcode);
+CREATE INDEX idx_order_items_product_id ON shop.order_items (product_id);
+
+-- migrate:down
+DROP INDEX IF EXISTS shop.idx_order_items_product_id;
+DROP INDEX IF EXISTS shop.idx_order_items_barcode;
+DROP INDEX IF EXISTS shop.idx_orders_pvz_id_status;
+DROP TABLE IF EXISTS shop.order_items;
+DROP TABLE IF EXISTS shop.orders;
@@ -0,0 +1,50 @@
+-- migrate:up
+
+-- Возвращенный получателем товар снова находится в ПВЗ до отправки обратно отправителю
+ALTER TABLE shop.products DROP CONSTRAINT IF EXISTS products_status_check;
+ALTER TABLE shop.products ADD CONSTRAINT products_status_check
+    CHECK (status IN ('received', 'stored', 'issued', 'returned', 'returned_to_sender'));
+
+-- Таблица исходящих отправок возвратов (ReturnShipment), жизненный цикл повторяет приемку
+CREATE TABLE shop.return_shipments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    pvz_id UUID NOT NULL REFERENCES shop.pvz(id),
+    status VARCHAR(50) CHECK (status IN ('in_progress', 'closed')) NOT NULL DEFAULT 'in_progress',
+    created_by UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    closed_by UUID DEFAULT NULL,
+    closed_at TIMESTAMP DEFAULT NULL
+);
+
+-- Не более одной открытой отправки возвратов на ПВЗ
+CREATE UNIQUE INDEX uq_return_shipments_pvz_id_open
+    ON shop.return_shipments (pvz_id)
+    WHERE status = 'in_progress';
+
+CREATE INDEX idx_return_shipments_pvz_id_created_at ON shop.return_shipments (pvz_id, created_at);
+
+-- Таблица возвратов (Return), товар может быть возвращен только один раз
+CREATE TABLE shop.returns (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    product_id UUID NOT NULL UNIQUE REFERENCES shop.products(id),
+    shipment_id UUID NOT NULL REFERENCES shop.return_shipments(id),
+    reason_code VARCHAR(50) CHECK (reason_code IN ('refused', 'damaged', 'wrong_item', 'not_as_described', 'changed_mind', 'other')) NOT NULL,
+    condition VARCHAR(50) CHECK (condition IN ('intact', 'opened', 'defective')) NOT NULL,
+    comment TEXT DEFAULT NULL,
+    created_by UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_returns_shipment_id ON shop.returns (shipment_id);
+
+-- migrate:down
+DROP INDEX IF EXISTS shop.idx_returns_shipment_id;
+DROP TABLE IF EXISTS shop.returns;
+DROP INDEX IF EXISTS shop.idx_return_shipments_pvz_id_created_at;
+DROP INDEX IF EXISTS shop.uq_return_shipments_pvz_id_open;
+DROP TABLE IF EXISTS shop.return_shipments;
+
+UPDATE shop.products SET status = 'returned_to_sender' WHERE status = 'returned';
+ALTER TABLE shop.products DROP CONSTRAINT IF EXISTS products_status_check;
+ALTER TABLE shop.products ADD CONSTRAINT products_status_check
+    CHECK (status IN ('received', 'stored', 'issued', 'returned_to_sender'));
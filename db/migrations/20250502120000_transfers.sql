@@ -0,0 +1,79 @@
+-- migrate:up
+
+-- Приемка поставки или приемка перемещенных из другого ПВЗ товаров
+ALTER TABLE shop.receptions
+    ADD COLUMN type VARCHAR(50) CHECK (type IN ('supply', 'transfer')) NOT NULL DEFAULT 'supply';
+
+-- Приемка перемещения открыта только внутри транзакции получения перемещения и не мешает
+-- открытой приемке поставки в ПВЗ назначения. Ограничение оставляем только для приемок поставок
+DROP INDEX IF EXISTS shop.uq_receptions_pvz_id_open;
+CREATE UNIQUE INDEX uq_receptions_pvz_id_open
+    ON shop.receptions (pvz_id)
+    WHERE status IN ('draft', 'in_progress') AND type = 'supply';
+
+-- Текущий ПВЗ товара хранится отдельно от приемки: reception_id всегда указывает на приемку поставки,
+-- а при получении перемещения меняется только current_pvz_id
+ALTER TABLE shop.products ADD COLUMN current_pvz_id UUID REFERENCES shop.pvz(id);
+
+UPDATE shop.products p
+SET current_pvz_id = r.pvz_id
+FROM shop.receptions r
+WHERE r.id = p.reception_id;
+
+ALTER TABLE shop.products ALTER COLUMN current_pvz_id SET NOT NULL;
+
+CREATE INDEX idx_products_current_pvz_id ON shop.products (current_pvz_id);
+
+-- Перемещаемый между ПВЗ товар находится в пути и не числится ни в одном ПВЗ
+ALTER TABLE shop.products DROP CONSTRAINT IF EXISTS products_status_check;
+ALTER TABLE shop.products ADD CONSTRAINT products_status_check
+    CHECK (status IN ('received', 'stored', 'in_transit', 'issued', 'returned', 'returned_to_sender'));
+
+-- Таблица перемещений между ПВЗ (Transfer)
+CREATE TABLE shop.transfers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    source_pvz_id UUID NOT NULL REFERENCES shop.pvz(id),
+    destination_pvz_id UUID NOT NULL REFERENCES shop.pvz(id),
+    status VARCHAR(50) CHECK (status IN ('created', 'dispatched', 'received')) NOT NULL DEFAULT 'created',
+    -- приемка в ПВЗ назначения, через которую получены товары
+    reception_id UUID DEFAULT NULL REFERENCES shop.receptions(id),
+    created_by UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    dispatched_by UUID DEFAULT NULL,
+    dispatched_at TIMESTAMP DEFAULT NULL,
+    received_by UUID DEFAULT NULL,
+    received_at TIMESTAMP DEFAULT NULL,
+    CHECK (source_pvz_id <> destination_pvz_id)
+);
+
+CREATE INDEX idx_transfers_status ON shop.transfers (status);
+
+-- Таблица товаров перемещения (TransferItem), исходная приемка сохраняется для истории
+CREATE TABLE shop.transfer_items (
+    transfer_id UUID NOT NULL REFERENCES shop.transfers(id) ON DELETE CASCADE,
+    product_id UUID NOT NULL REFERENCES shop.products(id),
+    source_reception_id UUID NOT NULL REFERENCES shop.receptions(id),
+    PRIMARY KEY (transfer_id, product_id)
+);
+
+CREATE INDEX idx_transfer_items_product_id ON shop.transfer_items (product_id);
+
+-- migrate:down
+DROP INDEX IF EXISTS shop.idx_transfer_items_product_id;
+DROP TABLE IF EXISTS shop.transfer_items;
+DROP INDEX IF EXISTS shop.idx_transfers_status;
+DROP TABLE IF EXISTS shop.transfers;
+
+DROP INDEX IF EXISTS shop.idx_products_current_pvz_id;
+ALTER TABLE shop.products DROP COLUMN IF EXISTS current_pvz_id;
+
+UPDATE shop.products SET status = 'stored' WHERE status = 'in_transit';
+ALTER TABLE shop.products DROP CONSTRAINT IF EXISTS products_status_check;
+ALTER TABLE shop.products ADD CONSTRAINT products_status_check
+    CHECK (status IN ('received', 'stored', 'issued', 'returned', 'returned_to_sender'));
+
+DROP INDEX IF EXISTS shop.uq_receptions_pvz_id_open;
+ALTER TABLE shop.receptions DROP COLUMN IF EXISTS type;
+CREATE UNIQUE INDEX uq_receptions_pvz_id_open
+    ON shop.receptions (pvz_id)
+    WHERE status IN ('draft', 'in_progress');
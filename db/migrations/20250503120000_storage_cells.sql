@@ -0,0 +1,24 @@
+-- migrate:up
+
+-- Таблица ячеек хранения ПВЗ (StorageCell): стеллаж, полка и ячейка образуют код ячейки
+CREATE TABLE shop.storage_cells (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    pvz_id UUID NOT NULL REFERENCES shop.pvz(id),
+    rack VARCHAR(10) NOT NULL,
+    shelf VARCHAR(10) NOT NULL,
+    cell VARCHAR(10) NOT NULL,
+    code VARCHAR(32) NOT NULL,
+    capacity INTEGER NOT NULL CHECK (capacity > 0),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT storage_cells_pvz_id_code_key UNIQUE (pvz_id, code)
+);
+
+-- Ячейка товара, занятость ячейки считается только по товарам на руках ПВЗ
+ALTER TABLE shop.products ADD COLUMN cell_id UUID DEFAULT NULL REFERENCES shop.storage_cells(id);
+
+CREATE INDEX idx_products_cell_id ON shop.products (cell_id) WHERE cell_id IS NOT NULL;
+
+-- migrate:down
+DROP INDEX IF EXISTS shop.idx_products_cell_id;
+ALTER TABLE shop.products DROP COLUMN IF EXISTS cell_id;
+DROP TABLE IF EXISTS shop.storage_cells;
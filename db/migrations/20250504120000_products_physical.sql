@@ -0,0 +1,32 @@
+-- migrate:up
+
+-- Вес товара в граммах и габариты в сантиметрах, габариты задаются либо целиком, либо не задаются
+ALTER TABLE shop.products
+    ADD COLUMN weight INTEGER DEFAULT NULL CHECK (weight > 0),
+    ADD COLUMN length INTEGER DEFAULT NULL CHECK (length > 0),
+    ADD COLUMN width INTEGER DEFAULT NULL CHECK (width > 0),
+    ADD COLUMN height INTEGER DEFAULT NULL CHECK (height > 0),
+    ADD CONSTRAINT products_dimensions_check
+        CHECK ((length IS NULL) = (width IS NULL) AND (width IS NULL) = (height IS NULL));
+
+-- Ограничения ПВЗ по весу (граммы) и объему (кубические сантиметры), NULL - лимит не проверяется
+CREATE TABLE shop.pvz_limits (
+    pvz_id UUID PRIMARY KEY REFERENCES shop.pvz(id),
+    max_item_weight INTEGER DEFAULT NULL CHECK (max_item_weight > 0),
+    max_item_volume INTEGER DEFAULT NULL CHECK (max_item_volume > 0),
+    max_total_weight BIGINT DEFAULT NULL CHECK (max_total_weight > 0),
+    max_total_volume BIGINT DEFAULT NULL CHECK (max_total_volume > 0),
+    on_exceed VARCHAR(10) CHECK (on_exceed IN ('reject', 'warn')) NOT NULL DEFAULT 'reject',
+    updated_by UUID NOT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- migrate:down
+DROP TABLE IF EXISTS shop.pvz_limits;
+
+ALTER TABLE shop.products
+    DROP CONSTRAINT IF EXISTS products_dimensions_check,
+    DROP COLUMN IF EXISTS height,
+    DROP COLUMN IF EXISTS width,
+    DROP COLUMN IF EXISTS length,
+    DROP COLUMN IF EXISTS weight;
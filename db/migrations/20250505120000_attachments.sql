@@ -0,0 +1,24 @@
+-- migrate:up
+
+-- Вложения приемок и товаров (Attachment): содержимое лежит в хранилище файлов по storage_key, в БД только метаданные
+CREATE TABLE shop.attachments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    reception_id UUID DEFAULT NULL REFERENCES shop.receptions(id),
+    product_id UUID DEFAULT NULL REFERENCES shop.products(id),
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(64) NOT NULL,
+    size_bytes BIGINT NOT NULL CHECK (size_bytes > 0),
+    storage_key VARCHAR(255) NOT NULL UNIQUE,
+    created_by UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    -- вложение относится ровно к одной приемке или одному товару
+    CONSTRAINT attachments_owner_check CHECK ((reception_id IS NULL) <> (product_id IS NULL))
+);
+
+CREATE INDEX idx_attachments_reception_id ON shop.attachments (reception_id) WHERE reception_id IS NOT NULL;
+CREATE INDEX idx_attachments_product_id ON shop.attachments (product_id) WHERE product_id IS NOT NULL;
+
+-- migrate:down
+DROP INDEX IF EXISTS shop.idx_attachments_product_id;
+DROP INDEX IF EXISTS shop.idx_attachments_reception_id;
+DROP TABLE IF EXISTS shop.attachments;
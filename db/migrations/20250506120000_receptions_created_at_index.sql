@@ -0,0 +1,7 @@
+-- migrate:up
+
+-- Статистика приемок фильтрует приемки всех ПВЗ по дате создания
+CREATE INDEX idx_receptions_created_at ON shop.receptions (created_at);
+
+-- migrate:down
+DROP INDEX IF EXISTS shop.idx_receptions_created_at;
@@ -0,0 +1,21 @@
+-- migrate:up
+
+-- Отчет о скорости приемок выбирает приемки по времени закрытия
+CREATE INDEX idx_reception_status_history_closed_at
+    ON shop.reception_status_history (created_at)
+    WHERE to_status = 'closed';
+
+-- Ежедневные отчеты о скорости приемок, которые строит фоновая задача
+CREATE TABLE shop.productivity_reports (
+    report_date DATE PRIMARY KEY,
+    start_date TIMESTAMP NOT NULL,
+    end_date TIMESTAMP NOT NULL,
+    sla_minutes INT NOT NULL CHECK (sla_minutes > 0),
+    idle_gap_minutes INT NOT NULL CHECK (idle_gap_minutes > 0),
+    report_rows JSONB NOT NULL,
+    generated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- migrate:down
+DROP TABLE IF EXISTS shop.productivity_reports;
+DROP INDEX IF EXISTS shop.idx_reception_status_history_closed_at;
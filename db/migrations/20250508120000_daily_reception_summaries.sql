@@ -0,0 +1,47 @@
+-- migrate:up
+
+-- Суточные сводки закрытых приемок поставок (closed и verified) по дню создания приемки.
+-- Приемки перемещений не учитываются, товары в них учтены в исходных приемках поставок.
+-- Строка ПВЗ за день пересчитывается при каждой смене статуса приемки в closed/verified и обратно.
+-- Сводки производные и без внешних ключей: их пересчет не блокирует строки ПВЗ
+CREATE TABLE shop.daily_pvz_summaries (
+    day DATE NOT NULL,
+    pvz_id UUID NOT NULL,
+    receptions INT NOT NULL CHECK (receptions >= 0),
+    -- товары без удаленных и аннулированных
+    products INT NOT NULL CHECK (products >= 0),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (day, pvz_id)
+);
+
+-- Те же сводки в разбивке по типам товаров, receptions - приемки, в которых есть товары типа
+CREATE TABLE shop.daily_product_type_summaries (
+    day DATE NOT NULL,
+    pvz_id UUID NOT NULL,
+    product_type VARCHAR(50) NOT NULL,
+    receptions INT NOT NULL CHECK (receptions >= 0),
+    products INT NOT NULL CHECK (products >= 0),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (day, pvz_id, product_type)
+);
+
+-- Заполнение сводок по уже закрытым приемкам
+INSERT INTO shop.daily_pvz_summaries (day, pvz_id, receptions, products)
+SELECT r.created_at::date, r.pvz_id, COUNT(DISTINCT r.id), COUNT(p.id)
+FROM shop.receptions r
+LEFT JOIN shop.products p ON p.reception_id = r.id
+    AND p.deleted_at IS NULL AND p.voided_at IS NULL
+WHERE r.status IN ('closed', 'verified') AND r.type = 'supply' AND r.created_at IS NOT NULL
+GROUP BY r.created_at::date, r.pvz_id;
+
+INSERT INTO shop.daily_product_type_summaries (day, pvz_id, product_type, receptions, products)
+SELECT r.created_at::date, r.pvz_id, p.type, COUNT(DISTINCT r.id), COUNT(p.id)
+FROM shop.receptions r
+JOIN shop.products p ON p.reception_id = r.id
+    AND p.deleted_at IS NULL AND p.voided_at IS NULL
+WHERE r.status IN ('closed', 'verified') AND r.type = 'supply' AND r.created_at IS NOT NULL
+GROUP BY r.created_at::date, r.pvz_id, p.type;
+
+-- migrate:down
+DROP TABLE IF EXISTS shop.daily_product_type_summaries;
+DROP TABLE IF EXISTS shop.daily_pvz_summaries;
@@ -0,0 +1,82 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/devWaylander/pvz_store/pkg/log"
+)
+
+// localStore хранит содержимое вложений в файлах каталога root, ключ задает путь внутри каталога
+type localStore struct {
+	root string
+}
+
+func NewLocalStore(root string) (*localStore, error) {
+	if err := os.MkdirAll(root, 0o750); err != nil {
+		log.Logger.Err(err).Str("root", root).Msg("method NewLocalStore")
+		return nil, errors.New("could not create blob store directory")
+	}
+
+	return &localStore{root: root}, nil
+}
+
+// Put записывает содержимое во временный файл и переименовывает его, чтобы читатели не увидели недописанный файл
+func (s *localStore) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	path := s.path(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		log.Logger.Err(err).Str("key", key).Msg("method Put")
+		return errors.New("could not put blob")
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		log.Logger.Err(err).Str("key", key).Msg("method Put")
+		return errors.New("could not put blob")
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		log.Logger.Err(err).Str("key", key).Msg("method Put")
+		return errors.New("could not put blob")
+	}
+	if err := tmp.Close(); err != nil {
+		log.Logger.Err(err).Str("key", key).Msg("method Put")
+		return errors.New("could not put blob")
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		log.Logger.Err(err).Str("key", key).Msg("method Put")
+		return errors.New("could not put blob")
+	}
+
+	return nil
+}
+
+func (s *localStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	file, err := os.Open(s.path(key))
+	if err != nil {
+		log.Logger.Err(err).Str("key", key).Msg("method Get")
+		return nil, errors.New("could not get blob")
+	}
+
+	return file, nil
+}
+
+func (s *localStore) Delete(ctx context.Context, key string) error {
+	err := os.Remove(s.path(key))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		log.Logger.Err(err).Str("key", key).Msg("method Delete")
+		return errors.New("could not delete blob")
+	}
+
+	return nil
+}
+
+// path переводит ключ в путь внутри root, ключ с ".." не выходит за пределы каталога
+func (s *localStore) path(key string) string {
+	return filepath.Join(s.root, filepath.Clean("/"+key))
+}
@@ -0,0 +1,60 @@
+package blobstore
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func Test_localStore(t *testing.T) {
+	root := t.TempDir()
+	store, err := NewLocalStore(root)
+	if err != nil {
+		t.Fatalf("NewLocalStore() unexpected error = %v", err)
+	}
+	ctx := context.Background()
+
+	content := "scanned delivery note"
+	if err := store.Put(ctx, "receptions/1/note", strings.NewReader(content), int64(len(content)), "application/pdf"); err != nil {
+		t.Fatalf("Put() unexpected error = %v", err)
+	}
+
+	blob, err := store.Get(ctx, "receptions/1/note")
+	if err != nil {
+		t.Fatalf("Get() unexpected error = %v", err)
+	}
+	got, _ := io.ReadAll(blob)
+	blob.Close()
+	if string(got) != content {
+		t.Errorf("Get() = %q, want %q", got, content)
+	}
+
+	if err := store.Delete(ctx, "receptions/1/note"); err != nil {
+		t.Fatalf("Delete() unexpected error = %v", err)
+	}
+	if _, err := store.Get(ctx, "receptions/1/note"); err == nil {
+		t.Errorf("Get() expected error for deleted blob")
+	}
+	if err := store.Delete(ctx, "receptions/1/note"); err != nil {
+		t.Errorf("Delete() of missing blob unexpected error = %v", err)
+	}
+}
+
+func Test_localStore_path(t *testing.T) {
+	root := t.TempDir()
+	store, err := NewLocalStore(root)
+	if err != nil {
+		t.Fatalf("NewLocalStore() unexpected error = %v", err)
+	}
+
+	// ключ с ".." не должен выходить за пределы каталога хранилища
+	if err := store.Put(context.Background(), "../../escape", strings.NewReader("x"), 1, "image/png"); err != nil {
+		t.Fatalf("Put() unexpected error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(root, "escape")); err != nil {
+		t.Errorf("Put() wrote blob outside of root: %v", err)
+	}
+}
@@ -0,0 +1,93 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/devWaylander/pvz_store/pkg/log"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// S3Config параметры S3-совместимого хранилища (AWS S3, MinIO, Yandex Object Storage)
+type S3Config struct {
+	Endpoint  string
+	AccessKey string
+	SecretKey string
+	Bucket    string
+	Region    string
+	UseSSL    bool
+}
+
+// s3Store хранит содержимое вложений объектами бакета, ключ вложения используется как ключ объекта
+type s3Store struct {
+	client *minio.Client
+	bucket string
+}
+
+// NewS3Store подключается к хранилищу и создает бакет, если его еще нет.
+// Регион задается явно, чтобы клиент не запрашивал расположение бакета перед каждым обращением
+func NewS3Store(ctx context.Context, cfg S3Config) (*s3Store, error) {
+	client, err := minio.New(cfg.Endpoint, &minio.Options{
+		Creds:        credentials.NewStaticV4(cfg.AccessKey, cfg.SecretKey, ""),
+		Secure:       cfg.UseSSL,
+		Region:       cfg.Region,
+		BucketLookup: minio.BucketLookupPath,
+	})
+	if err != nil {
+		log.Logger.Err(err).Str("endpoint", cfg.Endpoint).Msg("method NewS3Store")
+		return nil, errors.New("could not create s3 client")
+	}
+
+	exists, err := client.BucketExists(ctx, cfg.Bucket)
+	if err != nil {
+		log.Logger.Err(err).Str("bucket", cfg.Bucket).Msg("method NewS3Store")
+		return nil, errors.New("could not check s3 bucket")
+	}
+	if !exists {
+		err := client.MakeBucket(ctx, cfg.Bucket, minio.MakeBucketOptions{Region: cfg.Region})
+		if err != nil {
+			log.Logger.Err(err).Str("bucket", cfg.Bucket).Msg("method NewS3Store")
+			return nil, errors.New("could not create s3 bucket")
+		}
+	}
+
+	return &s3Store{client: client, bucket: cfg.Bucket}, nil
+}
+
+func (s *s3Store) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	_, err := s.client.PutObject(ctx, s.bucket, key, r, size, minio.PutObjectOptions{ContentType: contentType})
+	if err != nil {
+		log.Logger.Err(err).Str("key", key).Msg("method Put")
+		return errors.New("could not put blob")
+	}
+
+	return nil
+}
+
+// Get открывает объект на чтение, Stat проверяет наличие объекта сразу, а не при первом чтении
+func (s *s3Store) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	object, err := s.client.GetObject(ctx, s.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		log.Logger.Err(err).Str("key", key).Msg("method Get")
+		return nil, errors.New("could not get blob")
+	}
+	if _, err := object.Stat(); err != nil {
+		object.Close()
+		log.Logger.Err(err).Str("key", key).Msg("method Get")
+		return nil, errors.New("could not get blob")
+	}
+
+	return object, nil
+}
+
+func (s *s3Store) Delete(ctx context.Context, key string) error {
+	err := s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{})
+	if err != nil {
+		log.Logger.Err(err).Str("key", key).Msg("method Delete")
+		return errors.New("could not delete blob")
+	}
+
+	return nil
+}
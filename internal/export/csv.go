@@ -0,0 +1,30 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+// utf8BOM позволяет Excel открыть CSV с кириллицей без выбора кодировки
+const utf8BOM = "\xEF\xBB\xBF"
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func newCSVWriter(w io.Writer) (*csvWriter, error) {
+	if _, err := io.WriteString(w, utf8BOM); err != nil {
+		return nil, err
+	}
+
+	return &csvWriter{w: csv.NewWriter(w)}, nil
+}
+
+func (c *csvWriter) Write(record []string) error {
+	return c.w.Write(record)
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
@@ -0,0 +1,57 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"strings"
+)
+
+const (
+	FormatCSV  = "csv"
+	FormatXLSX = "xlsx"
+
+	ContentTypeCSV  = "text/csv"
+	ContentTypeXLSX = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+)
+
+// Writer построчно пишет табличную выгрузку, Close дописывает окончание файла
+type Writer interface {
+	Write(record []string) error
+	Close() error
+}
+
+// NewWriter создает writer выгрузки в формате format поверх w
+func NewWriter(format string, w io.Writer) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVWriter(w)
+	case FormatXLSX:
+		return newXLSXWriter(w)
+	default:
+		return nil, fmt.Errorf("unknown export format %q", format)
+	}
+}
+
+// FormatFromAccept выбирает формат выгрузки по заголовку Accept, по умолчанию CSV
+func FormatFromAccept(accept string) string {
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(mediaRange))
+		if err != nil {
+			continue
+		}
+		switch mediaType {
+		case ContentTypeXLSX:
+			return FormatXLSX
+		case ContentTypeCSV:
+			return FormatCSV
+		}
+	}
+
+	return FormatCSV
+}
+
+// FileName имя файла выгрузки для заголовка Content-Disposition
+func FileName(name, format string) string {
+	return mime.FormatMediaType("attachment", map[string]string{"filename": name + "." + format})
+}
@@ -0,0 +1,89 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+)
+
+func writeAll(t *testing.T, format string, records [][]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	w, err := NewWriter(format, &buf)
+	if err != nil {
+		t.Fatalf("NewWriter() unexpected error = %v", err)
+	}
+	for _, record := range records {
+		if err := w.Write(record); err != nil {
+			t.Fatalf("Write() unexpected error = %v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close() unexpected error = %v", err)
+	}
+
+	return buf.Bytes()
+}
+
+func Test_csvWriter(t *testing.T) {
+	got := writeAll(t, FormatCSV, [][]string{{"city", "type"}, {"Москва", "обувь, \"зимняя\""}})
+
+	want := utf8BOM + "city,type\nМосква,\"обувь, \"\"зимняя\"\"\"\n"
+	if string(got) != want {
+		t.Errorf("csv = %q, want %q", got, want)
+	}
+}
+
+func Test_xlsxWriter(t *testing.T) {
+	got := writeAll(t, FormatXLSX, [][]string{{"city"}, {"<Казань & Co>"}})
+
+	zr, err := zip.NewReader(bytes.NewReader(got), int64(len(got)))
+	if err != nil {
+		t.Fatalf("xlsx is not a zip archive: %v", err)
+	}
+
+	parts := map[string]string{}
+	for _, file := range zr.File {
+		rc, err := file.Open()
+		if err != nil {
+			t.Fatalf("open %s: %v", file.Name, err)
+		}
+		content, _ := io.ReadAll(rc)
+		rc.Close()
+		parts[file.Name] = string(content)
+	}
+
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml", "xl/_rels/workbook.xml.rels", "xl/worksheets/sheet1.xml"} {
+		if _, ok := parts[name]; !ok {
+			t.Errorf("xlsx part %s is missing", name)
+		}
+	}
+
+	sheet := parts["xl/worksheets/sheet1.xml"]
+	if !strings.Contains(sheet, `<row><c t="inlineStr"><is><t xml:space="preserve">&lt;Казань &amp; Co&gt;</t></is></c></row>`) {
+		t.Errorf("sheet does not contain escaped row: %s", sheet)
+	}
+	if !strings.HasSuffix(sheet, xlsxSheetFooter) {
+		t.Errorf("sheet is not closed: %s", sheet)
+	}
+}
+
+func Test_FormatFromAccept(t *testing.T) {
+	tests := []struct {
+		accept string
+		want   string
+	}{
+		{accept: "", want: FormatCSV},
+		{accept: "*/*", want: FormatCSV},
+		{accept: "text/csv", want: FormatCSV},
+		{accept: "application/json, " + ContentTypeXLSX + ";q=0.9", want: FormatXLSX},
+	}
+	for _, tt := range tests {
+		if got := FormatFromAccept(tt.accept); got != tt.want {
+			t.Errorf("FormatFromAccept(%q) = %v, want %v", tt.accept, got, tt.want)
+		}
+	}
+}
@@ -2,16 +2,25 @@ package handler
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
 	"net/http"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/devWaylander/pvz_store/api"
+	"github.com/devWaylander/pvz_store/internal/export"
 	internalErrors "github.com/devWaylander/pvz_store/pkg/errors"
 	"github.com/devWaylander/pvz_store/pkg/models"
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime"
+	"github.com/oapi-codegen/runtime/types"
 )
 
 type AuthMiddleware interface {
@@ -25,10 +34,65 @@ type Service interface {
 	GetPVZsInfo(ctx context.Context, data api.GetPvzParams) ([]models.PvzInfo, error)
 	CreateReception(ctx context.Context, data api.PostReceptionsJSONBody) (api.Reception, error)
 	CloseReception(ctx context.Context, pvzUUID uuid.UUID) (api.Reception, error)
+	StartReception(ctx context.Context, recUUID uuid.UUID) (api.Reception, error)
+	VerifyReception(ctx context.Context, recUUID uuid.UUID, reason string) (api.Reception, error)
+	ReopenReception(ctx context.Context, recUUID uuid.UUID, reason string) (api.Reception, error)
+	CancelReception(ctx context.Context, recUUID uuid.UUID, reason string) (api.Reception, error)
+	GetReceptionHistory(ctx context.Context, recUUID uuid.UUID) ([]api.ReceptionStatusChange, error)
+	GetDeletedProducts(ctx context.Context, recUUID uuid.UUID) ([]api.Product, error)
+	GetReceptions(ctx context.Context, data api.GetReceptionsParams) ([]api.ReceptionSummary, error)
+	GetReception(ctx context.Context, recUUID uuid.UUID, data api.GetReceptionsReceptionIdParams) (api.ReceptionDetail, error)
+	CloseReceptionWithOverride(ctx context.Context, recUUID uuid.UUID, reason string) (api.Reception, error)
+	GetDiscrepancyReport(ctx context.Context, recUUID uuid.UUID) (api.DiscrepancyReport, error)
+	CreateManifest(ctx context.Context, data api.PostManifestsJSONBody) (api.Manifest, error)
+	GetManifest(ctx context.Context, manifestUUID uuid.UUID) (api.Manifest, error)
 	CreateProduct(ctx context.Context, data api.PostProductsJSONBody) (api.Product, error)
+	CreateProducts(ctx context.Context, data api.PostProductsBatchJSONBody) ([]api.Product, error)
+	GetProductTypes(ctx context.Context) ([]api.ProductType, error)
+	CreateProductType(ctx context.Context, data api.ProductType) (api.ProductType, error)
+	UpdateProductTypeSchema(ctx context.Context, name string, data api.PutProductTypesTypeNameJSONBody) (api.ProductType, error)
 	DeleteLastProduct(ctx context.Context, pvzUUID uuid.UUID) error
+	DeleteProduct(ctx context.Context, productUUID uuid.UUID, reason string) error
+	UpdateProduct(ctx context.Context, productUUID uuid.UUID, data api.ProductPatch) (api.Product, error)
+	IssueProduct(ctx context.Context, productUUID uuid.UUID, pickupCode string) (api.Product, error)
+	ReturnProductToSender(ctx context.Context, productUUID uuid.UUID, reason string) (api.Product, error)
+	IssueProductPickupCode(ctx context.Context, productUUID uuid.UUID) (api.PickupCode, error)
+	GetPVZStock(ctx context.Context, pvzUUID uuid.UUID, data api.GetPvzPvzIdStockParams) (api.PvzStock, error)
+	CreateOrder(ctx context.Context, data api.PostOrdersJSONBody) (api.Order, error)
+	GetOrder(ctx context.Context, orderUUID uuid.UUID) (api.Order, error)
+	IssueOrder(ctx context.Context, orderUUID uuid.UUID, pickupCode string) (api.Order, error)
+	RegenerateOrderPickupCode(ctx context.Context, orderUUID uuid.UUID) (api.Order, error)
+	CreateReturnShipment(ctx context.Context, data api.PostReturnShipmentsJSONBody) (api.ReturnShipment, error)
+	GetReturnShipment(ctx context.Context, shipmentUUID uuid.UUID) (api.ReturnShipment, error)
+	CloseReturnShipment(ctx context.Context, pvzUUID uuid.UUID) (api.ReturnShipment, error)
+	CreateReturn(ctx context.Context, data api.PostReturnsJSONBody) (api.Return, error)
+	CreateTransfer(ctx context.Context, data api.PostTransfersJSONBody) (api.Transfer, error)
+	GetTransfer(ctx context.Context, transferUUID uuid.UUID) (api.Transfer, error)
+	GetTransfersInTransit(ctx context.Context, params api.GetTransfersInTransitParams) ([]api.Transfer, error)
+	DispatchTransfer(ctx context.Context, transferUUID uuid.UUID) (api.Transfer, error)
+	ReceiveTransfer(ctx context.Context, transferUUID uuid.UUID) (api.Transfer, error)
+	CreateStorageCell(ctx context.Context, pvzUUID uuid.UUID, data api.PostPvzPvzIdCellsJSONBody) (api.StorageCell, error)
+	GetStorageCells(ctx context.Context, pvzUUID uuid.UUID) ([]api.StorageCell, error)
+	MoveProduct(ctx context.Context, productUUID, cellUUID uuid.UUID) (api.Product, error)
+	GetProductLocation(ctx context.Context, productUUID uuid.UUID) (api.ProductLocation, error)
+	SetPvzLimits(ctx context.Context, pvzUUID uuid.UUID, limits api.PvzLimits) (api.PvzLimits, error)
+	GetPvzCapacity(ctx context.Context, pvzUUID uuid.UUID) (api.PvzCapacity, error)
+	CreateReceptionAttachment(ctx context.Context, recUUID uuid.UUID, fileName string, r io.Reader) (api.Attachment, error)
+	CreateProductAttachment(ctx context.Context, productUUID uuid.UUID, fileName string, r io.Reader) (api.Attachment, error)
+	GetReceptionAttachments(ctx context.Context, recUUID uuid.UUID) ([]api.Attachment, error)
+	GetProductAttachments(ctx context.Context, productUUID uuid.UUID) ([]api.Attachment, error)
+	GetAttachmentContent(ctx context.Context, attachmentUUID uuid.UUID) (api.Attachment, io.ReadCloser, error)
+	GetReceptionStats(ctx context.Context, params api.GetStatsReceptionsParams) ([]api.ReceptionStatsRow, error)
+	StreamPVZsInfo(ctx context.Context, params api.GetPvzParams) (func(fn func(info models.PvzInfo) error) error, error)
+	ExportPVZs(ctx context.Context, params api.GetExportPvzParams) (func(w models.RowWriter) error, error)
+	ExportReceptions(ctx context.Context, params api.GetExportReceptionsParams) (func(w models.RowWriter) error, error)
+	GetProductivityReport(ctx context.Context, params api.GetReportsProductivityParams) (api.ProductivityReport, error)
+	GetDailyProductivityReport(ctx context.Context, date types.Date) (api.ProductivityReport, error)
 }
 
+// ndjsonKey помечает в контексте запрос GET /pvz, клиент которого принимает потоковый ответ application/x-ndjson
+type ndjsonKey struct{}
+
 type Handler struct {
 	authMiddleware AuthMiddleware
 	service        Service
@@ -156,7 +220,21 @@ func (h *Handler) PostProducts(ctx context.Context, request api.PostProductsRequ
 		switch err.Error() {
 		case internalErrors.ErrPVZDoesntExist,
 			internalErrors.ErrReceptionDoesntExist,
-			internalErrors.ErrWrongReceptionStatus:
+			internalErrors.ErrWrongReceptionStatus,
+			internalErrors.ErrProductTypeDoesntExist,
+			internalErrors.ErrInvalidProductAttributes,
+			internalErrors.ErrItemWeightLimit,
+			internalErrors.ErrItemVolumeLimit,
+			internalErrors.ErrTotalWeightLimit,
+			internalErrors.ErrTotalVolumeLimit,
+			internalErrors.ErrWrongProductPhysical,
+			internalErrors.ErrWrongBarcode:
+			return api.PostProducts400JSONResponse{Message: err.Error()}, nil
+		case internalErrors.ErrProductBarcodeExist:
+			var duplicate *models.DuplicateBarcodeError
+			if errors.As(err, &duplicate) {
+				return api.PostProducts409JSONResponse(duplicate.ToModelAPIDuplicateProductError()), nil
+			}
 			return api.PostProducts400JSONResponse{Message: err.Error()}, nil
 		default:
 			return api.PostProducts500JSONResponse{Message: err.Error()}, err
@@ -166,6 +244,50 @@ func (h *Handler) PostProducts(ctx context.Context, request api.PostProductsRequ
 	return api.PostProducts201JSONResponse(product), nil
 }
 
+// Пакетное добавление товаров в текущую приемку (только для сотрудников ПВЗ)
+// (POST /products/batch)
+func (h *Handler) PostProductsBatch(ctx context.Context, request api.PostProductsBatchRequestObject) (api.PostProductsBatchResponseObject, error) {
+	authPrincipal, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.PostProductsBatch500JSONResponse{Message: err.Error()}, err
+	}
+
+	if authPrincipal.Role != string(api.Employee) {
+		err := errors.New(internalErrors.ErrForbiddenRole)
+		return api.PostProductsBatch403JSONResponse{Message: err.Error()}, nil
+	}
+
+	products, err := h.service.CreateProducts(ctx, api.PostProductsBatchJSONBody(*request.Body))
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrPVZDoesntExist,
+			internalErrors.ErrReceptionDoesntExist,
+			internalErrors.ErrWrongReceptionStatus,
+			internalErrors.ErrProductTypeDoesntExist,
+			internalErrors.ErrInvalidProductAttributes,
+			internalErrors.ErrWrongProductsBatch,
+			internalErrors.ErrWrongBarcode,
+			internalErrors.ErrItemWeightLimit,
+			internalErrors.ErrItemVolumeLimit,
+			internalErrors.ErrTotalWeightLimit,
+			internalErrors.ErrTotalVolumeLimit,
+			internalErrors.ErrWrongProductPhysical,
+			internalErrors.ErrDuplicateBarcodeInBatch:
+			return api.PostProductsBatch400JSONResponse{Message: err.Error()}, nil
+		case internalErrors.ErrProductBarcodeExist:
+			var duplicate *models.DuplicateBarcodeError
+			if errors.As(err, &duplicate) {
+				return api.PostProductsBatch409JSONResponse(duplicate.ToModelAPIDuplicateProductError()), nil
+			}
+			return api.PostProductsBatch400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.PostProductsBatch500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	return api.PostProductsBatch201JSONResponse(products), nil
+}
+
 // Удаление последнего добавленного товара из текущей приемки (LIFO, только для сотрудников ПВЗ)
 // (POST /pvz/{pvzId}/delete_last_product)
 func (h *Handler) PostPvzPvzIdDeleteLastProduct(
@@ -197,6 +319,184 @@ func (h *Handler) PostPvzPvzIdDeleteLastProduct(
 	return api.PostPvzPvzIdDeleteLastProduct200Response{}, nil
 }
 
+// Удаление товара из приемки в работе с указанием причины (только для сотрудников ПВЗ)
+// (DELETE /products/{productId})
+func (h *Handler) DeleteProductsProductId(
+	ctx context.Context,
+	request api.DeleteProductsProductIdRequestObject) (api.DeleteProductsProductIdResponseObject, error) {
+	authPrincipal, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.DeleteProductsProductId500JSONResponse{Message: err.Error()}, err
+	}
+
+	if authPrincipal.Role != string(api.Employee) {
+		err := errors.New(internalErrors.ErrForbiddenRole)
+		return api.DeleteProductsProductId403JSONResponse{Message: err.Error()}, nil
+	}
+
+	err = h.service.DeleteProduct(ctx, request.ProductId, request.Body.Reason)
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrProductDoesntExist,
+			internalErrors.ErrReceptionDoesntExist,
+			internalErrors.ErrWrongReceptionStatus,
+			internalErrors.ErrReasonRequired,
+			internalErrors.ErrProductTransition:
+			return api.DeleteProductsProductId400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.DeleteProductsProductId500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	return api.DeleteProductsProductId200Response{}, nil
+}
+
+// Исправление типа или атрибутов товара в приемке в работе (только для сотрудников ПВЗ)
+// (PATCH /products/{productId})
+func (h *Handler) PatchProductsProductId(
+	ctx context.Context,
+	request api.PatchProductsProductIdRequestObject) (api.PatchProductsProductIdResponseObject, error) {
+	authPrincipal, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.PatchProductsProductId500JSONResponse{Message: err.Error()}, err
+	}
+
+	if authPrincipal.Role != string(api.Employee) {
+		err := errors.New(internalErrors.ErrForbiddenRole)
+		return api.PatchProductsProductId403JSONResponse{Message: err.Error()}, nil
+	}
+
+	product, err := h.service.UpdateProduct(ctx, request.ProductId, api.ProductPatch(*request.Body))
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrProductDoesntExist,
+			internalErrors.ErrReceptionDoesntExist,
+			internalErrors.ErrWrongReceptionStatus,
+			internalErrors.ErrEmptyProductPatch,
+			internalErrors.ErrProductTypeDoesntExist,
+			internalErrors.ErrInvalidProductAttributes,
+			internalErrors.ErrProductTransition:
+			return api.PatchProductsProductId400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.PatchProductsProductId500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	return api.PatchProductsProductId200JSONResponse(product), nil
+}
+
+// Выдача товара получателю по коду выдачи (только для сотрудников ПВЗ)
+// (POST /products/{productId}/issue)
+func (h *Handler) PostProductsProductIdIssue(
+	ctx context.Context,
+	request api.PostProductsProductIdIssueRequestObject) (api.PostProductsProductIdIssueResponseObject, error) {
+	authPrincipal, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.PostProductsProductIdIssue500JSONResponse{Message: err.Error()}, err
+	}
+
+	if authPrincipal.Role != string(api.Employee) {
+		err := errors.New(internalErrors.ErrForbiddenRole)
+		return api.PostProductsProductIdIssue403JSONResponse{Message: err.Error()}, nil
+	}
+
+	product, err := h.service.IssueProduct(ctx, request.ProductId, request.Body.PickupCode)
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrProductDoesntExist,
+			internalErrors.ErrProductTransition,
+			internalErrors.ErrProductInOrder,
+			internalErrors.ErrWrongPickupCode,
+			internalErrors.ErrPickupAttemptsExceeded:
+			return api.PostProductsProductIdIssue400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.PostProductsProductIdIssue500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	return api.PostProductsProductIdIssue200JSONResponse(product), nil
+}
+
+// Возврат товара отправителю (для сотрудников ПВЗ и модераторов)
+// (POST /products/{productId}/return_to_sender)
+func (h *Handler) PostProductsProductIdReturnToSender(
+	ctx context.Context,
+	request api.PostProductsProductIdReturnToSenderRequestObject) (api.PostProductsProductIdReturnToSenderResponseObject, error) {
+	authPrincipal, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.PostProductsProductIdReturnToSender500JSONResponse{Message: err.Error()}, err
+	}
+
+	if authPrincipal.Role != string(api.Employee) && authPrincipal.Role != string(api.Moderator) {
+		err := errors.New(internalErrors.ErrForbiddenRole)
+		return api.PostProductsProductIdReturnToSender403JSONResponse{Message: err.Error()}, nil
+	}
+
+	product, err := h.service.ReturnProductToSender(ctx, request.ProductId, request.Body.Reason)
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrProductDoesntExist,
+			internalErrors.ErrProductTransition,
+			internalErrors.ErrReasonRequired:
+			return api.PostProductsProductIdReturnToSender400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.PostProductsProductIdReturnToSender500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	return api.PostProductsProductIdReturnToSender200JSONResponse(product), nil
+}
+
+// Выпуск кода выдачи товара для передачи получателю со сбросом попыток (только для модераторов)
+// (POST /products/{productId}/pickup_code)
+func (h *Handler) PostProductsProductIdPickupCode(
+	ctx context.Context,
+	request api.PostProductsProductIdPickupCodeRequestObject) (api.PostProductsProductIdPickupCodeResponseObject, error) {
+	authPrincipal, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.PostProductsProductIdPickupCode500JSONResponse{Message: err.Error()}, err
+	}
+
+	if authPrincipal.Role != string(api.Moderator) {
+		err := errors.New(internalErrors.ErrForbiddenRole)
+		return api.PostProductsProductIdPickupCode403JSONResponse{Message: err.Error()}, nil
+	}
+
+	code, err := h.service.IssueProductPickupCode(ctx, request.ProductId)
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrProductDoesntExist,
+			internalErrors.ErrProductTransition,
+			internalErrors.ErrProductInOrder:
+			return api.PostProductsProductIdPickupCode400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.PostProductsProductIdPickupCode500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	return api.PostProductsProductIdPickupCode200JSONResponse(code), nil
+}
+
+// Остатки ПВЗ - товары, которые еще не выданы и не возвращены (для всех ролей)
+// (GET /pvz/{pvzId}/stock)
+func (h *Handler) GetPvzPvzIdStock(ctx context.Context, request api.GetPvzPvzIdStockRequestObject) (api.GetPvzPvzIdStockResponseObject, error) {
+	if _, err := models.GetAuthPrincipal(ctx); err != nil {
+		return api.GetPvzPvzIdStock500JSONResponse{Message: err.Error()}, err
+	}
+
+	stock, err := h.service.GetPVZStock(ctx, request.PvzId, request.Params)
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrPVZDoesntExist:
+			return api.GetPvzPvzIdStock400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.GetPvzPvzIdStock500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	return api.GetPvzPvzIdStock200JSONResponse(stock), nil
+}
+
 // Закрытие последней открытой приемки товаров в рамках ПВЗ (только для сотрудников ПВЗ)
 // (POST /pvz/{pvzId}/close_last_reception)
 func (h *Handler) PostPvzPvzIdCloseLastReception(
@@ -217,7 +517,9 @@ func (h *Handler) PostPvzPvzIdCloseLastReception(
 		switch err.Error() {
 		case internalErrors.ErrPVZDoesntExist,
 			internalErrors.ErrReceptionDoesntExist,
-			internalErrors.ErrWrongReceptionStatus:
+			internalErrors.ErrWrongReceptionStatus,
+			internalErrors.ErrReceptionTransition,
+			internalErrors.ErrReceptionDiscrepancy:
 			return api.PostPvzPvzIdCloseLastReception400JSONResponse{Message: err.Error()}, nil
 		default:
 			return api.PostPvzPvzIdCloseLastReception500JSONResponse{Message: err.Error()}, err
@@ -227,99 +529,2034 @@ func (h *Handler) PostPvzPvzIdCloseLastReception(
 	return api.PostPvzPvzIdCloseLastReception200JSONResponse(reception), nil
 }
 
-// Получение списка ПВЗ с фильтрацией по дате приемки и пагинацией (для всех ролей)
-// (GET /pvz)
-func (h *Handler) GetPvz(ctx context.Context, request api.GetPvzRequestObject) (api.GetPvzResponseObject, error) {
+// Перевод черновика приемки в работу (только для сотрудников ПВЗ)
+// (POST /receptions/{receptionId}/start)
+func (h *Handler) PostReceptionsReceptionIdStart(
+	ctx context.Context,
+	request api.PostReceptionsReceptionIdStartRequestObject) (api.PostReceptionsReceptionIdStartResponseObject, error) {
 	authPrincipal, err := models.GetAuthPrincipal(ctx)
 	if err != nil {
-		return api.GetPvz500JSONResponse{Message: err.Error()}, err
+		return api.PostReceptionsReceptionIdStart500JSONResponse{Message: err.Error()}, err
 	}
 
-	if authPrincipal.Role == "" {
-		return api.GetPvz500JSONResponse{Message: internalErrors.ErrForbiddenRole},
-			errors.New(internalErrors.ErrForbiddenRole)
+	if authPrincipal.Role != string(api.Employee) {
+		err := errors.New(internalErrors.ErrForbiddenRole)
+		return api.PostReceptionsReceptionIdStart403JSONResponse{Message: err.Error()}, nil
 	}
 
-	pvzsInfo, err := h.service.GetPVZsInfo(ctx, request.Params)
+	reception, err := h.service.StartReception(ctx, request.ReceptionId)
 	if err != nil {
-		return api.GetPvz500JSONResponse{Message: err.Error()}, err
+		switch err.Error() {
+		case internalErrors.ErrReceptionDoesntExist,
+			internalErrors.ErrWrongReceptionStatus,
+			internalErrors.ErrReceptionTransition:
+			return api.PostReceptionsReceptionIdStart400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.PostReceptionsReceptionIdStart500JSONResponse{Message: err.Error()}, err
+		}
 	}
 
-	return api.GetPvz200JSONResponse(models.MapPvzInfoToAPIResponse(pvzsInfo)), nil
+	return api.PostReceptionsReceptionIdStart200JSONResponse(reception), nil
 }
 
-// RegisterStrictHandlers регистрирует все эндпоинты strict‑сервера на chi‑роутере, а также занимается парсингом URL и query параметров
-func (h *Handler) RegisterStrictHandlers(r chi.Router, sh api.ServerInterface) {
-	// POST /dummyLogin
-	r.Post("/dummyLogin", sh.PostDummyLogin)
+// Подтверждение закрытой приемки (только для модераторов)
+// (POST /receptions/{receptionId}/verify)
+func (h *Handler) PostReceptionsReceptionIdVerify(
+	ctx context.Context,
+	request api.PostReceptionsReceptionIdVerifyRequestObject) (api.PostReceptionsReceptionIdVerifyResponseObject, error) {
+	authPrincipal, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.PostReceptionsReceptionIdVerify500JSONResponse{Message: err.Error()}, err
+	}
 
-	// POST /register
-	r.Post("/register", sh.PostRegister)
+	if authPrincipal.Role != string(api.Moderator) {
+		err := errors.New(internalErrors.ErrForbiddenRole)
+		return api.PostReceptionsReceptionIdVerify403JSONResponse{Message: err.Error()}, nil
+	}
 
-	// POST /login
-	r.Post("/login", sh.PostLogin)
+	var reason string
+	if request.Body.Reason != nil {
+		reason = *request.Body.Reason
+	}
 
-	// POST /pvz
-	r.Post("/pvz", sh.PostPvz)
+	reception, err := h.service.VerifyReception(ctx, request.ReceptionId, reason)
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrReceptionDoesntExist,
+			internalErrors.ErrWrongReceptionStatus,
+			internalErrors.ErrReceptionTransition:
+			return api.PostReceptionsReceptionIdVerify400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.PostReceptionsReceptionIdVerify500JSONResponse{Message: err.Error()}, err
+		}
+	}
 
-	// POST /receptions
-	r.Post("/receptions", sh.PostReceptions)
+	return api.PostReceptionsReceptionIdVerify200JSONResponse(reception), nil
+}
 
-	// POST /products
-	r.Post("/products", sh.PostProducts)
+// Повторное открытие закрытой приемки (только для модераторов)
+// (POST /receptions/{receptionId}/reopen)
+func (h *Handler) PostReceptionsReceptionIdReopen(
+	ctx context.Context,
+	request api.PostReceptionsReceptionIdReopenRequestObject) (api.PostReceptionsReceptionIdReopenResponseObject, error) {
+	authPrincipal, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.PostReceptionsReceptionIdReopen500JSONResponse{Message: err.Error()}, err
+	}
 
-	// POST /pvz/{pvzId}/delete_last_product
-	r.Post("/pvz/{pvzId}/delete_last_product", func(w http.ResponseWriter, r *http.Request) {
-		pvzIdStr := chi.URLParam(r, "pvzId")
-		pvzId, err := uuid.Parse(pvzIdStr)
-		if err != nil {
-			http.Error(w, fmt.Sprintf("invalid pvzId: %v", err), http.StatusBadRequest)
-			return
+	if authPrincipal.Role != string(api.Moderator) {
+		err := errors.New(internalErrors.ErrForbiddenRole)
+		return api.PostReceptionsReceptionIdReopen403JSONResponse{Message: err.Error()}, nil
+	}
+
+	reception, err := h.service.ReopenReception(ctx, request.ReceptionId, request.Body.Reason)
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrReceptionDoesntExist,
+			internalErrors.ErrWrongReceptionStatus,
+			internalErrors.ErrReceptionTransition,
+			internalErrors.ErrNewerReceptionExist,
+			internalErrors.ErrReasonRequired:
+			return api.PostReceptionsReceptionIdReopen400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.PostReceptionsReceptionIdReopen500JSONResponse{Message: err.Error()}, err
 		}
+	}
 
-		sh.PostPvzPvzIdDeleteLastProduct(w, r, pvzId)
-	})
+	return api.PostReceptionsReceptionIdReopen200JSONResponse(reception), nil
+}
 
-	// POST /pvz/{pvzId}/close_last_reception
-	r.Post("/pvz/{pvzId}/close_last_reception", func(w http.ResponseWriter, r *http.Request) {
-		pvzIdStr := chi.URLParam(r, "pvzId")
-		pvzId, err := uuid.Parse(pvzIdStr)
-		if err != nil {
-			http.Error(w, fmt.Sprintf("invalid pvzId: %v", err), http.StatusBadRequest)
-			return
+// Отмена приемки с аннулированием её товаров (только для модераторов)
+// (POST /receptions/{receptionId}/cancel)
+func (h *Handler) PostReceptionsReceptionIdCancel(
+	ctx context.Context,
+	request api.PostReceptionsReceptionIdCancelRequestObject) (api.PostReceptionsReceptionIdCancelResponseObject, error) {
+	authPrincipal, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.PostReceptionsReceptionIdCancel500JSONResponse{Message: err.Error()}, err
+	}
+
+	if authPrincipal.Role != string(api.Moderator) {
+		err := errors.New(internalErrors.ErrForbiddenRole)
+		return api.PostReceptionsReceptionIdCancel403JSONResponse{Message: err.Error()}, nil
+	}
+
+	reception, err := h.service.CancelReception(ctx, request.ReceptionId, request.Body.Reason)
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrReceptionDoesntExist,
+			internalErrors.ErrWrongReceptionStatus,
+			internalErrors.ErrReceptionTransition,
+			internalErrors.ErrReasonRequired:
+			return api.PostReceptionsReceptionIdCancel400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.PostReceptionsReceptionIdCancel500JSONResponse{Message: err.Error()}, err
 		}
+	}
 
-		sh.PostPvzPvzIdCloseLastReception(w, r, pvzId)
-	})
+	return api.PostReceptionsReceptionIdCancel200JSONResponse(reception), nil
+}
 
-	// GET /pvz
-	r.Get("/pvz", func(w http.ResponseWriter, r *http.Request) {
-		var params api.GetPvzParams
+// Получение списка приемок с фильтрацией и пагинацией (для всех ролей)
+// (GET /receptions)
+func (h *Handler) GetReceptions(ctx context.Context, request api.GetReceptionsRequestObject) (api.GetReceptionsResponseObject, error) {
+	if _, err := models.GetAuthPrincipal(ctx); err != nil {
+		return api.GetReceptions500JSONResponse{Message: err.Error()}, err
+	}
 
-		err := runtime.BindQueryParameter("form", true, false, "startDate", r.URL.Query(), &params.StartDate)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-			return
-		}
+	receptions, err := h.service.GetReceptions(ctx, request.Params)
+	if err != nil {
+		return api.GetReceptions500JSONResponse{Message: err.Error()}, err
+	}
 
-		err = runtime.BindQueryParameter("form", true, false, "endDate", r.URL.Query(), &params.EndDate)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-			return
-		}
+	return api.GetReceptions200JSONResponse(receptions), nil
+}
 
-		err = runtime.BindQueryParameter("form", true, true, "page", r.URL.Query(), &params.Page)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-			return
+// Получение приемки с постраничным списком товаров (для всех ролей)
+// (GET /receptions/{receptionId})
+func (h *Handler) GetReceptionsReceptionId(
+	ctx context.Context,
+	request api.GetReceptionsReceptionIdRequestObject) (api.GetReceptionsReceptionIdResponseObject, error) {
+	if _, err := models.GetAuthPrincipal(ctx); err != nil {
+		return api.GetReceptionsReceptionId500JSONResponse{Message: err.Error()}, err
+	}
+
+	reception, err := h.service.GetReception(ctx, request.ReceptionId, request.Params)
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrReceptionDoesntExist:
+			return api.GetReceptionsReceptionId400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.GetReceptionsReceptionId500JSONResponse{Message: err.Error()}, err
 		}
+	}
 
-		err = runtime.BindQueryParameter("form", true, true, "limit", r.URL.Query(), &params.Limit)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-			return
+	return api.GetReceptionsReceptionId200JSONResponse(reception), nil
+}
+
+// История смены статусов приемки (для всех ролей)
+// (GET /receptions/{receptionId}/history)
+func (h *Handler) GetReceptionsReceptionIdHistory(
+	ctx context.Context,
+	request api.GetReceptionsReceptionIdHistoryRequestObject) (api.GetReceptionsReceptionIdHistoryResponseObject, error) {
+	if _, err := models.GetAuthPrincipal(ctx); err != nil {
+		return api.GetReceptionsReceptionIdHistory500JSONResponse{Message: err.Error()}, err
+	}
+
+	history, err := h.service.GetReceptionHistory(ctx, request.ReceptionId)
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrReceptionDoesntExist:
+			return api.GetReceptionsReceptionIdHistory400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.GetReceptionsReceptionIdHistory500JSONResponse{Message: err.Error()}, err
 		}
+	}
 
-		sh.GetPvz(w, r, params)
-	})
+	return api.GetReceptionsReceptionIdHistory200JSONResponse(history), nil
+}
+
+// Удаленные из приемки товары с автором и причиной удаления (только для модераторов)
+// (GET /receptions/{receptionId}/deleted_products)
+func (h *Handler) GetReceptionsReceptionIdDeletedProducts(
+	ctx context.Context,
+	request api.GetReceptionsReceptionIdDeletedProductsRequestObject) (api.GetReceptionsReceptionIdDeletedProductsResponseObject, error) {
+	authPrincipal, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.GetReceptionsReceptionIdDeletedProducts500JSONResponse{Message: err.Error()}, err
+	}
+
+	if authPrincipal.Role != string(api.Moderator) {
+		err := errors.New(internalErrors.ErrForbiddenRole)
+		return api.GetReceptionsReceptionIdDeletedProducts403JSONResponse{Message: err.Error()}, nil
+	}
+
+	products, err := h.service.GetDeletedProducts(ctx, request.ReceptionId)
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrReceptionDoesntExist:
+			return api.GetReceptionsReceptionIdDeletedProducts400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.GetReceptionsReceptionIdDeletedProducts500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	return api.GetReceptionsReceptionIdDeletedProducts200JSONResponse(products), nil
+}
+
+// Закрытие приемки с расхождениями по манифесту (только для модераторов)
+// (POST /receptions/{receptionId}/close_with_override)
+func (h *Handler) PostReceptionsReceptionIdCloseWithOverride(
+	ctx context.Context,
+	request api.PostReceptionsReceptionIdCloseWithOverrideRequestObject) (api.PostReceptionsReceptionIdCloseWithOverrideResponseObject, error) {
+	authPrincipal, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.PostReceptionsReceptionIdCloseWithOverride500JSONResponse{Message: err.Error()}, err
+	}
+
+	if authPrincipal.Role != string(api.Moderator) {
+		err := errors.New(internalErrors.ErrForbiddenRole)
+		return api.PostReceptionsReceptionIdCloseWithOverride403JSONResponse{Message: err.Error()}, nil
+	}
+
+	reception, err := h.service.CloseReceptionWithOverride(ctx, request.ReceptionId, request.Body.Reason)
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrReceptionDoesntExist,
+			internalErrors.ErrWrongReceptionStatus,
+			internalErrors.ErrReceptionTransition,
+			internalErrors.ErrReasonRequired:
+			return api.PostReceptionsReceptionIdCloseWithOverride400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.PostReceptionsReceptionIdCloseWithOverride500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	return api.PostReceptionsReceptionIdCloseWithOverride200JSONResponse(reception), nil
+}
+
+// Отчет о расхождениях приемки с манифестами (для всех ролей)
+// (GET /receptions/{receptionId}/discrepancy)
+func (h *Handler) GetReceptionsReceptionIdDiscrepancy(
+	ctx context.Context,
+	request api.GetReceptionsReceptionIdDiscrepancyRequestObject) (api.GetReceptionsReceptionIdDiscrepancyResponseObject, error) {
+	if _, err := models.GetAuthPrincipal(ctx); err != nil {
+		return api.GetReceptionsReceptionIdDiscrepancy500JSONResponse{Message: err.Error()}, err
+	}
+
+	report, err := h.service.GetDiscrepancyReport(ctx, request.ReceptionId)
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrReceptionDoesntExist,
+			internalErrors.ErrDiscrepancyReportDoesntExist:
+			return api.GetReceptionsReceptionIdDiscrepancy400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.GetReceptionsReceptionIdDiscrepancy500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	return api.GetReceptionsReceptionIdDiscrepancy200JSONResponse(report), nil
+}
+
+// Регистрация ожидаемой поставки в ПВЗ (только для модераторов)
+// (POST /manifests)
+func (h *Handler) PostManifests(ctx context.Context, request api.PostManifestsRequestObject) (api.PostManifestsResponseObject, error) {
+	authPrincipal, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.PostManifests500JSONResponse{Message: err.Error()}, err
+	}
+
+	if authPrincipal.Role != string(api.Moderator) {
+		err := errors.New(internalErrors.ErrForbiddenRole)
+		return api.PostManifests403JSONResponse{Message: err.Error()}, nil
+	}
+
+	manifest, err := h.service.CreateManifest(ctx, api.PostManifestsJSONBody(*request.Body))
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrPVZDoesntExist,
+			internalErrors.ErrWrongManifestItems,
+			internalErrors.ErrProductTypeDoesntExist:
+			return api.PostManifests400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.PostManifests500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	return api.PostManifests201JSONResponse(manifest), nil
+}
+
+// Получение манифеста поставки (для всех ролей)
+// (GET /manifests/{manifestId})
+func (h *Handler) GetManifestsManifestId(
+	ctx context.Context,
+	request api.GetManifestsManifestIdRequestObject) (api.GetManifestsManifestIdResponseObject, error) {
+	if _, err := models.GetAuthPrincipal(ctx); err != nil {
+		return api.GetManifestsManifestId500JSONResponse{Message: err.Error()}, err
+	}
+
+	manifest, err := h.service.GetManifest(ctx, request.ManifestId)
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrManifestDoesntExist:
+			return api.GetManifestsManifestId400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.GetManifestsManifestId500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	return api.GetManifestsManifestId200JSONResponse(manifest), nil
+}
+
+// Регистрация заказа покупателя в ПВЗ (только для модераторов)
+// (POST /orders)
+func (h *Handler) PostOrders(ctx context.Context, request api.PostOrdersRequestObject) (api.PostOrdersResponseObject, error) {
+	authPrincipal, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.PostOrders500JSONResponse{Message: err.Error()}, err
+	}
+
+	if authPrincipal.Role != string(api.Moderator) {
+		err := errors.New(internalErrors.ErrForbiddenRole)
+		return api.PostOrders403JSONResponse{Message: err.Error()}, nil
+	}
+
+	order, err := h.service.CreateOrder(ctx, api.PostOrdersJSONBody(*request.Body))
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrPVZDoesntExist,
+			internalErrors.ErrOrderExist,
+			internalErrors.ErrWrongOrderItems,
+			internalErrors.ErrWrongOrderRecipient:
+			return api.PostOrders400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.PostOrders500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	return api.PostOrders201JSONResponse(order), nil
+}
+
+// Получение заказа покупателя (для всех ролей)
+// (GET /orders/{orderId})
+func (h *Handler) GetOrdersOrderId(
+	ctx context.Context,
+	request api.GetOrdersOrderIdRequestObject) (api.GetOrdersOrderIdResponseObject, error) {
+	if _, err := models.GetAuthPrincipal(ctx); err != nil {
+		return api.GetOrdersOrderId500JSONResponse{Message: err.Error()}, err
+	}
+
+	order, err := h.service.GetOrder(ctx, request.OrderId)
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrOrderDoesntExist:
+			return api.GetOrdersOrderId400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.GetOrdersOrderId500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	return api.GetOrdersOrderId200JSONResponse(order), nil
+}
+
+// Выдача заказа по одноразовому коду (только для сотрудников ПВЗ)
+// (POST /orders/{orderId}/issue)
+func (h *Handler) PostOrdersOrderIdIssue(
+	ctx context.Context,
+	request api.PostOrdersOrderIdIssueRequestObject) (api.PostOrdersOrderIdIssueResponseObject, error) {
+	authPrincipal, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.PostOrdersOrderIdIssue500JSONResponse{Message: err.Error()}, err
+	}
+
+	if authPrincipal.Role != string(api.Employee) {
+		err := errors.New(internalErrors.ErrForbiddenRole)
+		return api.PostOrdersOrderIdIssue403JSONResponse{Message: err.Error()}, nil
+	}
+
+	order, err := h.service.IssueOrder(ctx, request.OrderId, request.Body.PickupCode)
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrOrderDoesntExist,
+			internalErrors.ErrWrongOrderStatus,
+			internalErrors.ErrWrongPickupCode,
+			internalErrors.ErrPickupAttemptsExceeded,
+			internalErrors.ErrProductTransition:
+			return api.PostOrdersOrderIdIssue400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.PostOrdersOrderIdIssue500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	return api.PostOrdersOrderIdIssue200JSONResponse(order), nil
+}
+
+// Выпуск нового кода выдачи заказа со сбросом попыток (только для модераторов)
+// (POST /orders/{orderId}/pickup_code)
+func (h *Handler) PostOrdersOrderIdPickupCode(
+	ctx context.Context,
+	request api.PostOrdersOrderIdPickupCodeRequestObject) (api.PostOrdersOrderIdPickupCodeResponseObject, error) {
+	authPrincipal, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.PostOrdersOrderIdPickupCode500JSONResponse{Message: err.Error()}, err
+	}
+
+	if authPrincipal.Role != string(api.Moderator) {
+		err := errors.New(internalErrors.ErrForbiddenRole)
+		return api.PostOrdersOrderIdPickupCode403JSONResponse{Message: err.Error()}, nil
+	}
+
+	order, err := h.service.RegenerateOrderPickupCode(ctx, request.OrderId)
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrOrderDoesntExist,
+			internalErrors.ErrWrongOrderStatus:
+			return api.PostOrdersOrderIdPickupCode400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.PostOrdersOrderIdPickupCode500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	return api.PostOrdersOrderIdPickupCode200JSONResponse(order), nil
+}
+
+// Оформление возврата или отказа от товара на стойке ПВЗ (только для сотрудников ПВЗ)
+// (POST /returns)
+func (h *Handler) PostReturns(ctx context.Context, request api.PostReturnsRequestObject) (api.PostReturnsResponseObject, error) {
+	authPrincipal, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.PostReturns500JSONResponse{Message: err.Error()}, err
+	}
+
+	if authPrincipal.Role != string(api.Employee) {
+		err := errors.New(internalErrors.ErrForbiddenRole)
+		return api.PostReturns403JSONResponse{Message: err.Error()}, nil
+	}
+
+	ret, err := h.service.CreateReturn(ctx, api.PostReturnsJSONBody(*request.Body))
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrPVZDoesntExist,
+			internalErrors.ErrNoOpenReturnShipment,
+			internalErrors.ErrProductDoesntExist,
+			internalErrors.ErrReturnWrongPvz,
+			internalErrors.ErrProductTransition,
+			internalErrors.ErrProductInOrder:
+			return api.PostReturns400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.PostReturns500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	return api.PostReturns201JSONResponse(ret), nil
+}
+
+// Открытие отправки возвратов в ПВЗ (только для сотрудников ПВЗ)
+// (POST /return_shipments)
+func (h *Handler) PostReturnShipments(
+	ctx context.Context,
+	request api.PostReturnShipmentsRequestObject) (api.PostReturnShipmentsResponseObject, error) {
+	authPrincipal, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.PostReturnShipments500JSONResponse{Message: err.Error()}, err
+	}
+
+	if authPrincipal.Role != string(api.Employee) {
+		err := errors.New(internalErrors.ErrForbiddenRole)
+		return api.PostReturnShipments403JSONResponse{Message: err.Error()}, nil
+	}
+
+	shipment, err := h.service.CreateReturnShipment(ctx, api.PostReturnShipmentsJSONBody(*request.Body))
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrPVZDoesntExist,
+			internalErrors.ErrReturnShipmentExist:
+			return api.PostReturnShipments400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.PostReturnShipments500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	return api.PostReturnShipments201JSONResponse(shipment), nil
+}
+
+// Получение отправки возвратов вместе с возвратами (для всех ролей)
+// (GET /return_shipments/{shipmentId})
+func (h *Handler) GetReturnShipmentsShipmentId(
+	ctx context.Context,
+	request api.GetReturnShipmentsShipmentIdRequestObject) (api.GetReturnShipmentsShipmentIdResponseObject, error) {
+	if _, err := models.GetAuthPrincipal(ctx); err != nil {
+		return api.GetReturnShipmentsShipmentId500JSONResponse{Message: err.Error()}, err
+	}
+
+	shipment, err := h.service.GetReturnShipment(ctx, request.ShipmentId)
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrReturnShipmentDoesntExist:
+			return api.GetReturnShipmentsShipmentId400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.GetReturnShipmentsShipmentId500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	return api.GetReturnShipmentsShipmentId200JSONResponse(shipment), nil
+}
+
+// Закрытие открытой отправки возвратов ПВЗ, товары отправки возвращаются отправителю (только для сотрудников ПВЗ)
+// (POST /pvz/{pvzId}/close_last_return_shipment)
+func (h *Handler) PostPvzPvzIdCloseLastReturnShipment(
+	ctx context.Context,
+	request api.PostPvzPvzIdCloseLastReturnShipmentRequestObject) (api.PostPvzPvzIdCloseLastReturnShipmentResponseObject, error) {
+	authPrincipal, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.PostPvzPvzIdCloseLastReturnShipment500JSONResponse{Message: err.Error()}, err
+	}
+
+	if authPrincipal.Role != string(api.Employee) {
+		err := errors.New(internalErrors.ErrForbiddenRole)
+		return api.PostPvzPvzIdCloseLastReturnShipment403JSONResponse{Message: err.Error()}, nil
+	}
+
+	shipment, err := h.service.CloseReturnShipment(ctx, request.PvzId)
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrPVZDoesntExist,
+			internalErrors.ErrNoOpenReturnShipment:
+			return api.PostPvzPvzIdCloseLastReturnShipment400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.PostPvzPvzIdCloseLastReturnShipment500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	return api.PostPvzPvzIdCloseLastReturnShipment200JSONResponse(shipment), nil
+}
+
+// Создание перемещения товаров на хранении в другой ПВЗ (только для сотрудников ПВЗ)
+// (POST /transfers)
+func (h *Handler) PostTransfers(ctx context.Context, request api.PostTransfersRequestObject) (api.PostTransfersResponseObject, error) {
+	authPrincipal, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.PostTransfers500JSONResponse{Message: err.Error()}, err
+	}
+
+	if authPrincipal.Role != string(api.Employee) {
+		err := errors.New(internalErrors.ErrForbiddenRole)
+		return api.PostTransfers403JSONResponse{Message: err.Error()}, nil
+	}
+
+	transfer, err := h.service.CreateTransfer(ctx, api.PostTransfersJSONBody(*request.Body))
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrPVZDoesntExist,
+			internalErrors.ErrWrongTransferPvz,
+			internalErrors.ErrWrongTransferProducts:
+			return api.PostTransfers400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.PostTransfers500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	return api.PostTransfers201JSONResponse(transfer), nil
+}
+
+// Перемещения в пути (только для модераторов)
+// (GET /transfers/in_transit)
+func (h *Handler) GetTransfersInTransit(
+	ctx context.Context,
+	request api.GetTransfersInTransitRequestObject) (api.GetTransfersInTransitResponseObject, error) {
+	authPrincipal, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.GetTransfersInTransit500JSONResponse{Message: err.Error()}, err
+	}
+
+	if authPrincipal.Role != string(api.Moderator) {
+		err := errors.New(internalErrors.ErrForbiddenRole)
+		return api.GetTransfersInTransit403JSONResponse{Message: err.Error()}, nil
+	}
+
+	transfers, err := h.service.GetTransfersInTransit(ctx, request.Params)
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrPVZDoesntExist:
+			return api.GetTransfersInTransit400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.GetTransfersInTransit500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	return api.GetTransfersInTransit200JSONResponse(transfers), nil
+}
+
+// Получение перемещения (для всех ролей)
+// (GET /transfers/{transferId})
+func (h *Handler) GetTransfersTransferId(
+	ctx context.Context,
+	request api.GetTransfersTransferIdRequestObject) (api.GetTransfersTransferIdResponseObject, error) {
+	if _, err := models.GetAuthPrincipal(ctx); err != nil {
+		return api.GetTransfersTransferId500JSONResponse{Message: err.Error()}, err
+	}
+
+	transfer, err := h.service.GetTransfer(ctx, request.TransferId)
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrTransferDoesntExist:
+			return api.GetTransfersTransferId400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.GetTransfersTransferId500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	return api.GetTransfersTransferId200JSONResponse(transfer), nil
+}
+
+// Отправка перемещения из ПВЗ отправления (только для сотрудников ПВЗ)
+// (POST /transfers/{transferId}/dispatch)
+func (h *Handler) PostTransfersTransferIdDispatch(
+	ctx context.Context,
+	request api.PostTransfersTransferIdDispatchRequestObject) (api.PostTransfersTransferIdDispatchResponseObject, error) {
+	authPrincipal, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.PostTransfersTransferIdDispatch500JSONResponse{Message: err.Error()}, err
+	}
+
+	if authPrincipal.Role != string(api.Employee) {
+		err := errors.New(internalErrors.ErrForbiddenRole)
+		return api.PostTransfersTransferIdDispatch403JSONResponse{Message: err.Error()}, nil
+	}
+
+	transfer, err := h.service.DispatchTransfer(ctx, request.TransferId)
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrTransferDoesntExist,
+			internalErrors.ErrWrongTransferStatus,
+			internalErrors.ErrProductTransition:
+			return api.PostTransfersTransferIdDispatch400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.PostTransfersTransferIdDispatch500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	return api.PostTransfersTransferIdDispatch200JSONResponse(transfer), nil
+}
+
+// Получение перемещения в ПВЗ назначения через приемку перемещения (только для сотрудников ПВЗ)
+// (POST /transfers/{transferId}/receive)
+func (h *Handler) PostTransfersTransferIdReceive(
+	ctx context.Context,
+	request api.PostTransfersTransferIdReceiveRequestObject) (api.PostTransfersTransferIdReceiveResponseObject, error) {
+	authPrincipal, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.PostTransfersTransferIdReceive500JSONResponse{Message: err.Error()}, err
+	}
+
+	if authPrincipal.Role != string(api.Employee) {
+		err := errors.New(internalErrors.ErrForbiddenRole)
+		return api.PostTransfersTransferIdReceive403JSONResponse{Message: err.Error()}, nil
+	}
+
+	transfer, err := h.service.ReceiveTransfer(ctx, request.TransferId)
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrTransferDoesntExist,
+			internalErrors.ErrWrongTransferStatus,
+			internalErrors.ErrProductTransition:
+			return api.PostTransfersTransferIdReceive400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.PostTransfersTransferIdReceive500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	return api.PostTransfersTransferIdReceive200JSONResponse(transfer), nil
+}
+
+// Создание ячейки хранения в ПВЗ (только для модераторов)
+// (POST /pvz/{pvzId}/cells)
+func (h *Handler) PostPvzPvzIdCells(ctx context.Context, request api.PostPvzPvzIdCellsRequestObject) (api.PostPvzPvzIdCellsResponseObject, error) {
+	authPrincipal, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.PostPvzPvzIdCells500JSONResponse{Message: err.Error()}, err
+	}
+
+	if authPrincipal.Role != string(api.Moderator) {
+		err := errors.New(internalErrors.ErrForbiddenRole)
+		return api.PostPvzPvzIdCells403JSONResponse{Message: err.Error()}, nil
+	}
+
+	cell, err := h.service.CreateStorageCell(ctx, request.PvzId, api.PostPvzPvzIdCellsJSONBody(*request.Body))
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrPVZDoesntExist,
+			internalErrors.ErrStorageCellExist:
+			return api.PostPvzPvzIdCells400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.PostPvzPvzIdCells500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	return api.PostPvzPvzIdCells201JSONResponse(cell), nil
+}
+
+// Ячейки хранения ПВЗ с заполненностью (для всех ролей)
+// (GET /pvz/{pvzId}/cells)
+func (h *Handler) GetPvzPvzIdCells(ctx context.Context, request api.GetPvzPvzIdCellsRequestObject) (api.GetPvzPvzIdCellsResponseObject, error) {
+	if _, err := models.GetAuthPrincipal(ctx); err != nil {
+		return api.GetPvzPvzIdCells500JSONResponse{Message: err.Error()}, err
+	}
+
+	cells, err := h.service.GetStorageCells(ctx, request.PvzId)
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrPVZDoesntExist:
+			return api.GetPvzPvzIdCells400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.GetPvzPvzIdCells500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	return api.GetPvzPvzIdCells200JSONResponse(cells), nil
+}
+
+// Перемещение товара в другую ячейку хранения ПВЗ (только для сотрудников ПВЗ)
+// (POST /products/{productId}/move)
+func (h *Handler) PostProductsProductIdMove(
+	ctx context.Context,
+	request api.PostProductsProductIdMoveRequestObject) (api.PostProductsProductIdMoveResponseObject, error) {
+	authPrincipal, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.PostProductsProductIdMove500JSONResponse{Message: err.Error()}, err
+	}
+
+	if authPrincipal.Role != string(api.Employee) {
+		err := errors.New(internalErrors.ErrForbiddenRole)
+		return api.PostProductsProductIdMove403JSONResponse{Message: err.Error()}, nil
+	}
+
+	product, err := h.service.MoveProduct(ctx, request.ProductId, request.Body.CellId)
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrProductDoesntExist,
+			internalErrors.ErrProductNotInStock,
+			internalErrors.ErrStorageCellDoesntExist,
+			internalErrors.ErrStorageCellFull:
+			return api.PostProductsProductIdMove400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.PostProductsProductIdMove500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	return api.PostProductsProductIdMove200JSONResponse(product), nil
+}
+
+// Местонахождение товара (для всех ролей)
+// (GET /products/{productId}/location)
+func (h *Handler) GetProductsProductIdLocation(
+	ctx context.Context,
+	request api.GetProductsProductIdLocationRequestObject) (api.GetProductsProductIdLocationResponseObject, error) {
+	if _, err := models.GetAuthPrincipal(ctx); err != nil {
+		return api.GetProductsProductIdLocation500JSONResponse{Message: err.Error()}, err
+	}
+
+	location, err := h.service.GetProductLocation(ctx, request.ProductId)
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrProductDoesntExist:
+			return api.GetProductsProductIdLocation400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.GetProductsProductIdLocation500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	return api.GetProductsProductIdLocation200JSONResponse(location), nil
+}
+
+// Установка ограничений ПВЗ по весу и объему товаров (только для модераторов)
+// (PUT /pvz/{pvzId}/limits)
+func (h *Handler) PutPvzPvzIdLimits(ctx context.Context, request api.PutPvzPvzIdLimitsRequestObject) (api.PutPvzPvzIdLimitsResponseObject, error) {
+	authPrincipal, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.PutPvzPvzIdLimits500JSONResponse{Message: err.Error()}, err
+	}
+
+	if authPrincipal.Role != string(api.Moderator) {
+		err := errors.New(internalErrors.ErrForbiddenRole)
+		return api.PutPvzPvzIdLimits403JSONResponse{Message: err.Error()}, nil
+	}
+
+	limits, err := h.service.SetPvzLimits(ctx, request.PvzId, api.PvzLimits(*request.Body))
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrPVZDoesntExist:
+			return api.PutPvzPvzIdLimits400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.PutPvzPvzIdLimits500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	return api.PutPvzPvzIdLimits200JSONResponse(limits), nil
+}
+
+// Занятые вес и объем ПВЗ и его ячеек хранения (для всех ролей)
+// (GET /pvz/{pvzId}/capacity)
+func (h *Handler) GetPvzPvzIdCapacity(ctx context.Context, request api.GetPvzPvzIdCapacityRequestObject) (api.GetPvzPvzIdCapacityResponseObject, error) {
+	if _, err := models.GetAuthPrincipal(ctx); err != nil {
+		return api.GetPvzPvzIdCapacity500JSONResponse{Message: err.Error()}, err
+	}
+
+	capacity, err := h.service.GetPvzCapacity(ctx, request.PvzId)
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrPVZDoesntExist:
+			return api.GetPvzPvzIdCapacity400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.GetPvzPvzIdCapacity500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	return api.GetPvzPvzIdCapacity200JSONResponse(capacity), nil
+}
+
+// Загрузка вложения приемки (для всех ролей)
+// (POST /receptions/{receptionId}/attachments)
+func (h *Handler) PostReceptionsReceptionIdAttachments(
+	ctx context.Context,
+	request api.PostReceptionsReceptionIdAttachmentsRequestObject) (api.PostReceptionsReceptionIdAttachmentsResponseObject, error) {
+	if _, err := models.GetAuthPrincipal(ctx); err != nil {
+		return api.PostReceptionsReceptionIdAttachments500JSONResponse{Message: err.Error()}, err
+	}
+
+	attachment, err := uploadAttachment(request.Body, func(fileName string, r io.Reader) (api.Attachment, error) {
+		return h.service.CreateReceptionAttachment(ctx, request.ReceptionId, fileName, r)
+	})
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrReceptionDoesntExist,
+			internalErrors.ErrAttachmentRequired,
+			internalErrors.ErrAttachmentTooLarge,
+			internalErrors.ErrWrongAttachmentType:
+			return api.PostReceptionsReceptionIdAttachments400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.PostReceptionsReceptionIdAttachments500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	return api.PostReceptionsReceptionIdAttachments201JSONResponse(attachment), nil
+}
+
+// Вложения приемки (для всех ролей)
+// (GET /receptions/{receptionId}/attachments)
+func (h *Handler) GetReceptionsReceptionIdAttachments(
+	ctx context.Context,
+	request api.GetReceptionsReceptionIdAttachmentsRequestObject) (api.GetReceptionsReceptionIdAttachmentsResponseObject, error) {
+	if _, err := models.GetAuthPrincipal(ctx); err != nil {
+		return api.GetReceptionsReceptionIdAttachments500JSONResponse{Message: err.Error()}, err
+	}
+
+	attachments, err := h.service.GetReceptionAttachments(ctx, request.ReceptionId)
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrReceptionDoesntExist:
+			return api.GetReceptionsReceptionIdAttachments400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.GetReceptionsReceptionIdAttachments500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	return api.GetReceptionsReceptionIdAttachments200JSONResponse(attachments), nil
+}
+
+// Загрузка вложения товара (для всех ролей)
+// (POST /products/{productId}/attachments)
+func (h *Handler) PostProductsProductIdAttachments(
+	ctx context.Context,
+	request api.PostProductsProductIdAttachmentsRequestObject) (api.PostProductsProductIdAttachmentsResponseObject, error) {
+	if _, err := models.GetAuthPrincipal(ctx); err != nil {
+		return api.PostProductsProductIdAttachments500JSONResponse{Message: err.Error()}, err
+	}
+
+	attachment, err := uploadAttachment(request.Body, func(fileName string, r io.Reader) (api.Attachment, error) {
+		return h.service.CreateProductAttachment(ctx, request.ProductId, fileName, r)
+	})
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrProductDoesntExist,
+			internalErrors.ErrAttachmentRequired,
+			internalErrors.ErrAttachmentTooLarge,
+			internalErrors.ErrWrongAttachmentType:
+			return api.PostProductsProductIdAttachments400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.PostProductsProductIdAttachments500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	return api.PostProductsProductIdAttachments201JSONResponse(attachment), nil
+}
+
+// Вложения товара (для всех ролей)
+// (GET /products/{productId}/attachments)
+func (h *Handler) GetProductsProductIdAttachments(
+	ctx context.Context,
+	request api.GetProductsProductIdAttachmentsRequestObject) (api.GetProductsProductIdAttachmentsResponseObject, error) {
+	if _, err := models.GetAuthPrincipal(ctx); err != nil {
+		return api.GetProductsProductIdAttachments500JSONResponse{Message: err.Error()}, err
+	}
+
+	attachments, err := h.service.GetProductAttachments(ctx, request.ProductId)
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrProductDoesntExist:
+			return api.GetProductsProductIdAttachments400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.GetProductsProductIdAttachments500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	return api.GetProductsProductIdAttachments200JSONResponse(attachments), nil
+}
+
+// Скачивание вложения (для всех ролей)
+// (GET /attachments/{attachmentId})
+func (h *Handler) GetAttachmentsAttachmentId(
+	ctx context.Context,
+	request api.GetAttachmentsAttachmentIdRequestObject) (api.GetAttachmentsAttachmentIdResponseObject, error) {
+	if _, err := models.GetAuthPrincipal(ctx); err != nil {
+		return api.GetAttachmentsAttachmentId500JSONResponse{Message: err.Error()}, err
+	}
+
+	attachment, content, err := h.service.GetAttachmentContent(ctx, request.AttachmentId)
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrAttachmentDoesntExist:
+			return api.GetAttachmentsAttachmentId400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.GetAttachmentsAttachmentId500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	return api.GetAttachmentsAttachmentId200AsteriskResponse{
+		Body:          content,
+		ContentType:   string(attachment.ContentType),
+		ContentLength: int64(attachment.Size),
+		Headers: api.GetAttachmentsAttachmentId200ResponseHeaders{
+			ContentDisposition: mime.FormatMediaType("attachment", map[string]string{"filename": attachment.FileName}),
+		},
+	}, nil
+}
+
+// Статистика приемок по ПВЗ, городам, типам товаров и периодам (только для модераторов)
+// (GET /stats/receptions)
+func (h *Handler) GetStatsReceptions(
+	ctx context.Context,
+	request api.GetStatsReceptionsRequestObject) (api.GetStatsReceptionsResponseObject, error) {
+	authPrincipal, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.GetStatsReceptions500JSONResponse{Message: err.Error()}, err
+	}
+
+	if authPrincipal.Role != string(api.Moderator) {
+		err := errors.New(internalErrors.ErrForbiddenRole)
+		return api.GetStatsReceptions403JSONResponse{Message: err.Error()}, nil
+	}
+
+	stats, err := h.service.GetReceptionStats(ctx, request.Params)
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrWrongDateRange:
+			return api.GetStatsReceptions400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.GetStatsReceptions500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	return api.GetStatsReceptions200JSONResponse(stats), nil
+}
+
+// Отчет о скорости приемок и нарушениях SLA по сотрудникам и ПВЗ (только для модераторов)
+// (GET /reports/productivity)
+func (h *Handler) GetReportsProductivity(
+	ctx context.Context,
+	request api.GetReportsProductivityRequestObject) (api.GetReportsProductivityResponseObject, error) {
+	authPrincipal, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.GetReportsProductivity500JSONResponse{Message: err.Error()}, err
+	}
+
+	if authPrincipal.Role != string(api.Moderator) {
+		err := errors.New(internalErrors.ErrForbiddenRole)
+		return api.GetReportsProductivity403JSONResponse{Message: err.Error()}, nil
+	}
+
+	report, err := h.service.GetProductivityReport(ctx, request.Params)
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrWrongDateRange:
+			return api.GetReportsProductivity400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.GetReportsProductivity500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	return api.GetReportsProductivity200JSONResponse(report), nil
+}
+
+// Сохраненный ежедневный отчет о скорости приемок (только для модераторов)
+// (GET /reports/productivity/daily/{date})
+func (h *Handler) GetReportsProductivityDailyDate(
+	ctx context.Context,
+	request api.GetReportsProductivityDailyDateRequestObject) (api.GetReportsProductivityDailyDateResponseObject, error) {
+	authPrincipal, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.GetReportsProductivityDailyDate500JSONResponse{Message: err.Error()}, err
+	}
+
+	if authPrincipal.Role != string(api.Moderator) {
+		err := errors.New(internalErrors.ErrForbiddenRole)
+		return api.GetReportsProductivityDailyDate403JSONResponse{Message: err.Error()}, nil
+	}
+
+	report, err := h.service.GetDailyProductivityReport(ctx, request.Date)
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrProductivityReportDoesntExist:
+			return api.GetReportsProductivityDailyDate404JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.GetReportsProductivityDailyDate500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	return api.GetReportsProductivityDailyDate200JSONResponse(report), nil
+}
+
+// Выгрузка ПВЗ с приемками и товарами в CSV или XLSX (для всех ролей)
+// (GET /export/pvz)
+func (h *Handler) GetExportPvz(ctx context.Context, request api.GetExportPvzRequestObject) (api.GetExportPvzResponseObject, error) {
+	if _, err := models.GetAuthPrincipal(ctx); err != nil {
+		return api.GetExportPvz500JSONResponse{Message: err.Error()}, err
+	}
+
+	write, err := h.service.ExportPVZs(ctx, request.Params)
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrWrongDateRange:
+			return api.GetExportPvz400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.GetExportPvz500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	format := exportFormat(request.Params.Format)
+	body := streamExport(format, write)
+	headers := api.GetExportPvz200ResponseHeaders{ContentDisposition: export.FileName("pvz", format)}
+	if format == export.FormatXLSX {
+		return api.GetExportPvz200ApplicationvndOpenxmlformatsOfficedocumentSpreadsheetmlSheetResponse{Body: body, Headers: headers}, nil
+	}
+
+	return api.GetExportPvz200TextcsvResponse{Body: body, Headers: headers}, nil
+}
+
+// Выгрузка списка приемок в CSV или XLSX (для всех ролей)
+// (GET /export/receptions)
+func (h *Handler) GetExportReceptions(
+	ctx context.Context,
+	request api.GetExportReceptionsRequestObject) (api.GetExportReceptionsResponseObject, error) {
+	if _, err := models.GetAuthPrincipal(ctx); err != nil {
+		return api.GetExportReceptions500JSONResponse{Message: err.Error()}, err
+	}
+
+	write, err := h.service.ExportReceptions(ctx, request.Params)
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrWrongDateRange:
+			return api.GetExportReceptions400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.GetExportReceptions500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	format := exportFormat(request.Params.Format)
+	body := streamExport(format, write)
+	headers := api.GetExportReceptions200ResponseHeaders{ContentDisposition: export.FileName("receptions", format)}
+	if format == export.FormatXLSX {
+		return api.GetExportReceptions200ApplicationvndOpenxmlformatsOfficedocumentSpreadsheetmlSheetResponse{Body: body, Headers: headers}, nil
+	}
+
+	return api.GetExportReceptions200TextcsvResponse{Body: body, Headers: headers}, nil
+}
+
+// exportFormat формат выгрузки из параметра format, который при регистрации маршрута дополняется заголовком Accept
+func exportFormat(format *api.ExportFormat) string {
+	if format == nil {
+		return export.FormatCSV
+	}
+
+	return string(*format)
+}
+
+// streamExport пишет строки выгрузки в формате format в поток для тела ответа
+func streamExport(format string, write func(w models.RowWriter) error) io.ReadCloser {
+	return streamBody(func(w io.Writer) error {
+		ew, err := export.NewWriter(format, w)
+		if err != nil {
+			return err
+		}
+		if err := write(ew); err != nil {
+			return err
+		}
+
+		return ew.Close()
+	})
+}
+
+// streamBody запускает write в отдельной горутине и отдает записанное потоком для тела ответа.
+// Strict-сервер закрывает поток после отправки ответа, поэтому если клиент отключился, write прерывается ошибкой записи
+func streamBody(write func(w io.Writer) error) io.ReadCloser {
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(write(pw))
+	}()
+
+	return pr
+}
+
+// uploadAttachment находит в multipart-теле часть file и передает её содержимое в save, не читая файл целиком
+func uploadAttachment(body *multipart.Reader, save func(fileName string, r io.Reader) (api.Attachment, error)) (api.Attachment, error) {
+	for {
+		// io.EOF означает, что части file в теле нет
+		part, err := body.NextPart()
+		if err != nil {
+			return api.Attachment{}, errors.New(internalErrors.ErrAttachmentRequired)
+		}
+
+		if part.FormName() == "file" {
+			defer part.Close()
+			return save(part.FileName(), part)
+		}
+		part.Close()
+	}
+}
+
+// Получение справочника типов товаров (для всех ролей)
+// (GET /product_types)
+func (h *Handler) GetProductTypes(ctx context.Context, request api.GetProductTypesRequestObject) (api.GetProductTypesResponseObject, error) {
+	if _, err := models.GetAuthPrincipal(ctx); err != nil {
+		return api.GetProductTypes500JSONResponse{Message: err.Error()}, err
+	}
+
+	productTypes, err := h.service.GetProductTypes(ctx)
+	if err != nil {
+		return api.GetProductTypes500JSONResponse{Message: err.Error()}, err
+	}
+
+	return api.GetProductTypes200JSONResponse(productTypes), nil
+}
+
+// Добавление типа товара в справочник (только для модераторов)
+// (POST /product_types)
+func (h *Handler) PostProductTypes(ctx context.Context, request api.PostProductTypesRequestObject) (api.PostProductTypesResponseObject, error) {
+	authPrincipal, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.PostProductTypes500JSONResponse{Message: err.Error()}, err
+	}
+
+	if authPrincipal.Role != string(api.Moderator) {
+		err := errors.New(internalErrors.ErrForbiddenRole)
+		return api.PostProductTypes403JSONResponse{Message: err.Error()}, nil
+	}
+
+	productType, err := h.service.CreateProductType(ctx, api.ProductType(*request.Body))
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrInvalidAttributesSchema,
+			internalErrors.ErrProductTypeExist:
+			return api.PostProductTypes400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.PostProductTypes500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	return api.PostProductTypes201JSONResponse(productType), nil
+}
+
+// Изменение схемы атрибутов типа товара (только для модераторов)
+// (PUT /product_types/{typeName})
+func (h *Handler) PutProductTypesTypeName(
+	ctx context.Context,
+	request api.PutProductTypesTypeNameRequestObject) (api.PutProductTypesTypeNameResponseObject, error) {
+	authPrincipal, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.PutProductTypesTypeName500JSONResponse{Message: err.Error()}, err
+	}
+
+	if authPrincipal.Role != string(api.Moderator) {
+		err := errors.New(internalErrors.ErrForbiddenRole)
+		return api.PutProductTypesTypeName403JSONResponse{Message: err.Error()}, nil
+	}
+
+	productType, err := h.service.UpdateProductTypeSchema(ctx, request.TypeName, api.PutProductTypesTypeNameJSONBody(*request.Body))
+	if err != nil {
+		switch err.Error() {
+		case internalErrors.ErrInvalidAttributesSchema,
+			internalErrors.ErrProductTypeDoesntExist:
+			return api.PutProductTypesTypeName400JSONResponse{Message: err.Error()}, nil
+		default:
+			return api.PutProductTypesTypeName500JSONResponse{Message: err.Error()}, err
+		}
+	}
+
+	return api.PutProductTypesTypeName200JSONResponse(productType), nil
+}
+
+// Получение списка ПВЗ с фильтрацией по дате приемки и пагинацией (для всех ролей)
+// (GET /pvz)
+func (h *Handler) GetPvz(ctx context.Context, request api.GetPvzRequestObject) (api.GetPvzResponseObject, error) {
+	authPrincipal, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.GetPvz500JSONResponse{Message: err.Error()}, err
+	}
+
+	if authPrincipal.Role == "" {
+		return api.GetPvz500JSONResponse{Message: internalErrors.ErrForbiddenRole},
+			errors.New(internalErrors.ErrForbiddenRole)
+	}
+
+	if isNDJSON, _ := ctx.Value(ndjsonKey{}).(bool); isNDJSON {
+		stream, err := h.service.StreamPVZsInfo(ctx, request.Params)
+		if err != nil {
+			return api.GetPvz500JSONResponse{Message: err.Error()}, err
+		}
+
+		projection := models.NewPvzInfoProjection(request.Params.Include, request.Params.Fields)
+		body := streamBody(func(w io.Writer) error {
+			encoder := json.NewEncoder(w)
+			return stream(func(info models.PvzInfo) error {
+				// Encode завершает каждый объект переводом строки, что и дает формат NDJSON
+				return encoder.Encode(models.MapPvzInfoToAPIResponse([]models.PvzInfo{info}, projection)[0])
+			})
+		})
+
+		return api.GetPvz200ApplicationxNdjsonResponse{Body: body}, nil
+	}
+
+	pvzsInfo, err := h.service.GetPVZsInfo(ctx, request.Params)
+	if err != nil {
+		return api.GetPvz500JSONResponse{Message: err.Error()}, err
+	}
+
+	projection := models.NewPvzInfoProjection(request.Params.Include, request.Params.Fields)
+	return api.GetPvz200JSONResponse(models.MapPvzInfoToAPIResponse(pvzsInfo, projection)), nil
+}
+
+// RegisterStrictHandlers регистрирует все эндпоинты strict‑сервера на chi‑роутере, а также занимается парсингом URL и query параметров
+func (h *Handler) RegisterStrictHandlers(r chi.Router, sh api.ServerInterface) {
+	// POST /dummyLogin
+	r.Post("/dummyLogin", sh.PostDummyLogin)
+
+	// POST /register
+	r.Post("/register", sh.PostRegister)
+
+	// POST /login
+	r.Post("/login", sh.PostLogin)
+
+	// POST /pvz
+	r.Post("/pvz", sh.PostPvz)
+
+	// POST /receptions
+	r.Post("/receptions", sh.PostReceptions)
+
+	// POST /products
+	r.Post("/products", sh.PostProducts)
+
+	// POST /products/batch
+	r.Post("/products/batch", sh.PostProductsBatch)
+
+	// DELETE /products/{productId}
+	r.Delete("/products/{productId}", func(w http.ResponseWriter, r *http.Request) {
+		productId, err := uuid.Parse(chi.URLParam(r, "productId"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid productId: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		sh.DeleteProductsProductId(w, r, productId)
+	})
+
+	// PATCH /products/{productId}
+	r.Patch("/products/{productId}", func(w http.ResponseWriter, r *http.Request) {
+		productId, err := uuid.Parse(chi.URLParam(r, "productId"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid productId: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		sh.PatchProductsProductId(w, r, productId)
+	})
+
+	// POST /products/{productId}/issue
+	r.Post("/products/{productId}/issue", func(w http.ResponseWriter, r *http.Request) {
+		productId, err := uuid.Parse(chi.URLParam(r, "productId"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid productId: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		sh.PostProductsProductIdIssue(w, r, productId)
+	})
+
+	// POST /products/{productId}/return_to_sender
+	r.Post("/products/{productId}/return_to_sender", func(w http.ResponseWriter, r *http.Request) {
+		productId, err := uuid.Parse(chi.URLParam(r, "productId"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid productId: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		sh.PostProductsProductIdReturnToSender(w, r, productId)
+	})
+
+	// POST /products/{productId}/pickup_code
+	r.Post("/products/{productId}/pickup_code", func(w http.ResponseWriter, r *http.Request) {
+		productId, err := uuid.Parse(chi.URLParam(r, "productId"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid productId: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		sh.PostProductsProductIdPickupCode(w, r, productId)
+	})
+
+	// GET /pvz/{pvzId}/stock
+	r.Get("/pvz/{pvzId}/stock", func(w http.ResponseWriter, r *http.Request) {
+		pvzId, err := uuid.Parse(chi.URLParam(r, "pvzId"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid pvzId: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		var params api.GetPvzPvzIdStockParams
+
+		err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		sh.GetPvzPvzIdStock(w, r, pvzId, params)
+	})
+
+	// POST /pvz/{pvzId}/delete_last_product
+	r.Post("/pvz/{pvzId}/delete_last_product", func(w http.ResponseWriter, r *http.Request) {
+		pvzIdStr := chi.URLParam(r, "pvzId")
+		pvzId, err := uuid.Parse(pvzIdStr)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid pvzId: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		sh.PostPvzPvzIdDeleteLastProduct(w, r, pvzId)
+	})
+
+	// POST /pvz/{pvzId}/close_last_reception
+	r.Post("/pvz/{pvzId}/close_last_reception", func(w http.ResponseWriter, r *http.Request) {
+		pvzIdStr := chi.URLParam(r, "pvzId")
+		pvzId, err := uuid.Parse(pvzIdStr)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid pvzId: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		sh.PostPvzPvzIdCloseLastReception(w, r, pvzId)
+	})
+
+	// POST /pvz/{pvzId}/close_last_return_shipment
+	r.Post("/pvz/{pvzId}/close_last_return_shipment", func(w http.ResponseWriter, r *http.Request) {
+		pvzId, err := uuid.Parse(chi.URLParam(r, "pvzId"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid pvzId: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		sh.PostPvzPvzIdCloseLastReturnShipment(w, r, pvzId)
+	})
+
+	// GET /receptions
+	r.Get("/receptions", func(w http.ResponseWriter, r *http.Request) {
+		var params api.GetReceptionsParams
+
+		err := runtime.BindQueryParameter("form", true, false, "pvzId", r.URL.Query(), &params.PvzId)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindQueryParameter("form", true, false, "createdBy", r.URL.Query(), &params.CreatedBy)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindQueryParameter("form", true, false, "startDate", r.URL.Query(), &params.StartDate)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindQueryParameter("form", true, false, "endDate", r.URL.Query(), &params.EndDate)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		sh.GetReceptions(w, r, params)
+	})
+
+	// GET /receptions/{receptionId}
+	r.Get("/receptions/{receptionId}", func(w http.ResponseWriter, r *http.Request) {
+		receptionId, err := uuid.Parse(chi.URLParam(r, "receptionId"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid receptionId: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		var params api.GetReceptionsReceptionIdParams
+
+		err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		sh.GetReceptionsReceptionId(w, r, receptionId, params)
+	})
+
+	// POST /receptions/{receptionId}/start
+	r.Post("/receptions/{receptionId}/start", func(w http.ResponseWriter, r *http.Request) {
+		receptionId, err := uuid.Parse(chi.URLParam(r, "receptionId"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid receptionId: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		sh.PostReceptionsReceptionIdStart(w, r, receptionId)
+	})
+
+	// POST /receptions/{receptionId}/verify
+	r.Post("/receptions/{receptionId}/verify", func(w http.ResponseWriter, r *http.Request) {
+		receptionId, err := uuid.Parse(chi.URLParam(r, "receptionId"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid receptionId: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		sh.PostReceptionsReceptionIdVerify(w, r, receptionId)
+	})
+
+	// POST /receptions/{receptionId}/reopen
+	r.Post("/receptions/{receptionId}/reopen", func(w http.ResponseWriter, r *http.Request) {
+		receptionId, err := uuid.Parse(chi.URLParam(r, "receptionId"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid receptionId: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		sh.PostReceptionsReceptionIdReopen(w, r, receptionId)
+	})
+
+	// POST /receptions/{receptionId}/cancel
+	r.Post("/receptions/{receptionId}/cancel", func(w http.ResponseWriter, r *http.Request) {
+		receptionId, err := uuid.Parse(chi.URLParam(r, "receptionId"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid receptionId: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		sh.PostReceptionsReceptionIdCancel(w, r, receptionId)
+	})
+
+	// GET /receptions/{receptionId}/history
+	r.Get("/receptions/{receptionId}/history", func(w http.ResponseWriter, r *http.Request) {
+		receptionId, err := uuid.Parse(chi.URLParam(r, "receptionId"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid receptionId: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		sh.GetReceptionsReceptionIdHistory(w, r, receptionId)
+	})
+
+	// GET /receptions/{receptionId}/deleted_products
+	r.Get("/receptions/{receptionId}/deleted_products", func(w http.ResponseWriter, r *http.Request) {
+		receptionId, err := uuid.Parse(chi.URLParam(r, "receptionId"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid receptionId: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		sh.GetReceptionsReceptionIdDeletedProducts(w, r, receptionId)
+	})
+
+	// POST /receptions/{receptionId}/close_with_override
+	r.Post("/receptions/{receptionId}/close_with_override", func(w http.ResponseWriter, r *http.Request) {
+		receptionId, err := uuid.Parse(chi.URLParam(r, "receptionId"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid receptionId: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		sh.PostReceptionsReceptionIdCloseWithOverride(w, r, receptionId)
+	})
+
+	// GET /receptions/{receptionId}/discrepancy
+	r.Get("/receptions/{receptionId}/discrepancy", func(w http.ResponseWriter, r *http.Request) {
+		receptionId, err := uuid.Parse(chi.URLParam(r, "receptionId"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid receptionId: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		sh.GetReceptionsReceptionIdDiscrepancy(w, r, receptionId)
+	})
+
+	// GET /product_types
+	r.Get("/product_types", sh.GetProductTypes)
+
+	// POST /product_types
+	r.Post("/product_types", sh.PostProductTypes)
+
+	// PUT /product_types/{typeName}
+	r.Put("/product_types/{typeName}", func(w http.ResponseWriter, r *http.Request) {
+		typeName, err := url.PathUnescape(chi.URLParam(r, "typeName"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid typeName: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		sh.PutProductTypesTypeName(w, r, typeName)
+	})
+
+	// POST /manifests
+	r.Post("/manifests", sh.PostManifests)
+
+	// GET /manifests/{manifestId}
+	r.Get("/manifests/{manifestId}", func(w http.ResponseWriter, r *http.Request) {
+		manifestId, err := uuid.Parse(chi.URLParam(r, "manifestId"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid manifestId: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		sh.GetManifestsManifestId(w, r, manifestId)
+	})
+
+	// POST /orders
+	r.Post("/orders", sh.PostOrders)
+
+	// GET /orders/{orderId}
+	r.Get("/orders/{orderId}", func(w http.ResponseWriter, r *http.Request) {
+		orderId, err := uuid.Parse(chi.URLParam(r, "orderId"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid orderId: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		sh.GetOrdersOrderId(w, r, orderId)
+	})
+
+	// POST /orders/{orderId}/issue
+	r.Post("/orders/{orderId}/issue", func(w http.ResponseWriter, r *http.Request) {
+		orderId, err := uuid.Parse(chi.URLParam(r, "orderId"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid orderId: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		sh.PostOrdersOrderIdIssue(w, r, orderId)
+	})
+
+	// POST /orders/{orderId}/pickup_code
+	r.Post("/orders/{orderId}/pickup_code", func(w http.ResponseWriter, r *http.Request) {
+		orderId, err := uuid.Parse(chi.URLParam(r, "orderId"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid orderId: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		sh.PostOrdersOrderIdPickupCode(w, r, orderId)
+	})
+
+	// POST /returns
+	r.Post("/returns", sh.PostReturns)
+
+	// POST /return_shipments
+	r.Post("/return_shipments", sh.PostReturnShipments)
+
+	// GET /return_shipments/{shipmentId}
+	r.Get("/return_shipments/{shipmentId}", func(w http.ResponseWriter, r *http.Request) {
+		shipmentId, err := uuid.Parse(chi.URLParam(r, "shipmentId"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid shipmentId: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		sh.GetReturnShipmentsShipmentId(w, r, shipmentId)
+	})
+
+	// POST /transfers
+	r.Post("/transfers", sh.PostTransfers)
+
+	// GET /transfers/in_transit
+	r.Get("/transfers/in_transit", func(w http.ResponseWriter, r *http.Request) {
+		var params api.GetTransfersInTransitParams
+
+		err := runtime.BindQueryParameter("form", true, false, "pvzId", r.URL.Query(), &params.PvzId)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		sh.GetTransfersInTransit(w, r, params)
+	})
+
+	// GET /transfers/{transferId}
+	r.Get("/transfers/{transferId}", func(w http.ResponseWriter, r *http.Request) {
+		transferId, err := uuid.Parse(chi.URLParam(r, "transferId"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid transferId: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		sh.GetTransfersTransferId(w, r, transferId)
+	})
+
+	// POST /transfers/{transferId}/dispatch
+	r.Post("/transfers/{transferId}/dispatch", func(w http.ResponseWriter, r *http.Request) {
+		transferId, err := uuid.Parse(chi.URLParam(r, "transferId"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid transferId: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		sh.PostTransfersTransferIdDispatch(w, r, transferId)
+	})
+
+	// POST /transfers/{transferId}/receive
+	r.Post("/transfers/{transferId}/receive", func(w http.ResponseWriter, r *http.Request) {
+		transferId, err := uuid.Parse(chi.URLParam(r, "transferId"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid transferId: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		sh.PostTransfersTransferIdReceive(w, r, transferId)
+	})
+
+	// POST /pvz/{pvzId}/cells
+	r.Post("/pvz/{pvzId}/cells", func(w http.ResponseWriter, r *http.Request) {
+		pvzId, err := uuid.Parse(chi.URLParam(r, "pvzId"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid pvzId: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		sh.PostPvzPvzIdCells(w, r, pvzId)
+	})
+
+	// GET /pvz/{pvzId}/cells
+	r.Get("/pvz/{pvzId}/cells", func(w http.ResponseWriter, r *http.Request) {
+		pvzId, err := uuid.Parse(chi.URLParam(r, "pvzId"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid pvzId: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		sh.GetPvzPvzIdCells(w, r, pvzId)
+	})
+
+	// POST /products/{productId}/move
+	r.Post("/products/{productId}/move", func(w http.ResponseWriter, r *http.Request) {
+		productId, err := uuid.Parse(chi.URLParam(r, "productId"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid productId: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		sh.PostProductsProductIdMove(w, r, productId)
+	})
+
+	// GET /products/{productId}/location
+	r.Get("/products/{productId}/location", func(w http.ResponseWriter, r *http.Request) {
+		productId, err := uuid.Parse(chi.URLParam(r, "productId"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid productId: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		sh.GetProductsProductIdLocation(w, r, productId)
+	})
+
+	// PUT /pvz/{pvzId}/limits
+	r.Put("/pvz/{pvzId}/limits", func(w http.ResponseWriter, r *http.Request) {
+		pvzId, err := uuid.Parse(chi.URLParam(r, "pvzId"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid pvzId: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		sh.PutPvzPvzIdLimits(w, r, pvzId)
+	})
+
+	// GET /pvz/{pvzId}/capacity
+	r.Get("/pvz/{pvzId}/capacity", func(w http.ResponseWriter, r *http.Request) {
+		pvzId, err := uuid.Parse(chi.URLParam(r, "pvzId"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid pvzId: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		sh.GetPvzPvzIdCapacity(w, r, pvzId)
+	})
+
+	// POST /receptions/{receptionId}/attachments
+	r.Post("/receptions/{receptionId}/attachments", func(w http.ResponseWriter, r *http.Request) {
+		receptionId, err := uuid.Parse(chi.URLParam(r, "receptionId"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid receptionId: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		sh.PostReceptionsReceptionIdAttachments(w, r, receptionId)
+	})
+
+	// GET /receptions/{receptionId}/attachments
+	r.Get("/receptions/{receptionId}/attachments", func(w http.ResponseWriter, r *http.Request) {
+		receptionId, err := uuid.Parse(chi.URLParam(r, "receptionId"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid receptionId: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		sh.GetReceptionsReceptionIdAttachments(w, r, receptionId)
+	})
+
+	// POST /products/{productId}/attachments
+	r.Post("/products/{productId}/attachments", func(w http.ResponseWriter, r *http.Request) {
+		productId, err := uuid.Parse(chi.URLParam(r, "productId"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid productId: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		sh.PostProductsProductIdAttachments(w, r, productId)
+	})
+
+	// GET /products/{productId}/attachments
+	r.Get("/products/{productId}/attachments", func(w http.ResponseWriter, r *http.Request) {
+		productId, err := uuid.Parse(chi.URLParam(r, "productId"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid productId: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		sh.GetProductsProductIdAttachments(w, r, productId)
+	})
+
+	// GET /attachments/{attachmentId}
+	r.Get("/attachments/{attachmentId}", func(w http.ResponseWriter, r *http.Request) {
+		attachmentId, err := uuid.Parse(chi.URLParam(r, "attachmentId"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid attachmentId: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		sh.GetAttachmentsAttachmentId(w, r, attachmentId)
+	})
+
+	// GET /stats/receptions
+	r.Get("/stats/receptions", func(w http.ResponseWriter, r *http.Request) {
+		var params api.GetStatsReceptionsParams
+
+		err := runtime.BindQueryParameter("form", false, false, "groupBy", r.URL.Query(), &params.GroupBy)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindQueryParameter("form", true, false, "period", r.URL.Query(), &params.Period)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindQueryParameter("form", true, false, "startDate", r.URL.Query(), &params.StartDate)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindQueryParameter("form", true, false, "endDate", r.URL.Query(), &params.EndDate)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		sh.GetStatsReceptions(w, r, params)
+	})
+
+	// GET /reports/productivity
+	r.Get("/reports/productivity", func(w http.ResponseWriter, r *http.Request) {
+		var params api.GetReportsProductivityParams
+
+		err := runtime.BindQueryParameter("form", true, false, "startDate", r.URL.Query(), &params.StartDate)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindQueryParameter("form", true, false, "endDate", r.URL.Query(), &params.EndDate)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindQueryParameter("form", true, false, "pvzId", r.URL.Query(), &params.PvzId)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindQueryParameter("form", true, false, "slaMinutes", r.URL.Query(), &params.SlaMinutes)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindQueryParameter("form", true, false, "idleGapMinutes", r.URL.Query(), &params.IdleGapMinutes)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		sh.GetReportsProductivity(w, r, params)
+	})
+
+	// GET /reports/productivity/daily/{date}
+	r.Get("/reports/productivity/daily/{date}", func(w http.ResponseWriter, r *http.Request) {
+		date, err := time.Parse(time.DateOnly, chi.URLParam(r, "date"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid date: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		sh.GetReportsProductivityDailyDate(w, r, types.Date{Time: date})
+	})
+
+	// GET /export/pvz
+	r.Get("/export/pvz", func(w http.ResponseWriter, r *http.Request) {
+		var params api.GetExportPvzParams
+
+		err := runtime.BindQueryParameter("form", true, false, "startDate", r.URL.Query(), &params.StartDate)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindQueryParameter("form", true, false, "endDate", r.URL.Query(), &params.EndDate)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		params.Format, err = bindExportFormat(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		sh.GetExportPvz(w, r, params)
+	})
+
+	// GET /export/receptions
+	r.Get("/export/receptions", func(w http.ResponseWriter, r *http.Request) {
+		var params api.GetExportReceptionsParams
+
+		err := runtime.BindQueryParameter("form", true, false, "pvzId", r.URL.Query(), &params.PvzId)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindQueryParameter("form", true, false, "createdBy", r.URL.Query(), &params.CreatedBy)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindQueryParameter("form", true, false, "startDate", r.URL.Query(), &params.StartDate)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindQueryParameter("form", true, false, "endDate", r.URL.Query(), &params.EndDate)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		params.Format, err = bindExportFormat(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		sh.GetExportReceptions(w, r, params)
+	})
+
+	// GET /pvz
+	r.Get("/pvz", func(w http.ResponseWriter, r *http.Request) {
+		var params api.GetPvzParams
+
+		err := runtime.BindQueryParameter("form", true, false, "startDate", r.URL.Query(), &params.StartDate)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindQueryParameter("form", true, false, "endDate", r.URL.Query(), &params.EndDate)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindQueryParameter("form", true, true, "page", r.URL.Query(), &params.Page)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindQueryParameter("form", true, true, "limit", r.URL.Query(), &params.Limit)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindQueryParameter("form", true, false, "include", r.URL.Query(), &params.Include)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindQueryParameter("form", false, false, "fields", r.URL.Query(), &params.Fields)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if acceptsNDJSON(r.Header.Get("Accept")) {
+			r = r.WithContext(context.WithValue(r.Context(), ndjsonKey{}, true))
+		}
+
+		sh.GetPvz(w, r, params)
+	})
+}
+
+// bindExportFormat читает формат выгрузки из параметра format, а без него выбирает формат по заголовку Accept
+func bindExportFormat(r *http.Request) (*api.ExportFormat, error) {
+	var format *api.ExportFormat
+	err := runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &format)
+	if err != nil {
+		return nil, err
+	}
+	if format == nil {
+		accepted := api.ExportFormat(export.FormatFromAccept(r.Header.Get("Accept")))
+		format = &accepted
+	}
+
+	return format, nil
+}
+
+// acceptsNDJSON проверяет, просит ли клиент потоковый ответ application/x-ndjson
+func acceptsNDJSON(accept string) bool {
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(mediaRange))
+		if err == nil && mediaType == "application/x-ndjson" {
+			return true
+		}
+	}
+
+	return false
 }
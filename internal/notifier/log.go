@@ -0,0 +1,28 @@
+package notifier
+
+import (
+	"context"
+
+	"github.com/devWaylander/pvz_store/api"
+	"github.com/devWaylander/pvz_store/pkg/log"
+)
+
+// logNotifier пишет коды выдачи в лог вместо отправки SMS/email, пока шлюз рассылок не подключен
+type logNotifier struct{}
+
+func NewLogNotifier() *logNotifier {
+	return &logNotifier{}
+}
+
+func (n *logNotifier) SendPickupCode(ctx context.Context, order api.Order, code string) error {
+	event := log.Logger.Info().Str("order_number", order.Number).Str("pickup_code", code)
+	if order.RecipientPhone != nil {
+		event = event.Str("recipient_phone", *order.RecipientPhone)
+	}
+	if order.RecipientEmail != nil {
+		event = event.Str("recipient_email", *order.RecipientEmail)
+	}
+	event.Msg("код выдачи заказа отправлен получателю")
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/devWaylander/pvz_store/api"
+	"github.com/devWaylander/pvz_store/pkg/log"
+	"github.com/devWaylander/pvz_store/pkg/models"
+	"github.com/google/uuid"
+)
+
+/*
+Attachment
+*/
+func (r *repository) CreateAttachment(ctx context.Context, attachment models.NewAttachment) (api.Attachment, error) {
+	query := `
+		INSERT INTO shop.attachments (id, reception_id, product_id, file_name, content_type, size_bytes, storage_key, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, reception_id, product_id, file_name, content_type, size_bytes, storage_key, created_by, created_at
+	`
+
+	var inserted models.AttachmentDB
+	err := r.conn(ctx).GetContext(ctx, &inserted, query,
+		attachment.ID, attachment.Owner.ReceptionID, attachment.Owner.ProductID, attachment.FileName,
+		attachment.ContentType, attachment.Size, attachment.StorageKey, attachment.CreatedBy,
+	)
+	if err != nil {
+		log.Logger.Err(err).Str("attachment_uuid", attachment.ID.String()).Msg("method CreateAttachment")
+		return api.Attachment{}, errors.New("could not create attachment")
+	}
+
+	return inserted.ToModelAPIAttachment(), nil
+}
+
+// GetAttachmentsByOwner возвращает вложения приемки или товара в порядке загрузки
+func (r *repository) GetAttachmentsByOwner(ctx context.Context, owner models.AttachmentOwner) ([]api.Attachment, error) {
+	query := `
+		SELECT id, reception_id, product_id, file_name, content_type, size_bytes, storage_key, created_by, created_at
+		FROM shop.attachments
+		WHERE reception_id = $1 OR product_id = $2
+		ORDER BY created_at, id
+	`
+
+	var attachments []models.AttachmentDB
+	err := r.conn(ctx).SelectContext(ctx, &attachments, query, owner.ReceptionID, owner.ProductID)
+	if err != nil {
+		log.Logger.Err(err).Msg("method GetAttachmentsByOwner")
+		return nil, errors.New("could not get attachments")
+	}
+
+	result := make([]api.Attachment, 0, len(attachments))
+	for _, attachment := range attachments {
+		result = append(result, attachment.ToModelAPIAttachment())
+	}
+
+	return result, nil
+}
+
+// GetAttachmentByUUID возвращает метаданные вложения и ключ его содержимого в хранилище файлов
+func (r *repository) GetAttachmentByUUID(ctx context.Context, attachmentUUID uuid.UUID) (api.Attachment, string, error) {
+	query := `
+		SELECT id, reception_id, product_id, file_name, content_type, size_bytes, storage_key, created_by, created_at
+		FROM shop.attachments
+		WHERE id = $1
+	`
+
+	var attachment models.AttachmentDB
+	err := r.conn(ctx).GetContext(ctx, &attachment, query, attachmentUUID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.Attachment{}, "", nil
+		}
+		log.Logger.Err(err).Str("attachment_uuid", attachmentUUID.String()).Msg("method GetAttachmentByUUID")
+		return api.Attachment{}, "", errors.New("could not get attachment by uuid")
+	}
+
+	return attachment.ToModelAPIAttachment(), attachment.StorageKey, nil
+}
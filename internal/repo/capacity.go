@@ -0,0 +1,114 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/devWaylander/pvz_store/api"
+	"github.com/devWaylander/pvz_store/pkg/log"
+	"github.com/devWaylander/pvz_store/pkg/models"
+	"github.com/google/uuid"
+)
+
+// capacityUsageColumns количество, суммарный вес и объем товаров p, товары без веса или габаритов учитываются нулем
+const capacityUsageColumns = `COUNT(p.id) AS products,
+			COALESCE(SUM(p.weight), 0)::bigint AS weight,
+			COALESCE(SUM(p.length::bigint * p.width * p.height), 0)::bigint AS volume`
+
+/*
+Capacity
+*/
+// GetPvzLimits возвращает ограничения ПВЗ, без заданных ограничений лимиты не проверяются
+func (r *repository) GetPvzLimits(ctx context.Context, pvzUUID uuid.UUID) (api.PvzLimits, error) {
+	query := `
+		SELECT pvz_id, max_item_weight, max_item_volume, max_total_weight, max_total_volume, on_exceed
+		FROM shop.pvz_limits
+		WHERE pvz_id = $1
+	`
+
+	var limits models.PvzLimitsDB
+	err := r.conn(ctx).GetContext(ctx, &limits, query, pvzUUID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.PvzLimits{OnExceed: api.Reject}, nil
+		}
+		log.Logger.Err(err).Str("pvz_uuid", pvzUUID.String()).Msg("method GetPvzLimits")
+		return api.PvzLimits{}, errors.New("could not get pvz limits")
+	}
+
+	return limits.ToModelAPIPvzLimits(), nil
+}
+
+func (r *repository) SetPvzLimits(ctx context.Context, pvzUUID uuid.UUID, limits api.PvzLimits, updatedBy uuid.UUID) (api.PvzLimits, error) {
+	query := `
+		INSERT INTO shop.pvz_limits (pvz_id, max_item_weight, max_item_volume, max_total_weight, max_total_volume, on_exceed, updated_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (pvz_id) DO UPDATE
+		SET max_item_weight = EXCLUDED.max_item_weight,
+			max_item_volume = EXCLUDED.max_item_volume,
+			max_total_weight = EXCLUDED.max_total_weight,
+			max_total_volume = EXCLUDED.max_total_volume,
+			on_exceed = EXCLUDED.on_exceed,
+			updated_by = EXCLUDED.updated_by,
+			updated_at = NOW()
+		RETURNING pvz_id, max_item_weight, max_item_volume, max_total_weight, max_total_volume, on_exceed
+	`
+
+	var saved models.PvzLimitsDB
+	err := r.conn(ctx).GetContext(ctx, &saved, query, pvzUUID,
+		limits.MaxItemWeight, limits.MaxItemVolume, limits.MaxTotalWeight, limits.MaxTotalVolume,
+		limits.OnExceed, updatedBy,
+	)
+	if err != nil {
+		log.Logger.Err(err).Str("pvz_uuid", pvzUUID.String()).Msg("method SetPvzLimits")
+		return api.PvzLimits{}, errors.New("could not set pvz limits")
+	}
+
+	return saved.ToModelAPIPvzLimits(), nil
+}
+
+// GetPvzCapacityUsage возвращает занятые товарами на руках ПВЗ вес и объем
+func (r *repository) GetPvzCapacityUsage(ctx context.Context, pvzUUID uuid.UUID) (api.CapacityUsage, error) {
+	query := `
+		SELECT ` + capacityUsageColumns + `
+		FROM shop.products p
+		WHERE p.current_pvz_id = $1 AND p.status IN ('received', 'stored') AND p.deleted_at IS NULL AND p.voided_at IS NULL
+	`
+
+	var usage models.CapacityUsageDB
+	err := r.conn(ctx).GetContext(ctx, &usage, query, pvzUUID)
+	if err != nil {
+		log.Logger.Err(err).Str("pvz_uuid", pvzUUID.String()).Msg("method GetPvzCapacityUsage")
+		return api.CapacityUsage{}, errors.New("could not get pvz capacity usage")
+	}
+
+	return usage.ToModelAPICapacityUsage(), nil
+}
+
+// GetCellsCapacityUsage возвращает занятые товарами на руках вес и объем каждой ячейки ПВЗ в порядке кодов
+func (r *repository) GetCellsCapacityUsage(ctx context.Context, pvzUUID uuid.UUID) ([]api.CellCapacity, error) {
+	query := `
+		SELECT c.id AS cell_id, c.code, ` + capacityUsageColumns + `
+		FROM shop.storage_cells c
+		LEFT JOIN shop.products p ON p.cell_id = c.id AND p.status IN ('received', 'stored')
+			AND p.deleted_at IS NULL AND p.voided_at IS NULL
+		WHERE c.pvz_id = $1
+		GROUP BY c.id, c.code
+		ORDER BY c.code
+	`
+
+	var cells []models.CellCapacityDB
+	err := r.conn(ctx).SelectContext(ctx, &cells, query, pvzUUID)
+	if err != nil {
+		log.Logger.Err(err).Str("pvz_uuid", pvzUUID.String()).Msg("method GetCellsCapacityUsage")
+		return nil, errors.New("could not get cells capacity usage")
+	}
+
+	result := make([]api.CellCapacity, 0, len(cells))
+	for _, cell := range cells {
+		result = append(result, cell.ToModelAPICellCapacity())
+	}
+
+	return result, nil
+}
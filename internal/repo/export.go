@@ -0,0 +1,144 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/devWaylander/pvz_store/pkg/log"
+	"github.com/devWaylander/pvz_store/pkg/models"
+)
+
+// exportFetchSize количество строк, которое выгрузка забирает из курсора за один FETCH
+const exportFetchSize = 1000
+
+/*
+Export
+*/
+// ExportPVZs передает в fn строки выгрузки ПВЗ с приемками за период и их товарами без удаленных
+func (r *repository) ExportPVZs(ctx context.Context, startDate, endDate *time.Time, fn func(row models.PvzExportRowDB) error) error {
+	var args []any
+	receptionConditions := ""
+	if startDate != nil {
+		args = append(args, *startDate)
+		receptionConditions += fmt.Sprintf(` AND r.created_at >= $%d`, len(args))
+	}
+	if endDate != nil {
+		args = append(args, *endDate)
+		receptionConditions += fmt.Sprintf(` AND r.created_at <= $%d`, len(args))
+	}
+
+	query := `
+		SELECT pv.id, pv.city, pv.registration_date,
+			r.id, r.created_at, r.status, r.type,
+			p.id, p.created_at, p.type, p.barcode, p.status
+		FROM shop.pvz pv
+		LEFT JOIN shop.receptions r ON r.pvz_id = pv.id` + receptionConditions + `
+		LEFT JOIN shop.products p ON p.reception_id = r.id AND p.deleted_at IS NULL
+		ORDER BY pv.registration_date, pv.id, r.created_at DESC, r.id, p.seq
+	`
+
+	return r.streamCursor(ctx, "export_pvz", query, args, func(rows *sql.Rows) error {
+		var row models.PvzExportRowDB
+		err := rows.Scan(&row.PvzID, &row.City, &row.RegistrationDate,
+			&row.ReceptionID, &row.ReceptionCreatedAt, &row.ReceptionStatus, &row.ReceptionType,
+			&row.ProductID, &row.ProductCreatedAt, &row.ProductType, &row.Barcode, &row.ProductStatus)
+		if err != nil {
+			log.Logger.Err(err).Msg("method ExportPVZs")
+			return errors.New("could not scan pvz export row")
+		}
+
+		return fn(row)
+	})
+}
+
+// ExportReceptions передает в fn приемки, отобранные фильтром GetReceptionsFiltered без пагинации, со счетчиками товаров
+func (r *repository) ExportReceptions(ctx context.Context, filter models.ReceptionFilter, fn func(row models.ReceptionExportRowDB) error) error {
+	conditions, args := receptionFilterConditions(filter)
+	query := `
+		SELECT r.id, r.pvz_id, r.status, r.type, r.created_at, r.created_by, r.closed_by, r.stale_at,
+			COALESCE(c.total, 0), COALESCE(c.counts, '')
+		FROM shop.receptions r
+		LEFT JOIN LATERAL (
+			SELECT SUM(t.cnt)::bigint AS total, string_agg(t.type || ':' || t.cnt, ', ' ORDER BY t.type) AS counts
+			FROM (
+				SELECT p.type, COUNT(*) AS cnt
+				FROM shop.products p
+				WHERE p.reception_id = r.id AND p.voided_at IS NULL AND p.deleted_at IS NULL
+				GROUP BY p.type
+			) t
+		) c ON TRUE
+		WHERE TRUE` + conditions + `
+		ORDER BY r.created_at DESC, r.id
+	`
+
+	return r.streamCursor(ctx, "export_receptions", query, args, func(rows *sql.Rows) error {
+		var row models.ReceptionExportRowDB
+		err := rows.Scan(&row.ID, &row.PvzID, &row.Status, &row.Type, &row.CreatedAt, &row.CreatedBy, &row.ClosedBy, &row.StaleAt,
+			&row.Products, &row.ProductCounts)
+		if err != nil {
+			log.Logger.Err(err).Msg("method ExportReceptions")
+			return errors.New("could not scan reception export row")
+		}
+
+		return fn(row)
+	})
+}
+
+// streamCursor читает результат query серверным курсором пачками по exportFetchSize и передает строки в scan,
+// поэтому выгрузка любого диапазона держит в памяти не больше одной пачки. Курсор живет в транзакции до конца чтения
+func (r *repository) streamCursor(ctx context.Context, name, query string, args []any, scan func(rows *sql.Rows) error) error {
+	return r.WithTx(ctx, func(ctx context.Context) error {
+		_, err := r.conn(ctx).ExecContext(ctx, `DECLARE `+name+` NO SCROLL CURSOR FOR `+query, args...)
+		if err != nil {
+			log.Logger.Err(err).Str("cursor", name).Msg("method streamCursor, DECLARE")
+			return errors.New("could not open export cursor")
+		}
+
+		fetchQuery := fmt.Sprintf(`FETCH %d FROM %s`, exportFetchSize, name)
+		for {
+			fetched, err := r.fetchCursor(ctx, fetchQuery, scan)
+			if err != nil {
+				return err
+			}
+			if fetched < exportFetchSize {
+				break
+			}
+		}
+
+		_, err = r.conn(ctx).ExecContext(ctx, `CLOSE `+name)
+		if err != nil {
+			log.Logger.Err(err).Str("cursor", name).Msg("method streamCursor, CLOSE")
+			return errors.New("could not close export cursor")
+		}
+
+		return nil
+	})
+}
+
+// fetchCursor выполняет один FETCH и возвращает количество полученных строк
+func (r *repository) fetchCursor(ctx context.Context, fetchQuery string, scan func(rows *sql.Rows) error) (int, error) {
+	rows, err := r.conn(ctx).QueryContext(ctx, fetchQuery)
+	if err != nil {
+		log.Logger.Err(err).Msg("method fetchCursor")
+		return 0, errors.New("could not fetch export cursor")
+	}
+	defer rows.Close()
+
+	fetched := 0
+	for rows.Next() {
+		fetched++
+		if err := scan(rows); err != nil {
+			return fetched, err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Logger.Err(err).Msg("method fetchCursor")
+		return fetched, errors.New("error during rows iteration")
+	}
+
+	return fetched, nil
+}
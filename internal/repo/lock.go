@@ -0,0 +1,40 @@
+package repo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/devWaylander/pvz_store/pkg/log"
+)
+
+// TryAdvisoryLock пытается взять сессионную advisory-блокировку Postgres по ключу key.
+// Блокировка держится на выделенном соединении до вызова unlock, поэтому среди всех реплик
+// её может удерживать только одна. Если блокировка занята, возвращается ok == false
+func (r *repository) TryAdvisoryLock(ctx context.Context, key int64) (unlock func(), ok bool, err error) {
+	conn, err := r.db.Conn(ctx)
+	if err != nil {
+		log.Logger.Err(err).Msg("method TryAdvisoryLock, Conn")
+		return nil, false, errors.New("could not get db connection")
+	}
+
+	err = conn.QueryRowContext(ctx, `SELECT pg_try_advisory_lock($1)`, key).Scan(&ok)
+	if err != nil {
+		conn.Close()
+		log.Logger.Err(err).Int64("key", key).Msg("method TryAdvisoryLock")
+		return nil, false, errors.New("could not acquire advisory lock")
+	}
+	if !ok {
+		conn.Close()
+		return nil, false, nil
+	}
+
+	unlock = func() {
+		// контекст вызова может быть уже отменён, снимаем блокировку независимо от него
+		if _, err := conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock($1)`, key); err != nil {
+			log.Logger.Err(err).Int64("key", key).Msg("method TryAdvisoryLock, unlock")
+		}
+		conn.Close()
+	}
+
+	return unlock, true, nil
+}
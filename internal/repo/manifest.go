@@ -0,0 +1,302 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/devWaylander/pvz_store/api"
+	"github.com/devWaylander/pvz_store/pkg/log"
+	"github.com/devWaylander/pvz_store/pkg/models"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+/*
+Manifest
+*/
+func (r *repository) CreateManifest(
+	ctx context.Context,
+	pvzUUID uuid.UUID,
+	supplier string,
+	strict bool,
+	items []api.ManifestItem,
+	createdBy uuid.UUID,
+) (api.Manifest, error) {
+	query := `
+		INSERT INTO shop.manifests (pvz_id, supplier, strict, created_by)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, pvz_id, supplier, strict, status, reception_id, created_by, created_at
+	`
+	itemQuery := `
+		INSERT INTO shop.manifest_items (manifest_id, product_type, sku, expected_count)
+		VALUES ($1, $2, $3, $4)
+	`
+
+	var inserted models.ManifestDB
+	insertedItems := make([]models.ManifestItemDB, 0, len(items))
+	err := r.WithTx(ctx, func(ctx context.Context) error {
+		err := r.conn(ctx).QueryRowContext(ctx, query, pvzUUID, supplier, strict, createdBy).Scan(
+			&inserted.ID, &inserted.PvzID, &inserted.Supplier, &inserted.Strict,
+			&inserted.Status, &inserted.ReceptionID, &inserted.CreatedBy, &inserted.CreatedAt,
+		)
+		if err != nil {
+			log.Logger.Err(err).Str("pvz_uuid", pvzUUID.String()).Msg("method CreateManifest")
+			return errors.New("could not create manifest")
+		}
+
+		for _, item := range items {
+			var sku sql.NullString
+			if item.Sku != nil {
+				sku = sql.NullString{String: *item.Sku, Valid: true}
+			}
+			_, err := r.conn(ctx).ExecContext(ctx, itemQuery, inserted.ID, item.Type, sku, item.Count)
+			if err != nil {
+				log.Logger.Err(err).Str("manifest_id", inserted.ID.String()).Msg("method CreateManifest")
+				return errors.New("could not create manifest item")
+			}
+			insertedItems = append(insertedItems, models.ManifestItemDB{
+				ManifestID:    inserted.ID,
+				ProductType:   item.Type,
+				Sku:           sku,
+				ExpectedCount: item.Count,
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return api.Manifest{}, err
+	}
+
+	return inserted.ToModelAPIManifest(insertedItems), nil
+}
+
+func (r *repository) GetManifestByUUID(ctx context.Context, manifestUUID uuid.UUID) (api.Manifest, error) {
+	query := `
+		SELECT id, pvz_id, supplier, strict, status, reception_id, created_by, created_at
+		FROM shop.manifests
+		WHERE id = $1
+	`
+
+	var manifest models.ManifestDB
+	err := r.conn(ctx).QueryRowContext(ctx, query, manifestUUID).Scan(
+		&manifest.ID, &manifest.PvzID, &manifest.Supplier, &manifest.Strict,
+		&manifest.Status, &manifest.ReceptionID, &manifest.CreatedBy, &manifest.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.Manifest{}, nil
+		}
+		log.Logger.Err(err).Str("manifest_uuid", manifestUUID.String()).Msg("method GetManifestByUUID")
+		return api.Manifest{}, errors.New("could not get manifest by uuid")
+	}
+
+	items, err := r.getManifestItems(ctx, []uuid.UUID{manifest.ID})
+	if err != nil {
+		return api.Manifest{}, err
+	}
+
+	return manifest.ToModelAPIManifest(items[manifest.ID]), nil
+}
+
+// GetManifestsToReconcile возвращает ещё не сверенные манифесты ПВЗ и манифесты, уже сверенные с приемкой
+// (при повторном закрытии после переоткрытия), блокируя их до конца транзакции
+func (r *repository) GetManifestsToReconcile(ctx context.Context, pvzUUID, recUUID uuid.UUID) ([]api.Manifest, error) {
+	query := `
+		SELECT id, pvz_id, supplier, strict, status, reception_id, created_by, created_at
+		FROM shop.manifests
+		WHERE pvz_id = $1 AND (status = 'expected' OR reception_id = $2)
+		ORDER BY created_at
+		FOR UPDATE
+	`
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, pvzUUID, recUUID)
+	if err != nil {
+		log.Logger.Err(err).Msg("method GetManifestsToReconcile")
+		return nil, errors.New("could not get manifests to reconcile")
+	}
+	defer rows.Close()
+
+	var manifests []models.ManifestDB
+	for rows.Next() {
+		var manifest models.ManifestDB
+		if err := rows.Scan(
+			&manifest.ID, &manifest.PvzID, &manifest.Supplier, &manifest.Strict,
+			&manifest.Status, &manifest.ReceptionID, &manifest.CreatedBy, &manifest.CreatedAt,
+		); err != nil {
+			log.Logger.Err(err).Msg("method GetManifestsToReconcile")
+			return nil, errors.New("could not scan manifest row")
+		}
+		manifests = append(manifests, manifest)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Logger.Err(err).Msg("method GetManifestsToReconcile")
+		return nil, errors.New("error during rows iteration")
+	}
+
+	manifestUUIDs := make([]uuid.UUID, 0, len(manifests))
+	for _, manifest := range manifests {
+		manifestUUIDs = append(manifestUUIDs, manifest.ID)
+	}
+	items, err := r.getManifestItems(ctx, manifestUUIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]api.Manifest, 0, len(manifests))
+	for _, manifest := range manifests {
+		result = append(result, manifest.ToModelAPIManifest(items[manifest.ID]))
+	}
+
+	return result, nil
+}
+
+func (r *repository) MarkManifestsReceived(ctx context.Context, manifestUUIDs []uuid.UUID, recUUID uuid.UUID) error {
+	query := `
+		UPDATE shop.manifests
+		SET status = 'received', reception_id = $1
+		WHERE id = ANY($2)
+	`
+
+	_, err := r.conn(ctx).ExecContext(ctx, query, recUUID, pq.Array(manifestUUIDs))
+	if err != nil {
+		log.Logger.Err(err).Str("reception_id", recUUID.String()).Msg("method MarkManifestsReceived")
+		return errors.New("could not mark manifests as received")
+	}
+
+	return nil
+}
+
+func (r *repository) getManifestItems(ctx context.Context, manifestUUIDs []uuid.UUID) (map[uuid.UUID][]models.ManifestItemDB, error) {
+	query := `
+		SELECT manifest_id, product_type, sku, expected_count
+		FROM shop.manifest_items
+		WHERE manifest_id = ANY($1)
+		ORDER BY product_type, sku NULLS FIRST
+	`
+
+	var items []models.ManifestItemDB
+	err := r.conn(ctx).SelectContext(ctx, &items, query, pq.Array(manifestUUIDs))
+	if err != nil {
+		log.Logger.Err(err).Msg("method getManifestItems")
+		return nil, errors.New("could not get manifest items")
+	}
+
+	itemsByManifest := make(map[uuid.UUID][]models.ManifestItemDB)
+	for _, item := range items {
+		itemsByManifest[item.ManifestID] = append(itemsByManifest[item.ManifestID], item)
+	}
+
+	return itemsByManifest, nil
+}
+
+/*
+Discrepancy report
+*/
+// SaveDiscrepancyReport сохраняет отчет о расхождениях, заменяя отчет предыдущего закрытия приемки
+func (r *repository) SaveDiscrepancyReport(ctx context.Context, report api.DiscrepancyReport) (api.DiscrepancyReport, error) {
+	deleteQuery := `
+		DELETE FROM shop.discrepancy_reports
+		WHERE reception_id = $1
+	`
+	query := `
+		INSERT INTO shop.discrepancy_reports (reception_id, has_discrepancies, overridden_by, override_reason)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, reception_id, has_discrepancies, overridden_by, override_reason, created_at
+	`
+	itemQuery := `
+		INSERT INTO shop.discrepancy_report_items (report_id, product_type, sku, expected_count, actual_count, kind)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	var overriddenBy uuid.NullUUID
+	if report.OverriddenBy != nil {
+		overriddenBy = uuid.NullUUID{UUID: *report.OverriddenBy, Valid: true}
+	}
+
+	var inserted models.DiscrepancyReportDB
+	insertedItems := make([]models.DiscrepancyItemDB, 0, len(report.Items))
+	err := r.WithTx(ctx, func(ctx context.Context) error {
+		_, err := r.conn(ctx).ExecContext(ctx, deleteQuery, report.ReceptionId)
+		if err != nil {
+			log.Logger.Err(err).Str("reception_id", report.ReceptionId.String()).Msg("method SaveDiscrepancyReport")
+			return errors.New("could not delete previous discrepancy report")
+		}
+
+		err = r.conn(ctx).QueryRowContext(ctx, query,
+			report.ReceptionId, report.HasDiscrepancies, overriddenBy, report.OverrideReason,
+		).Scan(
+			&inserted.ID, &inserted.ReceptionID, &inserted.HasDiscrepancies,
+			&inserted.OverriddenBy, &inserted.OverrideReason, &inserted.CreatedAt,
+		)
+		if err != nil {
+			log.Logger.Err(err).Str("reception_id", report.ReceptionId.String()).Msg("method SaveDiscrepancyReport")
+			return errors.New("could not save discrepancy report")
+		}
+
+		for _, item := range report.Items {
+			var sku sql.NullString
+			if item.Sku != nil {
+				sku = sql.NullString{String: *item.Sku, Valid: true}
+			}
+			_, err := r.conn(ctx).ExecContext(ctx, itemQuery, inserted.ID, item.Type, sku, item.Expected, item.Actual, string(item.Kind))
+			if err != nil {
+				log.Logger.Err(err).Str("report_id", inserted.ID.String()).Msg("method SaveDiscrepancyReport")
+				return errors.New("could not save discrepancy report item")
+			}
+			insertedItems = append(insertedItems, models.DiscrepancyItemDB{
+				ReportID:      inserted.ID,
+				ProductType:   item.Type,
+				Sku:           sku,
+				ExpectedCount: item.Expected,
+				ActualCount:   item.Actual,
+				Kind:          string(item.Kind),
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return api.DiscrepancyReport{}, err
+	}
+
+	return inserted.ToModelAPIDiscrepancyReport(insertedItems), nil
+}
+
+func (r *repository) GetDiscrepancyReportByReceptionUUID(ctx context.Context, recUUID uuid.UUID) (api.DiscrepancyReport, error) {
+	query := `
+		SELECT id, reception_id, has_discrepancies, overridden_by, override_reason, created_at
+		FROM shop.discrepancy_reports
+		WHERE reception_id = $1
+	`
+	itemsQuery := `
+		SELECT report_id, product_type, sku, expected_count, actual_count, kind
+		FROM shop.discrepancy_report_items
+		WHERE report_id = $1
+		ORDER BY product_type, sku NULLS FIRST
+	`
+
+	var report models.DiscrepancyReportDB
+	err := r.conn(ctx).QueryRowContext(ctx, query, recUUID).Scan(
+		&report.ID, &report.ReceptionID, &report.HasDiscrepancies,
+		&report.OverriddenBy, &report.OverrideReason, &report.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.DiscrepancyReport{}, nil
+		}
+		log.Logger.Err(err).Str("reception_id", recUUID.String()).Msg("method GetDiscrepancyReportByReceptionUUID")
+		return api.DiscrepancyReport{}, errors.New("could not get discrepancy report")
+	}
+
+	var items []models.DiscrepancyItemDB
+	err = r.conn(ctx).SelectContext(ctx, &items, itemsQuery, report.ID)
+	if err != nil {
+		log.Logger.Err(err).Str("report_id", report.ID.String()).Msg("method GetDiscrepancyReportByReceptionUUID")
+		return api.DiscrepancyReport{}, errors.New("could not get discrepancy report items")
+	}
+
+	return report.ToModelAPIDiscrepancyReport(items), nil
+}
@@ -0,0 +1,333 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/devWaylander/pvz_store/api"
+	internalErrors "github.com/devWaylander/pvz_store/pkg/errors"
+	"github.com/devWaylander/pvz_store/pkg/log"
+	"github.com/devWaylander/pvz_store/pkg/models"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const orderColumns = `id, number, pvz_id, recipient_phone, recipient_email, status, pickup_code_hash,
+	pickup_attempts, ready_at, issued_at, created_by, created_at`
+
+/*
+Order
+*/
+func (r *repository) CreateOrder(
+	ctx context.Context,
+	pvzUUID uuid.UUID,
+	number string,
+	recipientPhone, recipientEmail *string,
+	barcodes []string,
+	createdBy uuid.UUID,
+) (api.Order, error) {
+	query := `
+		INSERT INTO shop.orders (number, pvz_id, recipient_phone, recipient_email, created_by)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING ` + orderColumns
+	itemsQuery := `
+		INSERT INTO shop.order_items (order_id, barcode)
+		SELECT $1, barcode
+		FROM unnest($2::text[]) AS barcode
+	`
+
+	var inserted models.OrderDB
+	err := r.WithTx(ctx, func(ctx context.Context) error {
+		err := r.conn(ctx).GetContext(ctx, &inserted, query, number, pvzUUID, recipientPhone, recipientEmail, createdBy)
+		if err != nil {
+			if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" && pqErr.Constraint == "orders_number_key" {
+				return errors.New(internalErrors.ErrOrderExist)
+			}
+
+			log.Logger.Err(err).Str("pvz_uuid", pvzUUID.String()).Msg("method CreateOrder")
+			return errors.New("could not create order")
+		}
+
+		_, err = r.conn(ctx).ExecContext(ctx, itemsQuery, inserted.ID, pq.Array(barcodes))
+		if err != nil {
+			log.Logger.Err(err).Str("order_id", inserted.ID.String()).Msg("method CreateOrder")
+			return errors.New("could not create order items")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return api.Order{}, err
+	}
+
+	items := make([]models.OrderItemDB, 0, len(barcodes))
+	for _, barcode := range barcodes {
+		items = append(items, models.OrderItemDB{OrderID: inserted.ID, Barcode: barcode})
+	}
+
+	return inserted.ToModelAPIOrder(items), nil
+}
+
+func (r *repository) GetOrderByUUID(ctx context.Context, orderUUID uuid.UUID) (api.Order, error) {
+	return r.getOrderByUUID(ctx, orderUUID, "")
+}
+
+// GetOrderByUUIDForUpdate возвращает заказ, блокируя его до конца транзакции
+func (r *repository) GetOrderByUUIDForUpdate(ctx context.Context, orderUUID uuid.UUID) (api.Order, error) {
+	return r.getOrderByUUID(ctx, orderUUID, "FOR UPDATE")
+}
+
+func (r *repository) getOrderByUUID(ctx context.Context, orderUUID uuid.UUID, lock string) (api.Order, error) {
+	query := `
+		SELECT ` + orderColumns + `
+		FROM shop.orders
+		WHERE id = $1
+		` + lock
+
+	var order models.OrderDB
+	err := r.conn(ctx).GetContext(ctx, &order, query, orderUUID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.Order{}, nil
+		}
+		log.Logger.Err(err).Str("order_uuid", orderUUID.String()).Msg("method GetOrderByUUID")
+		return api.Order{}, errors.New("could not get order by uuid")
+	}
+
+	items, err := r.getOrderItems(ctx, []uuid.UUID{order.ID})
+	if err != nil {
+		return api.Order{}, err
+	}
+
+	return order.ToModelAPIOrder(items[order.ID]), nil
+}
+
+// GetOrderPickupCodeHash возвращает хеш действующего кода выдачи заказа
+func (r *repository) GetOrderPickupCodeHash(ctx context.Context, orderUUID uuid.UUID) (string, error) {
+	query := `
+		SELECT pickup_code_hash
+		FROM shop.orders
+		WHERE id = $1
+	`
+
+	var hash sql.NullString
+	err := r.conn(ctx).QueryRowContext(ctx, query, orderUUID).Scan(&hash)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+		log.Logger.Err(err).Str("order_uuid", orderUUID.String()).Msg("method GetOrderPickupCodeHash")
+		return "", errors.New("could not get order pickup code")
+	}
+
+	return hash.String, nil
+}
+
+// MatchOrderItems сопоставляет товары на руках ПВЗ с ожидающими позициями заказов этого ПВЗ по штрихкоду.
+// Позиция, сопоставленная с удаленным, аннулированным или ушедшим со склада товаром, сопоставляется заново.
+// Товары незавершенных перемещений не сопоставляются: они уедут в другой ПВЗ.
+// Если один штрихкод ждут несколько заказов, товар достается самому раннему
+func (r *repository) MatchOrderItems(ctx context.Context, productUUIDs []uuid.UUID) error {
+	query := `
+		WITH scanned AS (
+			SELECT p.id AS product_id, p.barcode, p.current_pvz_id AS pvz_id
+			FROM shop.products p
+			WHERE p.id = ANY($1)
+				AND p.barcode IS NOT NULL
+				AND p.status IN ('received', 'stored')
+				AND p.deleted_at IS NULL
+				AND p.voided_at IS NULL
+				AND NOT EXISTS (SELECT 1 FROM shop.order_items x WHERE x.product_id = p.id)
+				AND NOT EXISTS (
+					SELECT 1
+					FROM shop.transfer_items ti
+					JOIN shop.transfers t ON t.id = ti.transfer_id
+					WHERE ti.product_id = p.id AND t.status IN ('created', 'dispatched')
+				)
+		), candidates AS (
+			SELECT DISTINCT ON (s.product_id) oi.order_id, oi.barcode, s.product_id
+			FROM scanned s
+			JOIN shop.order_items oi ON oi.barcode = s.barcode
+			JOIN shop.orders o ON o.id = oi.order_id AND o.pvz_id = s.pvz_id AND o.status = 'awaiting'
+			LEFT JOIN shop.products linked ON linked.id = oi.product_id
+			WHERE oi.product_id IS NULL
+				OR linked.deleted_at IS NOT NULL
+				OR linked.voided_at IS NOT NULL
+				OR linked.status NOT IN ('received', 'stored')
+			ORDER BY s.product_id, o.created_at
+		)
+		UPDATE shop.order_items oi
+		SET product_id = c.product_id
+		FROM candidates c
+		WHERE oi.order_id = c.order_id AND oi.barcode = c.barcode
+	`
+
+	_, err := r.conn(ctx).ExecContext(ctx, query, pq.Array(productUUIDs))
+	if err != nil {
+		log.Logger.Err(err).Msg("method MatchOrderItems")
+		return errors.New("could not match order items")
+	}
+
+	return nil
+}
+
+// GetOrdersReadyForPickup возвращает ожидающие заказы ПВЗ, все позиции которых сопоставлены
+// с товарами на хранении, блокируя их до конца транзакции
+func (r *repository) GetOrdersReadyForPickup(ctx context.Context, pvzUUID uuid.UUID) ([]api.Order, error) {
+	query := `
+		SELECT ` + orderColumns + `
+		FROM shop.orders o
+		WHERE o.pvz_id = $1
+			AND o.status = 'awaiting'
+			AND NOT EXISTS (
+				SELECT 1
+				FROM shop.order_items oi
+				LEFT JOIN shop.products p ON p.id = oi.product_id
+				WHERE oi.order_id = o.id
+					AND (p.id IS NULL OR p.status <> 'stored' OR p.deleted_at IS NOT NULL OR p.voided_at IS NOT NULL)
+			)
+		ORDER BY o.created_at
+		FOR UPDATE
+	`
+
+	var orders []models.OrderDB
+	err := r.conn(ctx).SelectContext(ctx, &orders, query, pvzUUID)
+	if err != nil {
+		log.Logger.Err(err).Str("pvz_uuid", pvzUUID.String()).Msg("method GetOrdersReadyForPickup")
+		return nil, errors.New("could not get orders ready for pickup")
+	}
+
+	return r.withOrderItems(ctx, orders)
+}
+
+// SetOrderPickupCode переводит заказ в статус ready с новым кодом выдачи и сбрасывает счетчик попыток
+func (r *repository) SetOrderPickupCode(ctx context.Context, orderUUID uuid.UUID, codeHash string) (api.Order, error) {
+	query := `
+		UPDATE shop.orders
+		SET status = 'ready', pickup_code_hash = $1, pickup_attempts = 0, ready_at = COALESCE(ready_at, NOW())
+		WHERE id = $2 AND status IN ('awaiting', 'ready')
+		RETURNING ` + orderColumns
+
+	var order models.OrderDB
+	err := r.conn(ctx).GetContext(ctx, &order, query, codeHash, orderUUID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.Order{}, errors.New(internalErrors.ErrWrongOrderStatus)
+		}
+		log.Logger.Err(err).Str("order_uuid", orderUUID.String()).Msg("method SetOrderPickupCode")
+		return api.Order{}, errors.New("could not set order pickup code")
+	}
+
+	items, err := r.getOrderItems(ctx, []uuid.UUID{order.ID})
+	if err != nil {
+		return api.Order{}, err
+	}
+
+	return order.ToModelAPIOrder(items[order.ID]), nil
+}
+
+// RecordPickupAttempt учитывает неверную попытку ввода кода выдачи
+func (r *repository) RecordPickupAttempt(ctx context.Context, orderUUID uuid.UUID) error {
+	query := `
+		UPDATE shop.orders
+		SET pickup_attempts = pickup_attempts + 1
+		WHERE id = $1
+	`
+
+	_, err := r.conn(ctx).ExecContext(ctx, query, orderUUID)
+	if err != nil {
+		log.Logger.Err(err).Str("order_uuid", orderUUID.String()).Msg("method RecordPickupAttempt")
+		return errors.New("could not record pickup attempt")
+	}
+
+	return nil
+}
+
+// IssueOrder переводит готовый заказ в статус issued, код выдачи после этого недействителен
+func (r *repository) IssueOrder(ctx context.Context, orderUUID uuid.UUID, issuedBy uuid.UUID) (api.Order, error) {
+	query := `
+		UPDATE shop.orders
+		SET status = 'issued', pickup_code_hash = NULL, issued_at = NOW(), issued_by = $1
+		WHERE id = $2 AND status = 'ready'
+		RETURNING ` + orderColumns
+
+	var order models.OrderDB
+	err := r.conn(ctx).GetContext(ctx, &order, query, issuedBy, orderUUID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.Order{}, errors.New(internalErrors.ErrWrongOrderStatus)
+		}
+		log.Logger.Err(err).Str("order_uuid", orderUUID.String()).Msg("method IssueOrder")
+		return api.Order{}, errors.New("could not issue order")
+	}
+
+	items, err := r.getOrderItems(ctx, []uuid.UUID{order.ID})
+	if err != nil {
+		return api.Order{}, err
+	}
+
+	return order.ToModelAPIOrder(items[order.ID]), nil
+}
+
+// IsProductInActiveOrder сообщает, сопоставлен ли товар с позицией еще не выданного заказа
+func (r *repository) IsProductInActiveOrder(ctx context.Context, productUUID uuid.UUID) (bool, error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1
+			FROM shop.order_items oi
+			JOIN shop.orders o ON o.id = oi.order_id
+			WHERE oi.product_id = $1 AND o.status <> 'issued'
+		)
+	`
+
+	var exists bool
+	err := r.conn(ctx).QueryRowContext(ctx, query, productUUID).Scan(&exists)
+	if err != nil {
+		log.Logger.Err(err).Str("product_uuid", productUUID.String()).Msg("method IsProductInActiveOrder")
+		return false, errors.New("could not check product order")
+	}
+
+	return exists, nil
+}
+
+func (r *repository) withOrderItems(ctx context.Context, orders []models.OrderDB) ([]api.Order, error) {
+	orderUUIDs := make([]uuid.UUID, 0, len(orders))
+	for _, order := range orders {
+		orderUUIDs = append(orderUUIDs, order.ID)
+	}
+	items, err := r.getOrderItems(ctx, orderUUIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]api.Order, 0, len(orders))
+	for _, order := range orders {
+		result = append(result, order.ToModelAPIOrder(items[order.ID]))
+	}
+
+	return result, nil
+}
+
+func (r *repository) getOrderItems(ctx context.Context, orderUUIDs []uuid.UUID) (map[uuid.UUID][]models.OrderItemDB, error) {
+	query := `
+		SELECT order_id, barcode, product_id
+		FROM shop.order_items
+		WHERE order_id = ANY($1)
+		ORDER BY barcode
+	`
+
+	var items []models.OrderItemDB
+	err := r.conn(ctx).SelectContext(ctx, &items, query, pq.Array(orderUUIDs))
+	if err != nil {
+		log.Logger.Err(err).Msg("method getOrderItems")
+		return nil, errors.New("could not get order items")
+	}
+
+	itemsByOrder := make(map[uuid.UUID][]models.OrderItemDB)
+	for _, item := range items {
+		itemsByOrder[item.OrderID] = append(itemsByOrder[item.OrderID], item)
+	}
+
+	return itemsByOrder, nil
+}
@@ -0,0 +1,252 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/devWaylander/pvz_store/api"
+	internalErrors "github.com/devWaylander/pvz_store/pkg/errors"
+	"github.com/devWaylander/pvz_store/pkg/log"
+	"github.com/devWaylander/pvz_store/pkg/models"
+	"github.com/google/uuid"
+)
+
+// UpdateProductStatus переводит товар из статуса From в статус To и сохраняет переход в историю.
+// Код выдачи и счетчик попыток сохраняются, пока товар на руках ПВЗ, и сбрасываются, когда товар покидает ПВЗ
+func (r *repository) UpdateProductStatus(ctx context.Context, transition models.ProductTransition) error {
+	query := `
+		UPDATE shop.products
+		SET status = $1,
+			pickup_code_hash = CASE WHEN $1 IN ('received', 'stored') THEN pickup_code_hash END,
+			pickup_attempts = CASE WHEN $1 IN ('received', 'stored') THEN pickup_attempts ELSE 0 END
+		WHERE id = $2 AND status = $3 AND deleted_at IS NULL AND voided_at IS NULL
+	`
+
+	return r.WithTx(ctx, func(ctx context.Context) error {
+		res, err := r.conn(ctx).ExecContext(ctx, query, transition.To, transition.ProductID, transition.From)
+		if err != nil {
+			log.Logger.Err(err).Str("product_id", transition.ProductID.String()).Msg("method UpdateProductStatus")
+			return errors.New("could not update product status")
+		}
+
+		affected, err := res.RowsAffected()
+		if err != nil {
+			log.Logger.Err(err).Str("product_id", transition.ProductID.String()).Msg("method UpdateProductStatus")
+			return errors.New("could not update product status")
+		}
+		// статус успели поменять параллельным запросом
+		if affected == 0 {
+			return errors.New(internalErrors.ErrProductTransition)
+		}
+
+		return r.insertProductStatusChange(ctx, transition)
+	})
+}
+
+func (r *repository) insertProductStatusChange(ctx context.Context, transition models.ProductTransition) error {
+	query := `
+		INSERT INTO shop.product_status_history (product_id, from_status, to_status, actor_id, actor_role, reason)
+		VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''))
+	`
+
+	_, err := r.conn(ctx).ExecContext(ctx, query,
+		transition.ProductID, transition.From, transition.To,
+		transition.ActorID, transition.ActorRole, transition.Reason,
+	)
+	if err != nil {
+		log.Logger.Err(err).Str("product_id", transition.ProductID.String()).Msg("method insertProductStatusChange")
+		return errors.New("could not save product status history")
+	}
+
+	return nil
+}
+
+// syncReceptionProductsStatus переводит товары приемки вслед за приемкой:
+// при закрытии принятые товары размещаются на хранение, при повторном открытии возвращаются в принятые.
+// Товары приемки поставки, перемещенные в другой ПВЗ, не затрагиваются, товары приемки перемещения
+// берутся из её перемещения. Выпущенный код выдачи при этом не меняется
+func (r *repository) syncReceptionProductsStatus(ctx context.Context, transition models.ReceptionTransition) error {
+	var from, to string
+	switch {
+	case transition.To == string(api.ReceptionStatusClosed):
+		from, to = string(api.ProductStatusReceived), string(api.ProductStatusStored)
+	case transition.From == string(api.ReceptionStatusClosed) && transition.To == string(api.ReceptionStatusInProgress):
+		from, to = string(api.ProductStatusStored), string(api.ProductStatusReceived)
+	default:
+		return nil
+	}
+
+	query := `
+		WITH updated AS (
+			UPDATE shop.products p
+			SET status = $3
+			FROM shop.receptions r
+			WHERE r.id = $1 AND p.current_pvz_id = r.pvz_id
+				AND (p.reception_id = r.id OR p.id IN (
+					SELECT ti.product_id
+					FROM shop.transfer_items ti
+					JOIN shop.transfers t ON t.id = ti.transfer_id
+					WHERE t.reception_id = r.id
+				))
+				AND p.status = $2 AND p.deleted_at IS NULL AND p.voided_at IS NULL
+			RETURNING p.id
+		)
+		INSERT INTO shop.product_status_history (product_id, from_status, to_status, actor_id, actor_role)
+		SELECT id, $2, $3, $4, $5 FROM updated
+	`
+
+	_, err := r.conn(ctx).ExecContext(ctx, query, transition.ReceptionID, from, to, transition.ActorID, transition.ActorRole)
+	if err != nil {
+		log.Logger.Err(err).Str("reception_id", transition.ReceptionID.String()).Msg("method syncReceptionProductsStatus")
+		return errors.New("could not update reception products status")
+	}
+
+	return nil
+}
+
+// GetProductPickupCodeHashForUpdate возвращает хеш кода выдачи товара и число неверных попыток,
+// блокируя товар до конца транзакции. У товара без выпущенного кода хеш пустой
+func (r *repository) GetProductPickupCodeHashForUpdate(ctx context.Context, productUUID uuid.UUID) (string, int, error) {
+	query := `
+		SELECT pickup_code_hash, pickup_attempts
+		FROM shop.products
+		WHERE id = $1 AND deleted_at IS NULL AND voided_at IS NULL
+		FOR UPDATE
+	`
+
+	var (
+		hash     sql.NullString
+		attempts int
+	)
+	err := r.conn(ctx).QueryRowContext(ctx, query, productUUID).Scan(&hash, &attempts)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", 0, nil
+		}
+		log.Logger.Err(err).Str("product_uuid", productUUID.String()).Msg("method GetProductPickupCodeHashForUpdate")
+		return "", 0, errors.New("could not get product pickup code")
+	}
+
+	return hash.String, attempts, nil
+}
+
+// SetProductPickupCode сохраняет хеш нового кода выдачи товара на хранении и сбрасывает счетчик попыток
+func (r *repository) SetProductPickupCode(ctx context.Context, productUUID uuid.UUID, codeHash string) error {
+	query := `
+		UPDATE shop.products
+		SET pickup_code_hash = $1, pickup_attempts = 0
+		WHERE id = $2 AND status = 'stored' AND deleted_at IS NULL AND voided_at IS NULL
+	`
+
+	res, err := r.conn(ctx).ExecContext(ctx, query, codeHash, productUUID)
+	if err != nil {
+		log.Logger.Err(err).Str("product_uuid", productUUID.String()).Msg("method SetProductPickupCode")
+		return errors.New("could not set product pickup code")
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		log.Logger.Err(err).Str("product_uuid", productUUID.String()).Msg("method SetProductPickupCode")
+		return errors.New("could not set product pickup code")
+	}
+	if affected == 0 {
+		return errors.New(internalErrors.ErrProductTransition)
+	}
+
+	return nil
+}
+
+// RecordProductPickupAttempt учитывает неверную попытку ввода кода выдачи товара
+func (r *repository) RecordProductPickupAttempt(ctx context.Context, productUUID uuid.UUID) error {
+	query := `
+		UPDATE shop.products
+		SET pickup_attempts = pickup_attempts + 1
+		WHERE id = $1
+	`
+
+	_, err := r.conn(ctx).ExecContext(ctx, query, productUUID)
+	if err != nil {
+		log.Logger.Err(err).Str("product_uuid", productUUID.String()).Msg("method RecordProductPickupAttempt")
+		return errors.New("could not record product pickup attempt")
+	}
+
+	return nil
+}
+
+// GetStockByPvzUUID возвращает страницу товаров на руках ПВЗ в порядке поступления и их общее количество
+func (r *repository) GetStockByPvzUUID(ctx context.Context, pvzUUID uuid.UUID, page, limit int) ([]api.Product, int, error) {
+	query := `
+		SELECT p.id, p.reception_id, p.type, p.created_at, p.voided_at, p.created_by, p.attributes, p.barcode, p.status, p.cell_id, p.weight, p.length, p.width, p.height,
+			COUNT(*) OVER () AS total
+		FROM shop.products p
+		WHERE p.current_pvz_id = $1 AND p.status IN ('received', 'stored') AND p.deleted_at IS NULL AND p.voided_at IS NULL
+		ORDER BY p.seq
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, pvzUUID, limit, (page-1)*limit)
+	if err != nil {
+		log.Logger.Err(err).Str("pvz_uuid", pvzUUID.String()).Msg("method GetStockByPvzUUID")
+		return nil, 0, errors.New("could not get pvz stock")
+	}
+	defer rows.Close()
+
+	products := []api.Product{}
+	total := 0
+	for rows.Next() {
+		var product models.ProductDB
+		if err := rows.Scan(
+			&product.ID, &product.ReceptionID, &product.Type, &product.CreatedAt, &product.VoidedAt, &product.CreatedBy,
+			&product.Attributes, &product.Barcode, &product.Status, &product.CellID, &product.Weight, &product.Length, &product.Width, &product.Height, &total,
+		); err != nil {
+			log.Logger.Err(err).Msg("method GetStockByPvzUUID")
+			return nil, 0, errors.New("could not scan product row")
+		}
+		products = append(products, product.ToModelAPIProduct())
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Logger.Err(err).Msg("method GetStockByPvzUUID")
+		return nil, 0, errors.New("error iterating product rows")
+	}
+
+	return products, total, nil
+}
+
+// GetStockCountsByPvzUUID возвращает количество товаров на руках ПВЗ по типам
+func (r *repository) GetStockCountsByPvzUUID(ctx context.Context, pvzUUID uuid.UUID) (map[string]int, error) {
+	query := `
+		SELECT p.type, COUNT(*)
+		FROM shop.products p
+		WHERE p.current_pvz_id = $1 AND p.status IN ('received', 'stored') AND p.deleted_at IS NULL AND p.voided_at IS NULL
+		GROUP BY p.type
+	`
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, pvzUUID)
+	if err != nil {
+		log.Logger.Err(err).Str("pvz_uuid", pvzUUID.String()).Msg("method GetStockCountsByPvzUUID")
+		return nil, errors.New("could not get pvz stock counts")
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var (
+			prType string
+			count  int
+		)
+		if err := rows.Scan(&prType, &count); err != nil {
+			log.Logger.Err(err).Msg("method GetStockCountsByPvzUUID")
+			return nil, errors.New("could not scan product count row")
+		}
+		counts[prType] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Logger.Err(err).Msg("method GetStockCountsByPvzUUID")
+		return nil, errors.New("error during rows iteration")
+	}
+
+	return counts, nil
+}
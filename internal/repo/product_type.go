@@ -0,0 +1,114 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"github.com/devWaylander/pvz_store/api"
+	internalErrors "github.com/devWaylander/pvz_store/pkg/errors"
+	"github.com/devWaylander/pvz_store/pkg/log"
+	"github.com/devWaylander/pvz_store/pkg/models"
+	"github.com/lib/pq"
+)
+
+/*
+Product type
+*/
+func (r *repository) GetProductTypes(ctx context.Context) ([]api.ProductType, error) {
+	query := `
+		SELECT name, attributes_schema, created_at
+		FROM shop.product_types
+		ORDER BY name
+	`
+
+	var types []models.ProductTypeDB
+	err := r.conn(ctx).SelectContext(ctx, &types, query)
+	if err != nil {
+		log.Logger.Err(err).Msg("method GetProductTypes")
+		return nil, errors.New("could not get product types")
+	}
+
+	result := make([]api.ProductType, 0, len(types))
+	for _, productType := range types {
+		result = append(result, productType.ToModelAPIProductType())
+	}
+
+	return result, nil
+}
+
+func (r *repository) GetProductTypeByName(ctx context.Context, name string) (api.ProductType, error) {
+	query := `
+		SELECT name, attributes_schema, created_at
+		FROM shop.product_types
+		WHERE name = $1
+	`
+
+	var productType models.ProductTypeDB
+	err := r.conn(ctx).GetContext(ctx, &productType, query, name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.ProductType{}, nil
+		}
+		log.Logger.Err(err).Str("name", name).Msg("method GetProductTypeByName")
+		return api.ProductType{}, errors.New("could not get product type by name")
+	}
+
+	return productType.ToModelAPIProductType(), nil
+}
+
+func (r *repository) CreateProductType(ctx context.Context, name string, attributesSchema map[string]interface{}) (api.ProductType, error) {
+	query := `
+		INSERT INTO shop.product_types (name, attributes_schema)
+		VALUES ($1, $2)
+		RETURNING name, attributes_schema, created_at
+	`
+
+	schema, err := json.Marshal(attributesSchema)
+	if err != nil {
+		log.Logger.Err(err).Str("name", name).Msg("method CreateProductType")
+		return api.ProductType{}, errors.New("could not marshal attributes schema")
+	}
+
+	var inserted models.ProductTypeDB
+	err = r.conn(ctx).GetContext(ctx, &inserted, query, name, schema)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" && pqErr.Constraint == "product_types_pkey" {
+			return api.ProductType{}, errors.New(internalErrors.ErrProductTypeExist)
+		}
+
+		log.Logger.Err(err).Str("name", name).Msg("method CreateProductType")
+		return api.ProductType{}, errors.New("could not create product type")
+	}
+
+	return inserted.ToModelAPIProductType(), nil
+}
+
+// UpdateProductTypeSchema меняет схему атрибутов типа, возвращает пустой тип, если он не найден
+func (r *repository) UpdateProductTypeSchema(ctx context.Context, name string, attributesSchema map[string]interface{}) (api.ProductType, error) {
+	query := `
+		UPDATE shop.product_types
+		SET attributes_schema = $2
+		WHERE name = $1
+		RETURNING name, attributes_schema, created_at
+	`
+
+	schema, err := json.Marshal(attributesSchema)
+	if err != nil {
+		log.Logger.Err(err).Str("name", name).Msg("method UpdateProductTypeSchema")
+		return api.ProductType{}, errors.New("could not marshal attributes schema")
+	}
+
+	var updated models.ProductTypeDB
+	err = r.conn(ctx).GetContext(ctx, &updated, query, name, schema)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.ProductType{}, nil
+		}
+		log.Logger.Err(err).Str("name", name).Msg("method UpdateProductTypeSchema")
+		return api.ProductType{}, errors.New("could not update product type schema")
+	}
+
+	return updated.ToModelAPIProductType(), nil
+}
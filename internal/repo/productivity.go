@@ -0,0 +1,142 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/devWaylander/pvz_store/api"
+	"github.com/devWaylander/pvz_store/pkg/log"
+	"github.com/devWaylander/pvz_store/pkg/models"
+)
+
+/*
+Productivity report
+*/
+// GetReceptionTimings возвращает закрытые приемки, последнее закрытие которых попало в [StartDate, EndDate),
+// с временем сканирования их товаров без удаленных. Переоткрытые и отмененные приемки не учитываются,
+// приемки перемещений тоже: их закрывает получение перемещения, а не сотрудник
+func (r *repository) GetReceptionTimings(ctx context.Context, filter models.ProductivityFilter) ([]models.ReceptionTiming, error) {
+	args := []any{filter.StartDate, filter.EndDate}
+	pvzCondition := ""
+	if filter.PvzID != nil {
+		args = append(args, *filter.PvzID)
+		pvzCondition = fmt.Sprintf(` AND r.pvz_id = $%d`, len(args))
+	}
+
+	// приемку могли закрыть в диапазоне и переоткрыть с повторным закрытием позже,
+	// поэтому берется последнее закрытие каждой приемки, закрывавшейся в диапазоне
+	query := `
+		WITH closed AS (
+			SELECT h.reception_id, MAX(h.created_at) AS closed_at
+			FROM shop.reception_status_history h
+			WHERE h.to_status = 'closed' AND h.reception_id IN (
+				SELECT reception_id
+				FROM shop.reception_status_history
+				WHERE to_status = 'closed' AND created_at >= $1 AND created_at < $2
+			)
+			GROUP BY h.reception_id
+		)
+		SELECT r.id, r.pvz_id, r.created_by, r.created_at, c.closed_at, p.created_at
+		FROM closed c
+		JOIN shop.receptions r ON r.id = c.reception_id
+		LEFT JOIN shop.products p ON p.reception_id = r.id AND p.deleted_at IS NULL
+		WHERE c.closed_at >= $1 AND c.closed_at < $2
+			AND r.status IN ('closed', 'verified')
+			AND r.type = 'supply'
+			AND r.created_at IS NOT NULL` + pvzCondition + `
+		ORDER BY r.id, p.seq
+	`
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		log.Logger.Err(err).Msg("method GetReceptionTimings")
+		return nil, errors.New("could not get reception timings")
+	}
+	defer rows.Close()
+
+	var timings []models.ReceptionTiming
+	for rows.Next() {
+		var (
+			timing   models.ReceptionTiming
+			scanTime sql.NullTime
+		)
+		if err := rows.Scan(&timing.ReceptionID, &timing.PvzID, &timing.EmployeeID, &timing.OpenedAt, &timing.ClosedAt, &scanTime); err != nil {
+			log.Logger.Err(err).Msg("method GetReceptionTimings")
+			return nil, errors.New("could not scan reception timing row")
+		}
+
+		// строки одной приемки идут подряд, товары собираются в ее ScanTimes
+		last := len(timings) - 1
+		if last < 0 || timings[last].ReceptionID != timing.ReceptionID {
+			timings = append(timings, timing)
+			last++
+		}
+		if scanTime.Valid {
+			timings[last].ScanTimes = append(timings[last].ScanTimes, scanTime.Time)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Logger.Err(err).Msg("method GetReceptionTimings")
+		return nil, errors.New("error during rows iteration")
+	}
+
+	return timings, nil
+}
+
+// SaveProductivityReport сохраняет ежедневный отчет, отчет за тот же день перезаписывается
+func (r *repository) SaveProductivityReport(ctx context.Context, day time.Time, report api.ProductivityReport) error {
+	query := `
+		INSERT INTO shop.productivity_reports (report_date, start_date, end_date, sla_minutes, idle_gap_minutes, report_rows, generated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (report_date) DO UPDATE
+		SET start_date = EXCLUDED.start_date,
+			end_date = EXCLUDED.end_date,
+			sla_minutes = EXCLUDED.sla_minutes,
+			idle_gap_minutes = EXCLUDED.idle_gap_minutes,
+			report_rows = EXCLUDED.report_rows,
+			generated_at = EXCLUDED.generated_at
+	`
+
+	reportRows, err := json.Marshal(report.Rows)
+	if err != nil {
+		log.Logger.Err(err).Msg("method SaveProductivityReport, Marshal")
+		return errors.New("could not encode productivity report rows")
+	}
+
+	_, err = r.conn(ctx).ExecContext(ctx, query,
+		day.Format(time.DateOnly), report.StartDate, report.EndDate,
+		report.SlaMinutes, report.IdleGapMinutes, reportRows, report.GeneratedAt,
+	)
+	if err != nil {
+		log.Logger.Err(err).Str("report_date", day.Format(time.DateOnly)).Msg("method SaveProductivityReport")
+		return errors.New("could not save productivity report")
+	}
+
+	return nil
+}
+
+// GetProductivityReportByDate возвращает сохраненный ежедневный отчет, если отчета нет - пустой отчет
+func (r *repository) GetProductivityReportByDate(ctx context.Context, day time.Time) (api.ProductivityReport, error) {
+	query := `
+		SELECT report_date, start_date, end_date, sla_minutes, idle_gap_minutes, report_rows, generated_at
+		FROM shop.productivity_reports
+		WHERE report_date = $1
+	`
+
+	var report models.ProductivityReportDB
+	err := r.conn(ctx).GetContext(ctx, &report, query, day.Format(time.DateOnly))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.ProductivityReport{}, nil
+		}
+		log.Logger.Err(err).Str("report_date", day.Format(time.DateOnly)).Msg("method GetProductivityReportByDate")
+		return api.ProductivityReport{}, errors.New("could not get productivity report")
+	}
+
+	return report.ToModelAPIProductivityReport(), nil
+}
@@ -0,0 +1,170 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"github.com/devWaylander/pvz_store/pkg/log"
+	"github.com/devWaylander/pvz_store/pkg/models"
+)
+
+// aggTimestamp формат меток времени внутри json_agg. Колонки TIMESTAMP хранятся без пояса
+// и читаются драйвером как UTC, поэтому в JSON они отдаются в RFC3339 с суффиксом Z
+const aggTimestamp = `'YYYY-MM-DD"T"HH24:MI:SS.US"Z"'`
+
+/*
+PVZ info
+*/
+// pvzInfoProductsJoin боковой подзапрос товаров приемки r, собранных в JSON-массив products
+const pvzInfoProductsJoin = `
+			LEFT JOIN LATERAL (
+				SELECT json_agg(
+					json_build_object(
+						'id', p.id,
+						'reception_id', p.reception_id,
+						'type', p.type,
+						'created_at', to_char(p.created_at, ` + aggTimestamp + `),
+						'voided_at', to_char(p.voided_at, ` + aggTimestamp + `),
+						'created_by', p.created_by,
+						'attributes', p.attributes,
+						'barcode', p.barcode,
+						'status', p.status,
+						'cell_id', p.cell_id,
+						'weight', p.weight,
+						'length', p.length,
+						'width', p.width,
+						'height', p.height
+					)
+					ORDER BY p.seq
+				) AS products
+				FROM shop.products p
+				WHERE p.reception_id = r.id AND p.deleted_at IS NULL
+			) prod ON TRUE`
+
+// GetPVZsInfo одним запросом возвращает страницу ПВЗ с приемками за период, а при filter.WithProducts
+// и с их товарами без удаленных. Приемки и товары собираются в JSON через json_agg в боковых подзапросах,
+// порядок совпадает с GetPVZsWithPagination, GetReceptionsByPvzUUIDsFiltered и GetProductsByRecsUUIDs.
+// Отправки возвратов не заполняются
+func (r *repository) GetPVZsInfo(ctx context.Context, filter models.PvzInfoFilter) ([]models.PvzInfo, error) {
+	query, args := pvzInfoQuery(filter)
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		log.Logger.Err(err).Msg("method GetPVZsInfo")
+		return nil, errors.New("could not get pvzs info")
+	}
+	defer rows.Close()
+
+	var result []models.PvzInfo
+	for rows.Next() {
+		info, err := scanPvzInfo(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, info)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Logger.Err(err).Msg("method GetPVZsInfo")
+		return nil, errors.New("error during rows iteration")
+	}
+
+	return result, nil
+}
+
+// StreamPVZsInfo читает ту же страницу, что и GetPVZsInfo, серверным курсором и передает ПВЗ в fn по одному,
+// поэтому в памяти одновременно находится не больше одной пачки строк курсора
+func (r *repository) StreamPVZsInfo(ctx context.Context, filter models.PvzInfoFilter, fn func(info models.PvzInfo) error) error {
+	query, args := pvzInfoQuery(filter)
+
+	return r.streamCursor(ctx, "pvz_info", query, args, func(rows *sql.Rows) error {
+		info, err := scanPvzInfo(rows)
+		if err != nil {
+			return err
+		}
+
+		return fn(info)
+	})
+}
+
+// pvzInfoQuery собирает запрос страницы ПВЗ и его аргументы. Без filter.WithReceptions приемки не читаются
+func pvzInfoQuery(filter models.PvzInfoFilter) (string, []any) {
+	offset := (filter.Page - 1) * filter.Limit
+
+	productsJoin, productsColumn := "", ""
+	if filter.WithProducts {
+		productsJoin = pvzInfoProductsJoin
+		productsColumn = `,
+					'products', prod.products`
+	}
+
+	args := []any{filter.Limit, offset}
+	receptionsColumn, receptionsJoin := "NULL::json", ""
+	if filter.WithReceptions {
+		args = append(args, filter.StartDate, filter.EndDate)
+		receptionsColumn = "rec.receptions"
+		receptionsJoin = `
+		LEFT JOIN LATERAL (
+			SELECT json_agg(
+				json_build_object(
+					'reception', json_build_object(
+						'id', r.id,
+						'pvz_id', r.pvz_id,
+						'status', r.status,
+						'type', r.type,
+						'created_at', to_char(r.created_at, ` + aggTimestamp + `),
+						'stale_at', to_char(r.stale_at, ` + aggTimestamp + `),
+						'created_by', r.created_by,
+						'closed_by', r.closed_by
+					)` + productsColumn + `
+				)
+				ORDER BY r.created_at DESC
+			) AS receptions
+			FROM shop.receptions r` + productsJoin + `
+			WHERE r.pvz_id = pv.id
+				AND ($3::timestamp IS NULL OR r.created_at >= $3::timestamp)
+				AND ($4::timestamp IS NULL OR r.created_at <= $4::timestamp)
+		) rec ON TRUE`
+	}
+
+	query := `
+		SELECT pv.id, pv.city, pv.registration_date, ` + receptionsColumn + `
+		FROM (
+			SELECT id, city, registration_date
+			FROM shop.pvz
+			ORDER BY id
+			LIMIT $1 OFFSET $2
+		) pv` + receptionsJoin + `
+		ORDER BY pv.id
+	`
+
+	return query, args
+}
+
+// scanPvzInfo читает строку ПВЗ и раскладывает JSON-массив её приемок
+func scanPvzInfo(rows *sql.Rows) (models.PvzInfo, error) {
+	var (
+		pvz        models.PvzDB
+		receptions []byte
+	)
+	if err := rows.Scan(&pvz.ID, &pvz.City, &pvz.RegistrationDate, &receptions); err != nil {
+		log.Logger.Err(err).Msg("method scanPvzInfo")
+		return models.PvzInfo{}, errors.New("could not scan pvz info row")
+	}
+
+	info := models.PvzInfo{Pvz: pvz.ToModelAPIPvz()}
+	if receptions != nil {
+		var aggs []models.ReceptionWithProductsAggDB
+		if err := json.Unmarshal(receptions, &aggs); err != nil {
+			log.Logger.Err(err).Msg("method scanPvzInfo")
+			return models.PvzInfo{}, errors.New("could not decode pvz receptions")
+		}
+		for _, agg := range aggs {
+			info.Receptions = append(info.Receptions, agg.ToModelReceptionWithProducts())
+		}
+	}
+
+	return info, nil
+}
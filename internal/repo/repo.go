@@ -3,7 +3,9 @@ package repo
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/devWaylander/pvz_store/api"
@@ -34,7 +36,7 @@ func (r *repository) CreatePVZ(ctx context.Context, id uuid.UUID, city string, r
 	`
 
 	var inserted models.PvzDB
-	err := r.db.QueryRowContext(ctx, query, id, city, registrationDate).
+	err := r.conn(ctx).QueryRowContext(ctx, query, id, city, registrationDate).
 		Scan(&inserted.ID, &inserted.City, &inserted.RegistrationDate)
 
 	if err != nil {
@@ -55,7 +57,7 @@ func (r *repository) IsPVZExist(ctx context.Context, id uuid.UUID) (bool, error)
     `
 
 	var exists bool
-	err := r.db.QueryRowContext(ctx, query, id).Scan(&exists)
+	err := r.conn(ctx).QueryRowContext(ctx, query, id).Scan(&exists)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return false, nil
@@ -68,13 +70,34 @@ func (r *repository) IsPVZExist(ctx context.Context, id uuid.UUID) (bool, error)
 	return true, nil
 }
 
+// LockPVZ блокирует строку ПВЗ до конца транзакции, сериализуя открытие приемок в нём.
+// Возвращает false, если ПВЗ не существует
+func (r *repository) LockPVZ(ctx context.Context, id uuid.UUID) (bool, error) {
+	query := `
+		SELECT 1 FROM shop.pvz WHERE id = $1 FOR UPDATE
+	`
+
+	var exists bool
+	err := r.conn(ctx).QueryRowContext(ctx, query, id).Scan(&exists)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+
+		log.Logger.Err(err).Msg("method LockPVZ")
+		return false, errors.New("could not lock PVZ")
+	}
+
+	return true, nil
+}
+
 func (r *repository) GetPVZs(ctx context.Context) ([]api.PVZ, error) {
 	query := `
 		SELECT id, city, registration_date
 		FROM shop.pvz
 	`
 
-	rows, err := r.db.QueryContext(ctx, query)
+	rows, err := r.conn(ctx).QueryContext(ctx, query)
 	if err != nil {
 		log.Logger.Err(err).Msg("method GetPVZs")
 		return nil, errors.New("could not get pvzs")
@@ -105,10 +128,11 @@ func (r *repository) GetPVZsWithPagination(ctx context.Context, page, limit int)
 	query := `
 		SELECT id, city, registration_date
 		FROM shop.pvz
+		ORDER BY id
 		LIMIT $1 OFFSET $2
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, limit, offset)
+	rows, err := r.conn(ctx).QueryContext(ctx, query, limit, offset)
 	if err != nil {
 		log.Logger.Err(err).Msg("method GetPVZs")
 		return nil, errors.New("could not get pvzs")
@@ -136,37 +160,93 @@ func (r *repository) GetPVZsWithPagination(ctx context.Context, page, limit int)
 /*
 Reception
 */
-func (r *repository) CreateReception(ctx context.Context, pvzUUID uuid.UUID, status string) (api.Reception, error) {
+func (r *repository) CreateReception(ctx context.Context, pvzUUID uuid.UUID, status string, actor models.AuthPrincipal) (api.Reception, error) {
 	query := `
-		INSERT INTO shop.receptions (pvz_id, status)
-		VALUES ($1, $2)
-		RETURNING id, pvz_id, created_at, status
+		INSERT INTO shop.receptions (pvz_id, status, created_by)
+		VALUES ($1, $2, $3)
+		RETURNING id, pvz_id, created_at, status, type, created_by
 	`
 
 	var inserted models.ReceptionDB
-	err := r.db.QueryRowContext(ctx, query, pvzUUID, status).
-		Scan(&inserted.ID, &inserted.PvzID, &inserted.CreatedAt, &inserted.Status)
+	err := r.WithTx(ctx, func(ctx context.Context) error {
+		err := r.conn(ctx).QueryRowContext(ctx, query, pvzUUID, status, actor.UserUUID).
+			Scan(&inserted.ID, &inserted.PvzID, &inserted.CreatedAt, &inserted.Status, &inserted.Type, &inserted.CreatedBy)
+		if err != nil {
+			if isOpenReceptionViolation(err) {
+				return errors.New(internalErrors.ErrReceptionExist)
+			}
 
+			log.Logger.Err(err).Msg("method CreateReception")
+			return errors.New("could not create reception")
+		}
+
+		return r.insertReceptionStatusChange(ctx, models.ReceptionTransition{
+			ReceptionID: inserted.ID,
+			To:          status,
+			ActorID:     actor.UserUUID,
+			ActorRole:   actor.Role,
+		})
+	})
 	if err != nil {
-		log.Logger.Err(err).Msg("method CreateReception")
-		return api.Reception{}, errors.New("could not create reception")
+		return api.Reception{}, err
 	}
 
 	return inserted.ToModelAPIReception(), nil
 }
 
+func (r *repository) GetReceptionByUUID(ctx context.Context, recUUID uuid.UUID) (api.Reception, error) {
+	return r.getReceptionByUUID(ctx, recUUID, "")
+}
+
+// GetReceptionByUUIDForUpdate возвращает приемку, блокируя её до конца транзакции
+func (r *repository) GetReceptionByUUIDForUpdate(ctx context.Context, recUUID uuid.UUID) (api.Reception, error) {
+	return r.getReceptionByUUID(ctx, recUUID, "FOR UPDATE")
+}
+
+func (r *repository) getReceptionByUUID(ctx context.Context, recUUID uuid.UUID, lock string) (api.Reception, error) {
+	query := `
+		SELECT id, pvz_id, status, type, created_at, stale_at, created_by, closed_by
+		FROM shop.receptions
+		WHERE id = $1
+		` + lock
+
+	var reception models.ReceptionDB
+	err := r.conn(ctx).QueryRowContext(ctx, query, recUUID).
+		Scan(&reception.ID, &reception.PvzID, &reception.Status, &reception.Type, &reception.CreatedAt, &reception.StaleAt, &reception.CreatedBy, &reception.ClosedBy)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.Reception{}, nil
+		}
+		log.Logger.Err(err).Str("reception_uuid", recUUID.String()).Msg("method GetReceptionByUUID")
+		return api.Reception{}, errors.New("could not get reception by uuid")
+	}
+
+	return reception.ToModelAPIReception(), nil
+}
+
+// GetReceptionByPvzUUID возвращает последнюю приемку поставки ПВЗ, приемки перемещений не учитываются
 func (r *repository) GetReceptionByPvzUUID(ctx context.Context, pvzUUID uuid.UUID) (api.Reception, error) {
+	return r.getReceptionByPvzUUID(ctx, pvzUUID, "")
+}
+
+// GetReceptionByPvzUUIDForUpdate возвращает последнюю приемку поставки ПВЗ, блокируя её до конца транзакции
+func (r *repository) GetReceptionByPvzUUIDForUpdate(ctx context.Context, pvzUUID uuid.UUID) (api.Reception, error) {
+	return r.getReceptionByPvzUUID(ctx, pvzUUID, "FOR UPDATE")
+}
+
+func (r *repository) getReceptionByPvzUUID(ctx context.Context, pvzUUID uuid.UUID, lock string) (api.Reception, error) {
 	query := `
-		SELECT id, pvz_id, status, created_at
+		SELECT id, pvz_id, status, type, created_at, stale_at, created_by, closed_by
 		FROM shop.receptions
-		WHERE pvz_id = $1
+		WHERE pvz_id = $1 AND type = 'supply'
 		ORDER BY created_at DESC
 		LIMIT 1
-	`
+		` + lock
 
 	var reception models.ReceptionDB
-	err := r.db.QueryRowContext(ctx, query, pvzUUID).
-		Scan(&reception.ID, &reception.PvzID, &reception.Status, &reception.CreatedAt)
+	err := r.conn(ctx).QueryRowContext(ctx, query, pvzUUID).
+		Scan(&reception.ID, &reception.PvzID, &reception.Status, &reception.Type, &reception.CreatedAt, &reception.StaleAt, &reception.CreatedBy, &reception.ClosedBy)
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -181,7 +261,7 @@ func (r *repository) GetReceptionByPvzUUID(ctx context.Context, pvzUUID uuid.UUI
 
 func (r *repository) GetReceptionsByPvzUUIDsFiltered(ctx context.Context, pvzUUIDs []uuid.UUID, startDate, endDate *time.Time) ([]api.Reception, error) {
 	query := `
-		SELECT r.id, r.pvz_id, r.status, r.created_at
+		SELECT r.id, r.pvz_id, r.status, r.type, r.created_at, r.stale_at, r.created_by, r.closed_by
 		FROM shop.receptions r
 		WHERE r.pvz_id = ANY($1)
 	`
@@ -190,17 +270,17 @@ func (r *repository) GetReceptionsByPvzUUIDsFiltered(ctx context.Context, pvzUUI
 	args = append(args, pq.Array(pvzUUIDs))
 
 	if startDate != nil {
-		query += ` AND r.created_at >= $2`
 		args = append(args, *startDate)
+		query += fmt.Sprintf(` AND r.created_at >= $%d`, len(args))
 	}
 	if endDate != nil {
-		query += ` AND r.created_at <= $3`
 		args = append(args, *endDate)
+		query += fmt.Sprintf(` AND r.created_at <= $%d`, len(args))
 	}
 
 	query += ` ORDER BY r.created_at DESC`
 
-	rows, err := r.db.QueryContext(ctx, query, args...)
+	rows, err := r.conn(ctx).QueryContext(ctx, query, args...)
 	if err != nil {
 		log.Logger.Err(err).Msg("method GetReceptionsByPvzUUIDsFiltered")
 		return nil, errors.New("could not get receptions by pvz uuids")
@@ -210,7 +290,7 @@ func (r *repository) GetReceptionsByPvzUUIDsFiltered(ctx context.Context, pvzUUI
 	var receptions []api.Reception
 	for rows.Next() {
 		var reception models.ReceptionDB
-		if err := rows.Scan(&reception.ID, &reception.PvzID, &reception.Status, &reception.CreatedAt); err != nil {
+		if err := rows.Scan(&reception.ID, &reception.PvzID, &reception.Status, &reception.Type, &reception.CreatedAt, &reception.StaleAt, &reception.CreatedBy, &reception.ClosedBy); err != nil {
 			log.Logger.Err(err).Msg("method GetReceptionsByPvzUUIDsFiltered")
 			return nil, errors.New("could not scan reception row")
 		}
@@ -229,13 +309,13 @@ func (r *repository) GetReceptionStatusByPvzUUID(ctx context.Context, pvzUUID uu
 	query := `
 		SELECT status
 		FROM shop.receptions
-		WHERE pvz_id = $1
+		WHERE pvz_id = $1 AND type = 'supply'
 		ORDER BY created_at DESC
 		LIMIT 1
 	`
 
 	var status string
-	err := r.db.QueryRowContext(ctx, query, pvzUUID).Scan(&status)
+	err := r.conn(ctx).QueryRowContext(ctx, query, pvzUUID).Scan(&status)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return "", nil
@@ -248,42 +328,319 @@ func (r *repository) GetReceptionStatusByPvzUUID(ctx context.Context, pvzUUID uu
 	return status, nil
 }
 
-func (r *repository) UpdateReceptionStatus(ctx context.Context, recUUID uuid.UUID, status string) error {
+// GetReceptionsFiltered возвращает страницу приемок, отфильтрованных по ПВЗ, статусу, автору и дате создания
+func (r *repository) GetReceptionsFiltered(ctx context.Context, filter models.ReceptionFilter) ([]api.Reception, error) {
+	query := `
+		SELECT r.id, r.pvz_id, r.status, r.type, r.created_at, r.stale_at, r.created_by, r.closed_by
+		FROM shop.receptions r
+		WHERE TRUE
+	`
+
+	conditions, args := receptionFilterConditions(filter)
+	query += conditions
+
+	args = append(args, filter.Limit, (filter.Page-1)*filter.Limit)
+	query += fmt.Sprintf(` ORDER BY r.created_at DESC, r.id LIMIT $%d OFFSET $%d`, len(args)-1, len(args))
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		log.Logger.Err(err).Msg("method GetReceptionsFiltered")
+		return nil, errors.New("could not get receptions")
+	}
+	defer rows.Close()
+
+	receptions := make([]api.Reception, 0, filter.Limit)
+	for rows.Next() {
+		var reception models.ReceptionDB
+		if err := rows.Scan(&reception.ID, &reception.PvzID, &reception.Status, &reception.Type, &reception.CreatedAt, &reception.StaleAt, &reception.CreatedBy, &reception.ClosedBy); err != nil {
+			log.Logger.Err(err).Msg("method GetReceptionsFiltered")
+			return nil, errors.New("could not scan reception row")
+		}
+		receptions = append(receptions, reception.ToModelAPIReception())
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Logger.Err(err).Msg("method GetReceptionsFiltered")
+		return nil, errors.New("error during rows iteration")
+	}
+
+	return receptions, nil
+}
+
+// receptionFilterConditions собирает условия фильтра приемок r по ПВЗ, статусу, автору и дате создания
+func receptionFilterConditions(filter models.ReceptionFilter) (string, []any) {
+	var conditions string
+	var args []any
+	if filter.PvzID != nil {
+		args = append(args, *filter.PvzID)
+		conditions += fmt.Sprintf(` AND r.pvz_id = $%d`, len(args))
+	}
+	if filter.Status != nil {
+		args = append(args, *filter.Status)
+		conditions += fmt.Sprintf(` AND r.status = $%d`, len(args))
+	}
+	if filter.CreatedBy != nil {
+		args = append(args, *filter.CreatedBy)
+		conditions += fmt.Sprintf(` AND r.created_by = $%d`, len(args))
+	}
+	if filter.StartDate != nil {
+		args = append(args, *filter.StartDate)
+		conditions += fmt.Sprintf(` AND r.created_at >= $%d`, len(args))
+	}
+	if filter.EndDate != nil {
+		args = append(args, *filter.EndDate)
+		conditions += fmt.Sprintf(` AND r.created_at <= $%d`, len(args))
+	}
+
+	return conditions, args
+}
+
+// GetStaleReceptions возвращает приемки в работе без активности (создание, товары, смена статуса) с момента idleSince
+func (r *repository) GetStaleReceptions(ctx context.Context, idleSince time.Time) ([]api.Reception, error) {
+	query := `
+		SELECT r.id, r.pvz_id, r.status, r.type, r.created_at, r.stale_at, r.created_by, r.closed_by
+		FROM shop.receptions r
+		WHERE r.status = 'in_progress'
+			AND r.created_at < $1
+			AND NOT EXISTS (
+				SELECT 1 FROM shop.products p
+				WHERE p.reception_id = r.id AND p.created_at >= $1
+			)
+			AND NOT EXISTS (
+				SELECT 1 FROM shop.reception_status_history h
+				WHERE h.reception_id = r.id AND h.created_at >= $1
+			)
+		ORDER BY r.created_at
+	`
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, idleSince)
+	if err != nil {
+		log.Logger.Err(err).Msg("method GetStaleReceptions")
+		return nil, errors.New("could not get stale receptions")
+	}
+	defer rows.Close()
+
+	var receptions []api.Reception
+	for rows.Next() {
+		var reception models.ReceptionDB
+		if err := rows.Scan(&reception.ID, &reception.PvzID, &reception.Status, &reception.Type, &reception.CreatedAt, &reception.StaleAt, &reception.CreatedBy, &reception.ClosedBy); err != nil {
+			log.Logger.Err(err).Msg("method GetStaleReceptions")
+			return nil, errors.New("could not scan reception row")
+		}
+		receptions = append(receptions, reception.ToModelAPIReception())
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Logger.Err(err).Msg("method GetStaleReceptions")
+		return nil, errors.New("error during rows iteration")
+	}
+
+	return receptions, nil
+}
+
+func (r *repository) MarkReceptionStale(ctx context.Context, recUUID uuid.UUID) error {
 	query := `
 		UPDATE shop.receptions
-		SET status = $1
-		WHERE id = $2
+		SET stale_at = NOW()
+		WHERE id = $1 AND stale_at IS NULL
+	`
+
+	_, err := r.conn(ctx).ExecContext(ctx, query, recUUID)
+	if err != nil {
+		log.Logger.Err(err).Str("reception_id", recUUID.String()).Msg("method MarkReceptionStale")
+		return errors.New("could not mark reception as stale")
+	}
+
+	return nil
+}
+
+// UpdateReceptionStatus переводит приемку в новый статус и записывает переход в историю.
+// Статус меняется только если приемка всё ещё находится в статусе transition.From
+func (r *repository) UpdateReceptionStatus(ctx context.Context, transition models.ReceptionTransition) error {
+	return r.WithTx(ctx, func(ctx context.Context) error {
+		return r.updateReceptionStatus(ctx, transition)
+	})
+}
+
+// CancelReception переводит приемку в статус отмены и аннулирует все её товары в одной транзакции
+func (r *repository) CancelReception(ctx context.Context, transition models.ReceptionTransition) error {
+	query := `
+		UPDATE shop.products
+		SET voided_at = NOW()
+		WHERE reception_id = $1 AND voided_at IS NULL AND deleted_at IS NULL
 	`
 
-	_, err := r.db.ExecContext(ctx, query, status, recUUID)
+	return r.WithTx(ctx, func(ctx context.Context) error {
+		err := r.updateReceptionStatus(ctx, transition)
+		if err != nil {
+			return err
+		}
+
+		_, err = r.conn(ctx).ExecContext(ctx, query, transition.ReceptionID)
+		if err != nil {
+			log.Logger.Err(err).Str("reception_id", transition.ReceptionID.String()).Msg("method CancelReception")
+			return errors.New("could not void reception products")
+		}
+
+		return nil
+	})
+}
+
+func (r *repository) GetReceptionStatusHistory(ctx context.Context, recUUID uuid.UUID) ([]api.ReceptionStatusChange, error) {
+	query := `
+		SELECT id, reception_id, from_status, to_status, actor_id, actor_role, reason, created_at
+		FROM shop.reception_status_history
+		WHERE reception_id = $1
+		ORDER BY created_at, id
+	`
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, recUUID)
 	if err != nil {
+		log.Logger.Err(err).Msg("method GetReceptionStatusHistory")
+		return nil, errors.New("could not get reception status history")
+	}
+	defer rows.Close()
+
+	history := []api.ReceptionStatusChange{}
+	for rows.Next() {
+		var change models.ReceptionStatusChangeDB
+		if err := rows.Scan(
+			&change.ID, &change.ReceptionID, &change.FromStatus, &change.ToStatus,
+			&change.ActorID, &change.ActorRole, &change.Reason, &change.CreatedAt,
+		); err != nil {
+			log.Logger.Err(err).Msg("method GetReceptionStatusHistory")
+			return nil, errors.New("could not scan reception status history row")
+		}
+		history = append(history, change.ToModelAPIReceptionStatusChange())
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Logger.Err(err).Msg("method GetReceptionStatusHistory")
+		return nil, errors.New("error during rows iteration")
+	}
+
+	return history, nil
+}
+
+func (r *repository) updateReceptionStatus(ctx context.Context, transition models.ReceptionTransition) error {
+	query := `
+		UPDATE shop.receptions
+		SET status = $1,
+			stale_at = NULL,
+			closed_by = CASE
+				WHEN $1 = 'closed' THEN $4
+				WHEN $1 IN ('draft', 'in_progress') THEN NULL
+				ELSE closed_by
+			END
+		WHERE id = $2 AND status = $3
+	`
+
+	res, err := r.conn(ctx).ExecContext(ctx, query, transition.To, transition.ReceptionID, transition.From, transition.ActorID)
+	if err != nil {
+		// повторное открытие при уже существующей открытой приемке
+		if isOpenReceptionViolation(err) {
+			return errors.New(internalErrors.ErrReceptionExist)
+		}
+
 		log.Logger.Err(err).
-			Str("method", "UpdateReceptionStatus").
-			Str("status", status).
-			Str("reception_id", recUUID.String()).
+			Str("method", "updateReceptionStatus").
+			Str("status", transition.To).
+			Str("reception_id", transition.ReceptionID.String()).
 			Msg("could not update reception status")
 
 		return errors.New("could not update reception status")
 	}
 
+	affected, err := res.RowsAffected()
+	if err != nil {
+		log.Logger.Err(err).
+			Str("method", "updateReceptionStatus").
+			Str("reception_id", transition.ReceptionID.String()).
+			Msg("could not get rows affected")
+		return errors.New("could not update reception status")
+	}
+	// статус успели поменять параллельным запросом
+	if affected == 0 {
+		return errors.New(internalErrors.ErrWrongReceptionStatus)
+	}
+
+	if err := r.syncReceptionProductsStatus(ctx, transition); err != nil {
+		return err
+	}
+
+	// приемка попала в суточные сводки или выпала из них
+	if isSummaryStatus(transition.From) || isSummaryStatus(transition.To) {
+		if err := r.refreshReceptionSummaries(ctx, transition.ReceptionID); err != nil {
+			return err
+		}
+	}
+
+	return r.insertReceptionStatusChange(ctx, transition)
+}
+
+func (r *repository) insertReceptionStatusChange(ctx context.Context, transition models.ReceptionTransition) error {
+	query := `
+		INSERT INTO shop.reception_status_history (reception_id, from_status, to_status, actor_id, actor_role, reason)
+		VALUES ($1, NULLIF($2, ''), $3, $4, $5, NULLIF($6, ''))
+	`
+
+	_, err := r.conn(ctx).ExecContext(ctx, query,
+		transition.ReceptionID, transition.From, transition.To,
+		transition.ActorID, transition.ActorRole, transition.Reason,
+	)
+	if err != nil {
+		log.Logger.Err(err).
+			Str("method", "insertReceptionStatusChange").
+			Str("reception_id", transition.ReceptionID.String()).
+			Msg("could not insert reception status change")
+		return errors.New("could not save reception status history")
+	}
+
 	return nil
 }
 
 /*
 Product
 */
-func (r *repository) CreateProduct(ctx context.Context, receptionUUID uuid.UUID, prType string) (api.Product, error) {
+func (r *repository) CreateProduct(
+	ctx context.Context,
+	receptionUUID uuid.UUID,
+	prType string,
+	attributes api.ProductAttributes,
+	barcode *string,
+	weight *int,
+	dimensions *api.ProductDimensions,
+	createdBy uuid.UUID,
+) (api.Product, error) {
 	query := `
-		INSERT INTO shop.products (reception_id, type)
-		VALUES ($1, $2)
-		RETURNING id, reception_id, type, created_at
+		INSERT INTO shop.products (reception_id, current_pvz_id, type, attributes, barcode, created_by, weight, length, width, height)
+		VALUES ($1, (SELECT pvz_id FROM shop.receptions WHERE id = $1), $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id, reception_id, type, created_at, voided_at, created_by, attributes, barcode, status, cell_id, weight, length, width, height
 	`
 
+	attrs, err := json.Marshal(attributes)
+	if err != nil {
+		log.Logger.Err(err).Str("reception_uuid", receptionUUID.String()).Msg("method CreateProduct")
+		return api.Product{}, errors.New("could not marshal product attributes")
+	}
+
+	var length, width, height *int
+	if dimensions != nil {
+		length, width, height = &dimensions.Length, &dimensions.Width, &dimensions.Height
+	}
+
 	var inserted models.ProductDB
-	err := r.db.QueryRowContext(ctx, query, receptionUUID, prType).
-		Scan(&inserted.ID, &inserted.ReceptionID, &inserted.Type, &inserted.CreatedAt)
+	err = r.conn(ctx).QueryRowContext(ctx, query, receptionUUID, prType, attrs, barcode, createdBy, weight, length, width, height).
+		Scan(&inserted.ID, &inserted.ReceptionID, &inserted.Type, &inserted.CreatedAt, &inserted.VoidedAt, &inserted.CreatedBy, &inserted.Attributes, &inserted.Barcode, &inserted.Status, &inserted.CellID, &inserted.Weight, &inserted.Length, &inserted.Width, &inserted.Height)
 
 	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23503" && pqErr.Constraint == "products_type_fkey" {
+			return api.Product{}, errors.New(internalErrors.ErrProductTypeDoesntExist)
+		}
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" && pqErr.Constraint == "products_barcode_in_stock_key" {
+			return api.Product{}, errors.New(internalErrors.ErrProductBarcodeExist)
+		}
+
 		log.Logger.Err(err).Str("reception_uuid", receptionUUID.String()).Str("type", prType).Msg("method CreateProduct")
 		return api.Product{}, errors.New("could not create product")
 	}
@@ -291,14 +648,143 @@ func (r *repository) CreateProduct(ctx context.Context, receptionUUID uuid.UUID,
 	return inserted.ToModelAPIProduct(), nil
 }
 
+// CreateProducts добавляет товары в приемку одним запросом, товары возвращаются в порядке items.
+// seq выдается в порядке items, поэтому удаление по LIFO сохраняет порядок сканирования
+func (r *repository) CreateProducts(
+	ctx context.Context,
+	receptionUUID uuid.UUID,
+	items []api.ProductBatchItem,
+	createdBy uuid.UUID,
+) ([]api.Product, error) {
+	query := `
+		INSERT INTO shop.products (id, reception_id, current_pvz_id, type, attributes, barcode, created_by, weight, length, width, height)
+		SELECT t.id, $1, (SELECT pvz_id FROM shop.receptions WHERE id = $1), t.type, t.attributes::jsonb, t.barcode, $6, t.weight, t.length, t.width, t.height
+		FROM unnest($2::uuid[], $3::text[], $4::text[], $5::text[], $7::int[], $8::int[], $9::int[], $10::int[])
+			WITH ORDINALITY AS t(id, type, attributes, barcode, weight, length, width, height, ord)
+		ORDER BY t.ord
+		RETURNING id, reception_id, type, created_at, voided_at, created_by, attributes, barcode, status, cell_id, weight, length, width, height
+	`
+
+	ids := make([]uuid.UUID, len(items))
+	types := make([]string, len(items))
+	attrs := make([]string, len(items))
+	barcodes := make([]sql.NullString, len(items))
+	weights := make([]sql.NullInt64, len(items))
+	lengths := make([]sql.NullInt64, len(items))
+	widths := make([]sql.NullInt64, len(items))
+	heights := make([]sql.NullInt64, len(items))
+	for i, item := range items {
+		ids[i] = uuid.New()
+		types[i] = item.Type
+		if item.Barcode != nil {
+			barcodes[i] = sql.NullString{String: *item.Barcode, Valid: true}
+		}
+		if item.Weight != nil {
+			weights[i] = sql.NullInt64{Int64: int64(*item.Weight), Valid: true}
+		}
+		if item.Dimensions != nil {
+			lengths[i] = sql.NullInt64{Int64: int64(item.Dimensions.Length), Valid: true}
+			widths[i] = sql.NullInt64{Int64: int64(item.Dimensions.Width), Valid: true}
+			heights[i] = sql.NullInt64{Int64: int64(item.Dimensions.Height), Valid: true}
+		}
+
+		data, err := json.Marshal(item.Attributes)
+		if err != nil {
+			log.Logger.Err(err).Str("reception_uuid", receptionUUID.String()).Msg("method CreateProducts")
+			return nil, errors.New("could not marshal product attributes")
+		}
+		attrs[i] = string(data)
+	}
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, receptionUUID, pq.Array(ids), pq.Array(types), pq.Array(attrs), pq.Array(barcodes), createdBy,
+		pq.Array(weights), pq.Array(lengths), pq.Array(widths), pq.Array(heights))
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23503" && pqErr.Constraint == "products_type_fkey" {
+			return nil, errors.New(internalErrors.ErrProductTypeDoesntExist)
+		}
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" && pqErr.Constraint == "products_barcode_in_stock_key" {
+			return nil, errors.New(internalErrors.ErrProductBarcodeExist)
+		}
+
+		log.Logger.Err(err).Str("reception_uuid", receptionUUID.String()).Msg("method CreateProducts")
+		return nil, errors.New("could not create products")
+	}
+	defer rows.Close()
+
+	inserted := make(map[uuid.UUID]api.Product, len(items))
+	for rows.Next() {
+		var product models.ProductDB
+		if err := rows.Scan(&product.ID, &product.ReceptionID, &product.Type, &product.CreatedAt, &product.VoidedAt, &product.CreatedBy, &product.Attributes, &product.Barcode, &product.Status, &product.CellID, &product.Weight, &product.Length, &product.Width, &product.Height); err != nil {
+			log.Logger.Err(err).Msg("method CreateProducts")
+			return nil, errors.New("could not scan product row")
+		}
+		inserted[product.ID] = product.ToModelAPIProduct()
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Logger.Err(err).Msg("method CreateProducts")
+		return nil, errors.New("error iterating product rows")
+	}
+
+	// RETURNING не гарантирует порядок строк, поэтому порядок восстанавливается по сгенерированным id
+	products := make([]api.Product, 0, len(ids))
+	for _, id := range ids {
+		products = append(products, inserted[id])
+	}
+
+	return products, nil
+}
+
+// GetInStockProductsByBarcodes возвращает товары на руках ПВЗ (не удаленные, не аннулированные, не выданные)
+// с указанными штрихкодами. Товары незавершенных перемещений уходят из ПВЗ и не возвращаются
+func (r *repository) GetInStockProductsByBarcodes(ctx context.Context, barcodes []string) ([]api.Product, error) {
+	query := `
+		SELECT p.id, p.reception_id, p.type, p.created_at, p.voided_at, p.created_by, p.attributes, p.barcode, p.status, p.cell_id, p.weight, p.length, p.width, p.height
+		FROM shop.products p
+		WHERE p.barcode = ANY($1) AND p.deleted_at IS NULL AND p.voided_at IS NULL
+			AND p.status IN ('received', 'stored')
+			AND NOT EXISTS (
+				SELECT 1
+				FROM shop.transfer_items ti
+				JOIN shop.transfers t ON t.id = ti.transfer_id
+				WHERE ti.product_id = p.id AND t.status IN ('created', 'dispatched')
+			)
+	`
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, pq.Array(barcodes))
+	if err != nil {
+		log.Logger.Err(err).Msg("method GetInStockProductsByBarcodes")
+		return nil, errors.New("could not get products by barcodes")
+	}
+	defer rows.Close()
+
+	var products []api.Product
+	for rows.Next() {
+		var product models.ProductDB
+		if err := rows.Scan(&product.ID, &product.ReceptionID, &product.Type, &product.CreatedAt, &product.VoidedAt, &product.CreatedBy, &product.Attributes, &product.Barcode, &product.Status, &product.CellID, &product.Weight, &product.Length, &product.Width, &product.Height); err != nil {
+			log.Logger.Err(err).Msg("method GetInStockProductsByBarcodes")
+			return nil, errors.New("could not scan product row")
+		}
+		products = append(products, product.ToModelAPIProduct())
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Logger.Err(err).Msg("method GetInStockProductsByBarcodes")
+		return nil, errors.New("error iterating product rows")
+	}
+
+	return products, nil
+}
+
 func (r *repository) GetProductsByRecsUUIDs(ctx context.Context, recsUUIDs []uuid.UUID) ([]api.Product, error) {
 	query := `
-		SELECT p.id, p.reception_id, p.type, p.created_at
+		SELECT p.id, p.reception_id, p.type, p.created_at, p.voided_at, p.created_by, p.attributes, p.barcode, p.status, p.cell_id, p.weight, p.length, p.width, p.height
 		FROM shop.products p
-		WHERE p.reception_id = ANY($1)
+		WHERE p.reception_id = ANY($1) AND p.deleted_at IS NULL
+		ORDER BY p.seq
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, pq.Array(recsUUIDs))
+	rows, err := r.conn(ctx).QueryContext(ctx, query, pq.Array(recsUUIDs))
 	if err != nil {
 		log.Logger.Err(err).Msg("method GetProductsByRecsUUIDs")
 		return nil, errors.New("could not get products by reception uuids")
@@ -308,7 +794,7 @@ func (r *repository) GetProductsByRecsUUIDs(ctx context.Context, recsUUIDs []uui
 	var products []api.Product
 	for rows.Next() {
 		var product models.ProductDB
-		if err := rows.Scan(&product.ID, &product.ReceptionID, &product.Type, &product.CreatedAt); err != nil {
+		if err := rows.Scan(&product.ID, &product.ReceptionID, &product.Type, &product.CreatedAt, &product.VoidedAt, &product.CreatedBy, &product.Attributes, &product.Barcode, &product.Status, &product.CellID, &product.Weight, &product.Length, &product.Width, &product.Height); err != nil {
 			log.Logger.Err(err).Msg("method GetProductsByRecsUUIDs")
 			return nil, errors.New("could not scan product row")
 		}
@@ -323,19 +809,223 @@ func (r *repository) GetProductsByRecsUUIDs(ctx context.Context, recsUUIDs []uui
 	return products, nil
 }
 
-func (r *repository) DeleteLastProductByReceptionUUID(ctx context.Context, receptionUUID uuid.UUID) error {
+// GetDeletedProductsByReceptionUUID возвращает удаленные товары приемки с автором и причиной удаления в порядке удаления
+func (r *repository) GetDeletedProductsByReceptionUUID(ctx context.Context, receptionUUID uuid.UUID) ([]api.Product, error) {
+	query := `
+		SELECT p.id, p.reception_id, p.type, p.created_at, p.voided_at, p.created_by, p.attributes, p.barcode, p.status, p.cell_id, p.weight, p.length, p.width, p.height,
+			p.deleted_at, p.deleted_by, p.delete_reason
+		FROM shop.products p
+		WHERE p.reception_id = $1 AND p.deleted_at IS NOT NULL
+		ORDER BY p.deleted_at, p.seq
+	`
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, receptionUUID)
+	if err != nil {
+		log.Logger.Err(err).Str("reception_uuid", receptionUUID.String()).Msg("method GetDeletedProductsByReceptionUUID")
+		return nil, errors.New("could not get deleted products by reception uuid")
+	}
+	defer rows.Close()
+
+	var products []api.Product
+	for rows.Next() {
+		var product models.ProductDB
+		if err := rows.Scan(&product.ID, &product.ReceptionID, &product.Type, &product.CreatedAt, &product.VoidedAt, &product.CreatedBy, &product.Attributes, &product.Barcode, &product.Status, &product.CellID, &product.Weight, &product.Length, &product.Width, &product.Height,
+			&product.DeletedAt, &product.DeletedBy, &product.DeleteReason); err != nil {
+			log.Logger.Err(err).Msg("method GetDeletedProductsByReceptionUUID")
+			return nil, errors.New("could not scan product row")
+		}
+		products = append(products, product.ToModelAPIProduct())
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Logger.Err(err).Msg("method GetDeletedProductsByReceptionUUID")
+		return nil, errors.New("error during rows iteration")
+	}
+
+	return products, nil
+}
+
+// GetProductsByUUIDs возвращает неудаленные товары с указанными id
+func (r *repository) GetProductsByUUIDs(ctx context.Context, productUUIDs []uuid.UUID) ([]api.Product, error) {
+	query := `
+		SELECT p.id, p.reception_id, p.type, p.created_at, p.voided_at, p.created_by, p.attributes, p.barcode, p.status, p.cell_id, p.weight, p.length, p.width, p.height
+		FROM shop.products p
+		WHERE p.id = ANY($1) AND p.deleted_at IS NULL
+		ORDER BY p.seq
+	`
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, pq.Array(productUUIDs))
+	if err != nil {
+		log.Logger.Err(err).Msg("method GetProductsByUUIDs")
+		return nil, errors.New("could not get products by uuids")
+	}
+	defer rows.Close()
+
+	var products []api.Product
+	for rows.Next() {
+		var product models.ProductDB
+		if err := rows.Scan(&product.ID, &product.ReceptionID, &product.Type, &product.CreatedAt, &product.VoidedAt, &product.CreatedBy, &product.Attributes, &product.Barcode, &product.Status, &product.CellID, &product.Weight, &product.Length, &product.Width, &product.Height); err != nil {
+			log.Logger.Err(err).Msg("method GetProductsByUUIDs")
+			return nil, errors.New("could not scan product row")
+		}
+		products = append(products, product.ToModelAPIProduct())
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Logger.Err(err).Msg("method GetProductsByUUIDs")
+		return nil, errors.New("error during rows iteration")
+	}
+
+	return products, nil
+}
+
+// GetProductCountsByReceptionUUID возвращает количество неаннулированных товаров приемки по типам
+func (r *repository) GetProductCountsByReceptionUUID(ctx context.Context, recUUID uuid.UUID) (map[string]int, error) {
+	counts, err := r.GetProductCountsByRecsUUIDs(ctx, []uuid.UUID{recUUID})
+	if err != nil {
+		return nil, err
+	}
+
+	return counts[recUUID], nil
+}
+
+// GetProductTypesByReceptionBarcodes возвращает типы неаннулированных товаров приемки с указанными штрихкодами
+func (r *repository) GetProductTypesByReceptionBarcodes(ctx context.Context, recUUID uuid.UUID, barcodes []string) (map[string]string, error) {
+	query := `
+		SELECT barcode, type
+		FROM shop.products
+		WHERE reception_id = $1 AND barcode = ANY($2) AND voided_at IS NULL AND deleted_at IS NULL
+	`
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, recUUID, pq.Array(barcodes))
+	if err != nil {
+		log.Logger.Err(err).Str("reception_id", recUUID.String()).Msg("method GetProductTypesByReceptionBarcodes")
+		return nil, errors.New("could not get product types by barcodes")
+	}
+	defer rows.Close()
+
+	types := make(map[string]string)
+	for rows.Next() {
+		var barcode, prType string
+		if err := rows.Scan(&barcode, &prType); err != nil {
+			log.Logger.Err(err).Msg("method GetProductTypesByReceptionBarcodes")
+			return nil, errors.New("could not scan product type by barcode")
+		}
+		types[barcode] = prType
+	}
+	if err := rows.Err(); err != nil {
+		log.Logger.Err(err).Msg("method GetProductTypesByReceptionBarcodes")
+		return nil, errors.New("error during rows iteration")
+	}
+
+	return types, nil
+}
+
+// GetProductCountsByRecsUUIDs возвращает количество неаннулированных товаров по типам для каждой из приемок
+func (r *repository) GetProductCountsByRecsUUIDs(ctx context.Context, recsUUIDs []uuid.UUID) (map[uuid.UUID]map[string]int, error) {
+	query := `
+		SELECT reception_id, type, COUNT(*)
+		FROM shop.products
+		WHERE reception_id = ANY($1) AND voided_at IS NULL AND deleted_at IS NULL
+		GROUP BY reception_id, type
+	`
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, pq.Array(recsUUIDs))
+	if err != nil {
+		log.Logger.Err(err).Msg("method GetProductCountsByRecsUUIDs")
+		return nil, errors.New("could not get product counts by reception uuids")
+	}
+	defer rows.Close()
+
+	counts := make(map[uuid.UUID]map[string]int)
+	for rows.Next() {
+		var (
+			recUUID uuid.UUID
+			prType  string
+			count   int
+		)
+		if err := rows.Scan(&recUUID, &prType, &count); err != nil {
+			log.Logger.Err(err).Msg("method GetProductCountsByRecsUUIDs")
+			return nil, errors.New("could not scan product count row")
+		}
+		if counts[recUUID] == nil {
+			counts[recUUID] = make(map[string]int)
+		}
+		counts[recUUID][prType] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Logger.Err(err).Msg("method GetProductCountsByRecsUUIDs")
+		return nil, errors.New("error during rows iteration")
+	}
+
+	return counts, nil
+}
+
+// GetProductsByReceptionUUIDWithPagination возвращает страницу товаров приемки в порядке добавления
+// и общее количество товаров приемки
+func (r *repository) GetProductsByReceptionUUIDWithPagination(ctx context.Context, recUUID uuid.UUID, page, limit int) ([]api.Product, int, error) {
+	countQuery := `
+		SELECT COUNT(*)
+		FROM shop.products
+		WHERE reception_id = $1 AND deleted_at IS NULL
+	`
+	query := `
+		SELECT p.id, p.reception_id, p.type, p.created_at, p.voided_at, p.created_by, p.attributes, p.barcode, p.status, p.cell_id, p.weight, p.length, p.width, p.height
+		FROM shop.products p
+		WHERE p.reception_id = $1 AND p.deleted_at IS NULL
+		ORDER BY p.seq
+		LIMIT $2 OFFSET $3
+	`
+
+	var total int
+	err := r.conn(ctx).QueryRowContext(ctx, countQuery, recUUID).Scan(&total)
+	if err != nil {
+		log.Logger.Err(err).Str("reception_id", recUUID.String()).Msg("method GetProductsByReceptionUUIDWithPagination")
+		return nil, 0, errors.New("could not count products by reception uuid")
+	}
+
+	offset := (page - 1) * limit
+	rows, err := r.conn(ctx).QueryContext(ctx, query, recUUID, limit, offset)
+	if err != nil {
+		log.Logger.Err(err).Str("reception_id", recUUID.String()).Msg("method GetProductsByReceptionUUIDWithPagination")
+		return nil, 0, errors.New("could not get products by reception uuid")
+	}
+	defer rows.Close()
+
+	products := make([]api.Product, 0, limit)
+	for rows.Next() {
+		var product models.ProductDB
+		if err := rows.Scan(&product.ID, &product.ReceptionID, &product.Type, &product.CreatedAt, &product.VoidedAt, &product.CreatedBy, &product.Attributes, &product.Barcode, &product.Status, &product.CellID, &product.Weight, &product.Length, &product.Width, &product.Height); err != nil {
+			log.Logger.Err(err).Msg("method GetProductsByReceptionUUIDWithPagination")
+			return nil, 0, errors.New("could not scan product row")
+		}
+		products = append(products, product.ToModelAPIProduct())
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Logger.Err(err).Msg("method GetProductsByReceptionUUIDWithPagination")
+		return nil, 0, errors.New("error during rows iteration")
+	}
+
+	return products, total, nil
+}
+
+// DeleteLastProductByReceptionUUID мягко удаляет последний добавленный в приемку товар, сохраняя автора удаления
+func (r *repository) DeleteLastProductByReceptionUUID(ctx context.Context, receptionUUID uuid.UUID, deletedBy uuid.UUID) error {
 	query := `
-		DELETE FROM shop.products
+		UPDATE shop.products
+		SET deleted_at = NOW(), deleted_by = $2
 		WHERE id = (
 			SELECT id
 			FROM shop.products
-			WHERE reception_id = $1
-			ORDER BY created_at DESC
+			WHERE reception_id = $1 AND deleted_at IS NULL AND status = 'received'
+			ORDER BY seq DESC
 			LIMIT 1
 		)
 	`
 
-	res, err := r.db.ExecContext(ctx, query, receptionUUID)
+	res, err := r.conn(ctx).ExecContext(ctx, query, receptionUUID, deletedBy)
 	if err != nil {
 		log.Logger.Err(err).Str("receptionUUID", receptionUUID.String()).Msg("method DeleteLastProductByReceptionUUID")
 		return errors.New("could not delete last product by reception uuid")
@@ -356,3 +1046,96 @@ func (r *repository) DeleteLastProductByReceptionUUID(ctx context.Context, recep
 
 	return nil
 }
+
+// GetProductByUUID возвращает неудаленный товар, при отсутствии товара возвращается товар без id
+func (r *repository) GetProductByUUID(ctx context.Context, productUUID uuid.UUID) (api.Product, error) {
+	query := `
+		SELECT p.id, p.reception_id, p.type, p.created_at, p.voided_at, p.created_by, p.attributes, p.barcode, p.status, p.cell_id, p.weight, p.length, p.width, p.height
+		FROM shop.products p
+		WHERE p.id = $1 AND p.deleted_at IS NULL
+	`
+
+	var product models.ProductDB
+	err := r.conn(ctx).QueryRowContext(ctx, query, productUUID).
+		Scan(&product.ID, &product.ReceptionID, &product.Type, &product.CreatedAt, &product.VoidedAt, &product.CreatedBy, &product.Attributes, &product.Barcode, &product.Status, &product.CellID, &product.Weight, &product.Length, &product.Width, &product.Height)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.Product{}, nil
+		}
+		log.Logger.Err(err).Str("product_uuid", productUUID.String()).Msg("method GetProductByUUID")
+		return api.Product{}, errors.New("could not get product by uuid")
+	}
+
+	return product.ToModelAPIProduct(), nil
+}
+
+func (r *repository) DeleteProduct(ctx context.Context, productUUID uuid.UUID, deletedBy uuid.UUID, reason string) error {
+	query := `
+		UPDATE shop.products
+		SET deleted_at = NOW(), deleted_by = $2, delete_reason = $3
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+
+	res, err := r.conn(ctx).ExecContext(ctx, query, productUUID, deletedBy, reason)
+	if err != nil {
+		log.Logger.Err(err).Str("product_uuid", productUUID.String()).Msg("method DeleteProduct")
+		return errors.New("could not delete product")
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		log.Logger.Err(err).Str("product_uuid", productUUID.String()).Msg("method DeleteProduct")
+		return errors.New("could not get deletion result")
+	}
+
+	if affected == 0 {
+		return errors.New(internalErrors.ErrProductDoesntExist)
+	}
+
+	return nil
+}
+
+func (r *repository) UpdateProduct(
+	ctx context.Context,
+	productUUID uuid.UUID,
+	prType string,
+	attributes api.ProductAttributes,
+) (api.Product, error) {
+	query := `
+		UPDATE shop.products
+		SET type = $2, attributes = $3
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING id, reception_id, type, created_at, voided_at, created_by, attributes, barcode, status, cell_id, weight, length, width, height
+	`
+
+	attrs, err := json.Marshal(attributes)
+	if err != nil {
+		log.Logger.Err(err).Str("product_uuid", productUUID.String()).Msg("method UpdateProduct")
+		return api.Product{}, errors.New("could not marshal product attributes")
+	}
+
+	var updated models.ProductDB
+	err = r.conn(ctx).QueryRowContext(ctx, query, productUUID, prType, attrs).
+		Scan(&updated.ID, &updated.ReceptionID, &updated.Type, &updated.CreatedAt, &updated.VoidedAt, &updated.CreatedBy, &updated.Attributes, &updated.Barcode, &updated.Status, &updated.CellID, &updated.Weight, &updated.Length, &updated.Width, &updated.Height)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.Product{}, errors.New(internalErrors.ErrProductDoesntExist)
+		}
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23503" && pqErr.Constraint == "products_type_fkey" {
+			return api.Product{}, errors.New(internalErrors.ErrProductTypeDoesntExist)
+		}
+
+		log.Logger.Err(err).Str("product_uuid", productUUID.String()).Str("type", prType).Msg("method UpdateProduct")
+		return api.Product{}, errors.New("could not update product")
+	}
+
+	return updated.ToModelAPIProduct(), nil
+}
+
+// isOpenReceptionViolation сообщает о нарушении правила "одна открытая приемка на ПВЗ"
+func isOpenReceptionViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505" && pqErr.Constraint == "uq_receptions_pvz_id_open"
+}
@@ -0,0 +1,245 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/devWaylander/pvz_store/api"
+	internalErrors "github.com/devWaylander/pvz_store/pkg/errors"
+	"github.com/devWaylander/pvz_store/pkg/log"
+	"github.com/devWaylander/pvz_store/pkg/models"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const returnShipmentColumns = `id, pvz_id, status, created_by, created_at, closed_by, closed_at`
+
+/*
+Return shipment
+*/
+func (r *repository) CreateReturnShipment(ctx context.Context, pvzUUID uuid.UUID, createdBy uuid.UUID) (api.ReturnShipment, error) {
+	query := `
+		INSERT INTO shop.return_shipments (pvz_id, created_by)
+		VALUES ($1, $2)
+		RETURNING ` + returnShipmentColumns
+
+	var inserted models.ReturnShipmentDB
+	err := r.conn(ctx).GetContext(ctx, &inserted, query, pvzUUID, createdBy)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" && pqErr.Constraint == "uq_return_shipments_pvz_id_open" {
+			return api.ReturnShipment{}, errors.New(internalErrors.ErrReturnShipmentExist)
+		}
+
+		log.Logger.Err(err).Str("pvz_uuid", pvzUUID.String()).Msg("method CreateReturnShipment")
+		return api.ReturnShipment{}, errors.New("could not create return shipment")
+	}
+
+	return inserted.ToModelAPIReturnShipment(nil), nil
+}
+
+func (r *repository) GetReturnShipmentByUUID(ctx context.Context, shipmentUUID uuid.UUID) (api.ReturnShipment, error) {
+	query := `
+		SELECT ` + returnShipmentColumns + `
+		FROM shop.return_shipments
+		WHERE id = $1
+	`
+
+	var shipment models.ReturnShipmentDB
+	err := r.conn(ctx).GetContext(ctx, &shipment, query, shipmentUUID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.ReturnShipment{}, nil
+		}
+		log.Logger.Err(err).Str("shipment_uuid", shipmentUUID.String()).Msg("method GetReturnShipmentByUUID")
+		return api.ReturnShipment{}, errors.New("could not get return shipment")
+	}
+
+	returns, err := r.getReturns(ctx, []uuid.UUID{shipment.ID})
+	if err != nil {
+		return api.ReturnShipment{}, err
+	}
+
+	return shipment.ToModelAPIReturnShipment(returns[shipment.ID]), nil
+}
+
+// GetOpenReturnShipmentByPvzUUID возвращает открытую отправку возвратов ПВЗ, блокируя её до конца транзакции
+func (r *repository) GetOpenReturnShipmentByPvzUUID(ctx context.Context, pvzUUID uuid.UUID) (api.ReturnShipment, error) {
+	query := `
+		SELECT ` + returnShipmentColumns + `
+		FROM shop.return_shipments
+		WHERE pvz_id = $1 AND status = 'in_progress'
+		FOR UPDATE
+	`
+
+	var shipment models.ReturnShipmentDB
+	err := r.conn(ctx).GetContext(ctx, &shipment, query, pvzUUID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.ReturnShipment{}, nil
+		}
+		log.Logger.Err(err).Str("pvz_uuid", pvzUUID.String()).Msg("method GetOpenReturnShipmentByPvzUUID")
+		return api.ReturnShipment{}, errors.New("could not get return shipment")
+	}
+
+	returns, err := r.getReturns(ctx, []uuid.UUID{shipment.ID})
+	if err != nil {
+		return api.ReturnShipment{}, err
+	}
+
+	return shipment.ToModelAPIReturnShipment(returns[shipment.ID]), nil
+}
+
+// CloseReturnShipment закрывает отправку возвратов, товары отправки возвращаются отправителю
+func (r *repository) CloseReturnShipment(ctx context.Context, shipmentUUID uuid.UUID, actor models.AuthPrincipal) (api.ReturnShipment, error) {
+	productsQuery := `
+		WITH updated AS (
+			UPDATE shop.products p
+			SET status = 'returned_to_sender', pickup_code_hash = NULL, pickup_attempts = 0
+			FROM shop.returns rt
+			WHERE rt.shipment_id = $1 AND rt.product_id = p.id AND p.status = 'returned'
+			RETURNING p.id
+		)
+		INSERT INTO shop.product_status_history (product_id, from_status, to_status, actor_id, actor_role)
+		SELECT id, 'returned', 'returned_to_sender', $2, $3 FROM updated
+	`
+	query := `
+		UPDATE shop.return_shipments
+		SET status = 'closed', closed_by = $1, closed_at = NOW()
+		WHERE id = $2 AND status = 'in_progress'
+		RETURNING ` + returnShipmentColumns
+
+	var shipment models.ReturnShipmentDB
+	err := r.WithTx(ctx, func(ctx context.Context) error {
+		_, err := r.conn(ctx).ExecContext(ctx, productsQuery, shipmentUUID, actor.UserUUID, actor.Role)
+		if err != nil {
+			log.Logger.Err(err).Str("shipment_id", shipmentUUID.String()).Msg("method CloseReturnShipment")
+			return errors.New("could not return shipment products to sender")
+		}
+
+		err = r.conn(ctx).GetContext(ctx, &shipment, query, actor.UserUUID, shipmentUUID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return errors.New(internalErrors.ErrNoOpenReturnShipment)
+			}
+			log.Logger.Err(err).Str("shipment_id", shipmentUUID.String()).Msg("method CloseReturnShipment")
+			return errors.New("could not close return shipment")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return api.ReturnShipment{}, err
+	}
+
+	returns, err := r.getReturns(ctx, []uuid.UUID{shipment.ID})
+	if err != nil {
+		return api.ReturnShipment{}, err
+	}
+
+	return shipment.ToModelAPIReturnShipment(returns[shipment.ID]), nil
+}
+
+// GetReturnShipmentsByPvzUUIDsFiltered возвращает отправки возвратов ПВЗ, открытые в диапазоне дат, вместе с возвратами
+func (r *repository) GetReturnShipmentsByPvzUUIDsFiltered(
+	ctx context.Context,
+	pvzUUIDs []uuid.UUID,
+	startDate, endDate *time.Time,
+) ([]api.ReturnShipment, error) {
+	query := `
+		SELECT ` + returnShipmentColumns + `
+		FROM shop.return_shipments
+		WHERE pvz_id = ANY($1)
+	`
+
+	args := []any{pq.Array(pvzUUIDs)}
+	if startDate != nil {
+		args = append(args, *startDate)
+		query += fmt.Sprintf(` AND created_at >= $%d`, len(args))
+	}
+	if endDate != nil {
+		args = append(args, *endDate)
+		query += fmt.Sprintf(` AND created_at <= $%d`, len(args))
+	}
+
+	query += ` ORDER BY created_at DESC`
+
+	var shipments []models.ReturnShipmentDB
+	err := r.conn(ctx).SelectContext(ctx, &shipments, query, args...)
+	if err != nil {
+		log.Logger.Err(err).Msg("method GetReturnShipmentsByPvzUUIDsFiltered")
+		return nil, errors.New("could not get return shipments by pvz uuids")
+	}
+
+	shipmentUUIDs := make([]uuid.UUID, 0, len(shipments))
+	for _, shipment := range shipments {
+		shipmentUUIDs = append(shipmentUUIDs, shipment.ID)
+	}
+	returns, err := r.getReturns(ctx, shipmentUUIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]api.ReturnShipment, 0, len(shipments))
+	for _, shipment := range shipments {
+		result = append(result, shipment.ToModelAPIReturnShipment(returns[shipment.ID]))
+	}
+
+	return result, nil
+}
+
+/*
+Return
+*/
+func (r *repository) CreateReturn(
+	ctx context.Context,
+	shipmentUUID, productUUID uuid.UUID,
+	reasonCode, condition string,
+	comment *string,
+	createdBy uuid.UUID,
+) (api.Return, error) {
+	query := `
+		INSERT INTO shop.returns (shipment_id, product_id, reason_code, condition, comment, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, product_id, shipment_id, reason_code, condition, comment, created_by, created_at
+	`
+
+	var inserted models.ReturnDB
+	err := r.conn(ctx).GetContext(ctx, &inserted, query, shipmentUUID, productUUID, reasonCode, condition, comment, createdBy)
+	if err != nil {
+		// товар уже возвращали, повторный возврат невозможен
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" && pqErr.Constraint == "returns_product_id_key" {
+			return api.Return{}, errors.New(internalErrors.ErrProductTransition)
+		}
+
+		log.Logger.Err(err).Str("product_uuid", productUUID.String()).Msg("method CreateReturn")
+		return api.Return{}, errors.New("could not create return")
+	}
+
+	return inserted.ToModelAPIReturn(), nil
+}
+
+func (r *repository) getReturns(ctx context.Context, shipmentUUIDs []uuid.UUID) (map[uuid.UUID][]models.ReturnDB, error) {
+	query := `
+		SELECT id, product_id, shipment_id, reason_code, condition, comment, created_by, created_at
+		FROM shop.returns
+		WHERE shipment_id = ANY($1)
+		ORDER BY created_at
+	`
+
+	var returns []models.ReturnDB
+	err := r.conn(ctx).SelectContext(ctx, &returns, query, pq.Array(shipmentUUIDs))
+	if err != nil {
+		log.Logger.Err(err).Msg("method getReturns")
+		return nil, errors.New("could not get returns")
+	}
+
+	returnsByShipment := make(map[uuid.UUID][]models.ReturnDB)
+	for _, ret := range returns {
+		returnsByShipment[ret.ShipmentID] = append(returnsByShipment[ret.ShipmentID], ret)
+	}
+
+	return returnsByShipment, nil
+}
@@ -0,0 +1,104 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/devWaylander/pvz_store/api"
+	"github.com/devWaylander/pvz_store/pkg/log"
+	"github.com/devWaylander/pvz_store/pkg/models"
+)
+
+// statsDimensionColumns выражения измерений статистики приемок, группировка собирается только из них
+var statsDimensionColumns = map[string]string{
+	string(api.StatsDimensionPvz):         "s.pvz_id",
+	string(api.StatsDimensionCity):        "pv.city",
+	string(api.StatsDimensionProductType): "s.product_type",
+}
+
+// statsPeriodUnits единицы date_trunc для периодов статистики
+var statsPeriodUnits = map[string]string{
+	string(api.Day):   "day",
+	string(api.Week):  "week",
+	string(api.Month): "month",
+}
+
+/*
+Stats
+*/
+// GetReceptionStats суммирует суточные сводки закрытых приемок по периоду и измерениям фильтра.
+// В разбивке по типам товаров читаются сводки по типам, иначе сводки по ПВЗ. Диапазон дат округляется до суток
+func (r *repository) GetReceptionStats(ctx context.Context, filter models.ReceptionStatsFilter) ([]api.ReceptionStatsRow, error) {
+	columns := map[string]string{
+		"period_start": "NULL::timestamp",
+		"pvz_id":       "NULL::uuid",
+		"city":         "NULL::text",
+		"product_type": "NULL::text",
+	}
+	var groupBy []string
+	if unit, ok := statsPeriodUnits[filter.Period]; ok {
+		columns["period_start"] = fmt.Sprintf("date_trunc('%s', s.day::timestamp)", unit)
+		groupBy = append(groupBy, columns["period_start"])
+	}
+	withProductType := false
+	for _, dimension := range filter.GroupBy {
+		column, ok := statsDimensionColumns[dimension]
+		if !ok {
+			return nil, fmt.Errorf("unknown stats dimension %q", dimension)
+		}
+		switch dimension {
+		case string(api.StatsDimensionPvz):
+			columns["pvz_id"] = column
+		case string(api.StatsDimensionCity):
+			columns["city"] = column
+		case string(api.StatsDimensionProductType):
+			columns["product_type"] = column
+			withProductType = true
+		}
+		groupBy = append(groupBy, column)
+	}
+
+	table := "shop.daily_pvz_summaries"
+	if withProductType {
+		table = "shop.daily_product_type_summaries"
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s AS period_start, %s AS pvz_id, %s AS city, %s AS product_type,
+			COALESCE(SUM(s.receptions), 0) AS receptions,
+			COALESCE(SUM(s.products), 0) AS products
+		FROM %s s
+		JOIN shop.pvz pv ON pv.id = s.pvz_id
+		WHERE TRUE
+	`, columns["period_start"], columns["pvz_id"], columns["city"], columns["product_type"], table)
+
+	var args []any
+	if filter.StartDate != nil {
+		args = append(args, filter.StartDate.UTC().Format(time.DateOnly))
+		query += fmt.Sprintf(` AND s.day >= $%d::date`, len(args))
+	}
+	if filter.EndDate != nil {
+		args = append(args, filter.EndDate.UTC().Format(time.DateOnly))
+		query += fmt.Sprintf(` AND s.day <= $%d::date`, len(args))
+	}
+	if len(groupBy) > 0 {
+		query += ` GROUP BY ` + strings.Join(groupBy, ", ") + ` ORDER BY ` + strings.Join(groupBy, ", ")
+	}
+
+	var rows []models.ReceptionStatsRowDB
+	err := r.conn(ctx).SelectContext(ctx, &rows, query, args...)
+	if err != nil {
+		log.Logger.Err(err).Msg("method GetReceptionStats")
+		return nil, errors.New("could not get reception stats")
+	}
+
+	result := make([]api.ReceptionStatsRow, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, row.ToModelAPIReceptionStatsRow())
+	}
+
+	return result, nil
+}
@@ -0,0 +1,197 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/devWaylander/pvz_store/api"
+	internalErrors "github.com/devWaylander/pvz_store/pkg/errors"
+	"github.com/devWaylander/pvz_store/pkg/log"
+	"github.com/devWaylander/pvz_store/pkg/models"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// cellOccupiedColumn количество товаров на руках ПВЗ в ячейке c
+const cellOccupiedColumn = `(
+			SELECT COUNT(*)
+			FROM shop.products p
+			WHERE p.cell_id = c.id AND p.status IN ('received', 'stored')
+				AND p.deleted_at IS NULL AND p.voided_at IS NULL
+		) AS occupied`
+
+/*
+Storage cell
+*/
+func (r *repository) CreateStorageCell(ctx context.Context, pvzUUID uuid.UUID, rack, shelf, cell, code string, capacity int) (api.StorageCell, error) {
+	query := `
+		INSERT INTO shop.storage_cells (pvz_id, rack, shelf, cell, code, capacity)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, pvz_id, rack, shelf, cell, code, capacity, created_at
+	`
+
+	var inserted models.StorageCellDB
+	err := r.conn(ctx).GetContext(ctx, &inserted, query, pvzUUID, rack, shelf, cell, code, capacity)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" && pqErr.Constraint == "storage_cells_pvz_id_code_key" {
+			return api.StorageCell{}, errors.New(internalErrors.ErrStorageCellExist)
+		}
+
+		log.Logger.Err(err).Str("pvz_uuid", pvzUUID.String()).Str("code", code).Msg("method CreateStorageCell")
+		return api.StorageCell{}, errors.New("could not create storage cell")
+	}
+
+	return inserted.ToModelAPIStorageCell(), nil
+}
+
+// GetStorageCellsByPvzUUID возвращает ячейки ПВЗ с их заполненностью в порядке кодов
+func (r *repository) GetStorageCellsByPvzUUID(ctx context.Context, pvzUUID uuid.UUID) ([]api.StorageCell, error) {
+	query := `
+		SELECT c.id, c.pvz_id, c.rack, c.shelf, c.cell, c.code, c.capacity, c.created_at,
+		` + cellOccupiedColumn + `
+		FROM shop.storage_cells c
+		WHERE c.pvz_id = $1
+		ORDER BY c.code
+	`
+
+	var cells []models.StorageCellDB
+	err := r.conn(ctx).SelectContext(ctx, &cells, query, pvzUUID)
+	if err != nil {
+		log.Logger.Err(err).Str("pvz_uuid", pvzUUID.String()).Msg("method GetStorageCellsByPvzUUID")
+		return nil, errors.New("could not get storage cells")
+	}
+
+	result := make([]api.StorageCell, 0, len(cells))
+	for _, cell := range cells {
+		result = append(result, cell.ToModelAPIStorageCell())
+	}
+
+	return result, nil
+}
+
+// GetStorageCellsByPvzUUIDForUpdate возвращает ячейки ПВЗ с заполненностью, блокируя их до конца транзакции
+func (r *repository) GetStorageCellsByPvzUUIDForUpdate(ctx context.Context, pvzUUID uuid.UUID) ([]api.StorageCell, error) {
+	query := `
+		SELECT id
+		FROM shop.storage_cells
+		WHERE pvz_id = $1
+		ORDER BY id
+		FOR UPDATE
+	`
+
+	var cells []api.StorageCell
+	err := r.WithTx(ctx, func(ctx context.Context) error {
+		var locked []uuid.UUID
+		err := r.conn(ctx).SelectContext(ctx, &locked, query, pvzUUID)
+		if err != nil {
+			log.Logger.Err(err).Str("pvz_uuid", pvzUUID.String()).Msg("method GetStorageCellsByPvzUUIDForUpdate")
+			return errors.New("could not lock storage cells")
+		}
+
+		// заполненность читается отдельным запросом после блокировки, чтобы учесть товары,
+		// разложенные транзакциями, которые держали блокировку
+		cells, err = r.GetStorageCellsByPvzUUID(ctx, pvzUUID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return cells, nil
+}
+
+// GetStorageCellByUUID возвращает ячейку с заполненностью, блокируя её до конца транзакции
+func (r *repository) GetStorageCellByUUID(ctx context.Context, cellUUID uuid.UUID) (api.StorageCell, error) {
+	lockQuery := `
+		SELECT id
+		FROM shop.storage_cells
+		WHERE id = $1
+		FOR UPDATE
+	`
+	query := `
+		SELECT c.id, c.pvz_id, c.rack, c.shelf, c.cell, c.code, c.capacity, c.created_at,
+		` + cellOccupiedColumn + `
+		FROM shop.storage_cells c
+		WHERE c.id = $1
+	`
+
+	var cell models.StorageCellDB
+	err := r.WithTx(ctx, func(ctx context.Context) error {
+		var locked uuid.UUID
+		err := r.conn(ctx).GetContext(ctx, &locked, lockQuery, cellUUID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil
+			}
+			log.Logger.Err(err).Str("cell_uuid", cellUUID.String()).Msg("method GetStorageCellByUUID")
+			return errors.New("could not lock storage cell")
+		}
+
+		// заполненность читается отдельным запросом после блокировки, как в GetStorageCellsByPvzUUIDForUpdate
+		err = r.conn(ctx).GetContext(ctx, &cell, query, cellUUID)
+		if err != nil {
+			log.Logger.Err(err).Str("cell_uuid", cellUUID.String()).Msg("method GetStorageCellByUUID")
+			return errors.New("could not get storage cell by uuid")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return api.StorageCell{}, err
+	}
+	if cell.ID == uuid.Nil {
+		return api.StorageCell{}, nil
+	}
+
+	return cell.ToModelAPIStorageCell(), nil
+}
+
+func (r *repository) SetProductCell(ctx context.Context, productUUID, cellUUID uuid.UUID) error {
+	query := `
+		UPDATE shop.products
+		SET cell_id = $1
+		WHERE id = $2
+	`
+
+	_, err := r.conn(ctx).ExecContext(ctx, query, cellUUID, productUUID)
+	if err != nil {
+		log.Logger.Err(err).Str("product_uuid", productUUID.String()).Msg("method SetProductCell")
+		return errors.New("could not set product storage cell")
+	}
+
+	return nil
+}
+
+// GetProductLocation возвращает ПВЗ, приемку и статус товара, ячейка указывается только для товара на руках ПВЗ.
+// При отсутствии товара возвращается местонахождение без статуса
+func (r *repository) GetProductLocation(ctx context.Context, productUUID uuid.UUID) (api.ProductLocation, error) {
+	query := `
+		SELECT p.id, p.current_pvz_id, p.reception_id, p.status,
+			CASE WHEN p.status IN ('received', 'stored') THEN p.cell_id END AS cell_id
+		FROM shop.products p
+		WHERE p.id = $1 AND p.deleted_at IS NULL AND p.voided_at IS NULL
+	`
+
+	var location api.ProductLocation
+	var cellID uuid.NullUUID
+	err := r.conn(ctx).QueryRowContext(ctx, query, productUUID).
+		Scan(&location.ProductId, &location.PvzId, &location.ReceptionId, &location.Status, &cellID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.ProductLocation{}, nil
+		}
+		log.Logger.Err(err).Str("product_uuid", productUUID.String()).Msg("method GetProductLocation")
+		return api.ProductLocation{}, errors.New("could not get product location")
+	}
+
+	if cellID.Valid {
+		cell, err := r.GetStorageCellByUUID(ctx, cellID.UUID)
+		if err != nil {
+			return api.ProductLocation{}, err
+		}
+		location.Cell = &cell
+	}
+
+	return location, nil
+}
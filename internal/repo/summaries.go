@@ -0,0 +1,128 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/devWaylander/pvz_store/api"
+	"github.com/devWaylander/pvz_store/pkg/log"
+	"github.com/google/uuid"
+)
+
+/*
+Daily summaries
+*/
+// RebuildDailySummaries пересчитывает суточные сводки всех ПВЗ за день по сырым приемкам и товарам.
+// На время пересчета таблицы сводок блокируются от изменений при смене статусов приемок
+func (r *repository) RebuildDailySummaries(ctx context.Context, day time.Time) error {
+	query := `
+		LOCK TABLE shop.daily_pvz_summaries, shop.daily_product_type_summaries IN EXCLUSIVE MODE
+	`
+
+	return r.WithTx(ctx, func(ctx context.Context) error {
+		_, err := r.conn(ctx).ExecContext(ctx, query)
+		if err != nil {
+			log.Logger.Err(err).Msg("method RebuildDailySummaries")
+			return errors.New("could not lock daily summaries")
+		}
+
+		return r.refreshDailySummaries(ctx, day, uuid.NullUUID{})
+	})
+}
+
+// GetReceptionsDateRange возвращает дни создания первой и последней приемки, без приемок - нулевые даты
+func (r *repository) GetReceptionsDateRange(ctx context.Context) (time.Time, time.Time, error) {
+	query := `
+		SELECT MIN(created_at)::date, MAX(created_at)::date
+		FROM shop.receptions
+	`
+
+	var first, last sql.NullTime
+	err := r.conn(ctx).QueryRowContext(ctx, query).Scan(&first, &last)
+	if err != nil {
+		log.Logger.Err(err).Msg("method GetReceptionsDateRange")
+		return time.Time{}, time.Time{}, errors.New("could not get receptions date range")
+	}
+
+	return first.Time, last.Time, nil
+}
+
+// refreshReceptionSummaries пересчитывает сводки ПВЗ приемки за день ее создания. Вызывается в транзакции
+// смены статуса, пересчеты одного ПВЗ выполняются по очереди под транзакционной advisory-блокировкой.
+// Приемки перемещений в сводки не входят: их товары уже учтены в приемках поставок
+func (r *repository) refreshReceptionSummaries(ctx context.Context, recUUID uuid.UUID) error {
+	query := `
+		SELECT pvz_id, created_at::date
+		FROM shop.receptions
+		WHERE id = $1 AND created_at IS NOT NULL AND type = 'supply'
+	`
+	lockQuery := `
+		SELECT pg_advisory_xact_lock(hashtextextended($1::text, 0))
+	`
+
+	var (
+		pvzID uuid.UUID
+		day   time.Time
+	)
+	err := r.conn(ctx).QueryRowContext(ctx, query, recUUID).Scan(&pvzID, &day)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		log.Logger.Err(err).Str("reception_id", recUUID.String()).Msg("method refreshReceptionSummaries")
+		return errors.New("could not get reception for summaries")
+	}
+
+	_, err = r.conn(ctx).ExecContext(ctx, lockQuery, pvzID.String())
+	if err != nil {
+		log.Logger.Err(err).Str("pvz_id", pvzID.String()).Msg("method refreshReceptionSummaries")
+		return errors.New("could not lock pvz summaries")
+	}
+
+	return r.refreshDailySummaries(ctx, day, uuid.NullUUID{UUID: pvzID, Valid: true})
+}
+
+// refreshDailySummaries заменяет сводки за день пересчитанными по закрытым приемкам поставок, при pvzID.Valid - только сводки ПВЗ
+func (r *repository) refreshDailySummaries(ctx context.Context, day time.Time, pvzID uuid.NullUUID) error {
+	queries := []string{
+		`DELETE FROM shop.daily_pvz_summaries
+		WHERE day = $1::date AND ($2::uuid IS NULL OR pvz_id = $2::uuid)`,
+		`DELETE FROM shop.daily_product_type_summaries
+		WHERE day = $1::date AND ($2::uuid IS NULL OR pvz_id = $2::uuid)`,
+		`INSERT INTO shop.daily_pvz_summaries (day, pvz_id, receptions, products)
+		SELECT $1::date, r.pvz_id, COUNT(DISTINCT r.id), COUNT(p.id)
+		FROM shop.receptions r
+		LEFT JOIN shop.products p ON p.reception_id = r.id
+			AND p.deleted_at IS NULL AND p.voided_at IS NULL
+		WHERE r.status IN ('closed', 'verified') AND r.type = 'supply'
+			AND r.created_at >= $1::date AND r.created_at < $1::date + 1
+			AND ($2::uuid IS NULL OR r.pvz_id = $2::uuid)
+		GROUP BY r.pvz_id`,
+		`INSERT INTO shop.daily_product_type_summaries (day, pvz_id, product_type, receptions, products)
+		SELECT $1::date, r.pvz_id, p.type, COUNT(DISTINCT r.id), COUNT(p.id)
+		FROM shop.receptions r
+		JOIN shop.products p ON p.reception_id = r.id
+			AND p.deleted_at IS NULL AND p.voided_at IS NULL
+		WHERE r.status IN ('closed', 'verified') AND r.type = 'supply'
+			AND r.created_at >= $1::date AND r.created_at < $1::date + 1
+			AND ($2::uuid IS NULL OR r.pvz_id = $2::uuid)
+		GROUP BY r.pvz_id, p.type`,
+	}
+
+	for _, query := range queries {
+		_, err := r.conn(ctx).ExecContext(ctx, query, day.Format(time.DateOnly), pvzID)
+		if err != nil {
+			log.Logger.Err(err).Str("day", day.Format(time.DateOnly)).Msg("method refreshDailySummaries")
+			return errors.New("could not refresh daily summaries")
+		}
+	}
+
+	return nil
+}
+
+// isSummaryStatus статусы приемок, которые попадают в суточные сводки
+func isSummaryStatus(status string) bool {
+	return status == string(api.ReceptionStatusClosed) || status == string(api.ReceptionStatusVerified)
+}
@@ -0,0 +1,322 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/devWaylander/pvz_store/api"
+	internalErrors "github.com/devWaylander/pvz_store/pkg/errors"
+	"github.com/devWaylander/pvz_store/pkg/log"
+	"github.com/devWaylander/pvz_store/pkg/models"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const transferColumns = `id, source_pvz_id, destination_pvz_id, status, reception_id, created_by, created_at,
+	dispatched_at, received_at`
+
+/*
+Transfer
+*/
+// CreateTransfer создает перемещение товаров, которые лежат на хранении в ПВЗ отправления
+// и не входят в другое незавершенное перемещение или невыданный заказ
+func (r *repository) CreateTransfer(
+	ctx context.Context,
+	sourcePvzUUID, destinationPvzUUID uuid.UUID,
+	productUUIDs []uuid.UUID,
+	createdBy uuid.UUID,
+) (api.Transfer, error) {
+	query := `
+		INSERT INTO shop.transfers (source_pvz_id, destination_pvz_id, created_by)
+		VALUES ($1, $2, $3)
+		RETURNING ` + transferColumns
+	itemsQuery := `
+		INSERT INTO shop.transfer_items (transfer_id, product_id, source_reception_id)
+		SELECT $1, p.id, p.reception_id
+		FROM shop.products p
+		WHERE p.id = ANY($2)
+			AND p.current_pvz_id = $3
+			AND p.status = 'stored'
+			AND p.deleted_at IS NULL
+			AND p.voided_at IS NULL
+			AND NOT EXISTS (
+				SELECT 1
+				FROM shop.transfer_items ti
+				JOIN shop.transfers t ON t.id = ti.transfer_id
+				WHERE ti.product_id = p.id AND t.status IN ('created', 'dispatched')
+			)
+			AND NOT EXISTS (
+				SELECT 1
+				FROM shop.order_items oi
+				JOIN shop.orders o ON o.id = oi.order_id
+				WHERE oi.product_id = p.id AND o.status <> 'issued'
+			)
+		RETURNING transfer_id, product_id, source_reception_id
+	`
+
+	var inserted models.TransferDB
+	var items []models.TransferItemDB
+	err := r.WithTx(ctx, func(ctx context.Context) error {
+		err := r.conn(ctx).GetContext(ctx, &inserted, query, sourcePvzUUID, destinationPvzUUID, createdBy)
+		if err != nil {
+			log.Logger.Err(err).Str("source_pvz_uuid", sourcePvzUUID.String()).Msg("method CreateTransfer")
+			return errors.New("could not create transfer")
+		}
+
+		err = r.conn(ctx).SelectContext(ctx, &items, itemsQuery, inserted.ID, pq.Array(productUUIDs), sourcePvzUUID)
+		if err != nil {
+			log.Logger.Err(err).Str("transfer_id", inserted.ID.String()).Msg("method CreateTransfer")
+			return errors.New("could not create transfer items")
+		}
+		if len(items) != len(productUUIDs) {
+			return errors.New(internalErrors.ErrWrongTransferProducts)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return api.Transfer{}, err
+	}
+
+	return inserted.ToModelAPITransfer(items), nil
+}
+
+func (r *repository) GetTransferByUUID(ctx context.Context, transferUUID uuid.UUID) (api.Transfer, error) {
+	return r.getTransferByUUID(ctx, transferUUID, "")
+}
+
+// GetTransferByUUIDForUpdate возвращает перемещение, блокируя его до конца транзакции
+func (r *repository) GetTransferByUUIDForUpdate(ctx context.Context, transferUUID uuid.UUID) (api.Transfer, error) {
+	return r.getTransferByUUID(ctx, transferUUID, "FOR UPDATE")
+}
+
+func (r *repository) getTransferByUUID(ctx context.Context, transferUUID uuid.UUID, lock string) (api.Transfer, error) {
+	query := `
+		SELECT ` + transferColumns + `
+		FROM shop.transfers
+		WHERE id = $1
+		` + lock
+
+	var transfer models.TransferDB
+	err := r.conn(ctx).GetContext(ctx, &transfer, query, transferUUID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.Transfer{}, nil
+		}
+		log.Logger.Err(err).Str("transfer_uuid", transferUUID.String()).Msg("method GetTransferByUUID")
+		return api.Transfer{}, errors.New("could not get transfer by uuid")
+	}
+
+	items, err := r.getTransferItems(ctx, []uuid.UUID{transfer.ID})
+	if err != nil {
+		return api.Transfer{}, err
+	}
+
+	return transfer.ToModelAPITransfer(items[transfer.ID]), nil
+}
+
+// GetTransfersInTransit возвращает отправленные и еще не полученные перемещения,
+// при заданном ПВЗ только те, где он является отправителем или получателем
+func (r *repository) GetTransfersInTransit(ctx context.Context, pvzUUID *uuid.UUID) ([]api.Transfer, error) {
+	query := `
+		SELECT ` + transferColumns + `
+		FROM shop.transfers
+		WHERE status = 'dispatched'
+			AND ($1::uuid IS NULL OR source_pvz_id = $1 OR destination_pvz_id = $1)
+		ORDER BY dispatched_at
+	`
+
+	var pvzID uuid.NullUUID
+	if pvzUUID != nil {
+		pvzID = uuid.NullUUID{UUID: *pvzUUID, Valid: true}
+	}
+
+	var transfers []models.TransferDB
+	err := r.conn(ctx).SelectContext(ctx, &transfers, query, pvzID)
+	if err != nil {
+		log.Logger.Err(err).Msg("method GetTransfersInTransit")
+		return nil, errors.New("could not get transfers in transit")
+	}
+
+	transferUUIDs := make([]uuid.UUID, 0, len(transfers))
+	for _, transfer := range transfers {
+		transferUUIDs = append(transferUUIDs, transfer.ID)
+	}
+	items, err := r.getTransferItems(ctx, transferUUIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]api.Transfer, 0, len(transfers))
+	for _, transfer := range transfers {
+		result = append(result, transfer.ToModelAPITransfer(items[transfer.ID]))
+	}
+
+	return result, nil
+}
+
+// DispatchTransfer отправляет перемещение: товары переходят в статус in_transit.
+// Если какой-то товар успел покинуть хранение, перемещение не отправляется
+func (r *repository) DispatchTransfer(ctx context.Context, transferUUID uuid.UUID, actor models.AuthPrincipal) (api.Transfer, error) {
+	productsQuery := `
+		WITH updated AS (
+			UPDATE shop.products p
+			SET status = 'in_transit', pickup_code_hash = NULL, pickup_attempts = 0
+			FROM shop.transfer_items ti
+			WHERE ti.transfer_id = $1 AND ti.product_id = p.id
+				AND p.status = 'stored' AND p.deleted_at IS NULL AND p.voided_at IS NULL
+			RETURNING p.id
+		), history AS (
+			INSERT INTO shop.product_status_history (product_id, from_status, to_status, actor_id, actor_role)
+			SELECT id, 'stored', 'in_transit', $2, $3 FROM updated
+		)
+		SELECT COUNT(*) FROM updated
+	`
+	query := `
+		UPDATE shop.transfers
+		SET status = 'dispatched', dispatched_by = $1, dispatched_at = NOW()
+		WHERE id = $2 AND status = 'created'
+		RETURNING ` + transferColumns
+
+	return r.moveTransfer(ctx, "DispatchTransfer", transferUUID, func(ctx context.Context) (int, error) {
+		var updated int
+		err := r.conn(ctx).QueryRowContext(ctx, productsQuery, transferUUID, actor.UserUUID, actor.Role).Scan(&updated)
+		return updated, err
+	}, query, actor.UserUUID, transferUUID)
+}
+
+// ReceiveTransfer переводит товары перемещения в ПВЗ назначения в статусе received.
+// Приемка поставки товара не меняется, приемка перемещения связана с товарами через перемещение
+func (r *repository) ReceiveTransfer(
+	ctx context.Context,
+	transferUUID, recUUID uuid.UUID,
+	actor models.AuthPrincipal,
+) (api.Transfer, error) {
+	productsQuery := `
+		WITH updated AS (
+			UPDATE shop.products p
+			SET status = 'received', current_pvz_id = t.destination_pvz_id, cell_id = NULL
+			FROM shop.transfer_items ti
+			JOIN shop.transfers t ON t.id = ti.transfer_id
+			WHERE ti.transfer_id = $1 AND ti.product_id = p.id AND p.status = 'in_transit'
+			RETURNING p.id
+		), history AS (
+			INSERT INTO shop.product_status_history (product_id, from_status, to_status, actor_id, actor_role)
+			SELECT id, 'in_transit', 'received', $2, $3 FROM updated
+		)
+		SELECT COUNT(*) FROM updated
+	`
+	query := `
+		UPDATE shop.transfers
+		SET status = 'received', reception_id = $1, received_by = $2, received_at = NOW()
+		WHERE id = $3 AND status = 'dispatched'
+		RETURNING ` + transferColumns
+
+	return r.moveTransfer(ctx, "ReceiveTransfer", transferUUID, func(ctx context.Context) (int, error) {
+		var updated int
+		err := r.conn(ctx).QueryRowContext(ctx, productsQuery, transferUUID, actor.UserUUID, actor.Role).Scan(&updated)
+		return updated, err
+	}, query, recUUID, actor.UserUUID, transferUUID)
+}
+
+// moveTransfer переводит товары перемещения и само перемещение в следующий статус в одной транзакции,
+// все товары перемещения должны перейти вместе с ним
+func (r *repository) moveTransfer(
+	ctx context.Context,
+	method string,
+	transferUUID uuid.UUID,
+	moveProducts func(ctx context.Context) (int, error),
+	query string,
+	args ...any,
+) (api.Transfer, error) {
+	var transfer models.TransferDB
+	var items []models.TransferItemDB
+	err := r.WithTx(ctx, func(ctx context.Context) error {
+		itemsByTransfer, err := r.getTransferItems(ctx, []uuid.UUID{transferUUID})
+		if err != nil {
+			return err
+		}
+		items = itemsByTransfer[transferUUID]
+
+		updated, err := moveProducts(ctx)
+		if err != nil {
+			log.Logger.Err(err).Str("transfer_id", transferUUID.String()).Msg("method " + method)
+			return errors.New("could not update transfer products status")
+		}
+		if updated != len(items) {
+			return errors.New(internalErrors.ErrProductTransition)
+		}
+
+		err = r.conn(ctx).GetContext(ctx, &transfer, query, args...)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return errors.New(internalErrors.ErrWrongTransferStatus)
+			}
+			log.Logger.Err(err).Str("transfer_id", transferUUID.String()).Msg("method " + method)
+			return errors.New("could not update transfer status")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return api.Transfer{}, err
+	}
+
+	return transfer.ToModelAPITransfer(items), nil
+}
+
+// CreateTransferReception создает в ПВЗ назначения приемку перемещенных товаров,
+// она не конфликтует с открытой приемкой поставки
+func (r *repository) CreateTransferReception(ctx context.Context, pvzUUID uuid.UUID, actor models.AuthPrincipal) (api.Reception, error) {
+	query := `
+		INSERT INTO shop.receptions (pvz_id, status, type, created_by)
+		VALUES ($1, 'in_progress', 'transfer', $2)
+		RETURNING id, pvz_id, created_at, status, type, created_by
+	`
+
+	var inserted models.ReceptionDB
+	err := r.WithTx(ctx, func(ctx context.Context) error {
+		err := r.conn(ctx).QueryRowContext(ctx, query, pvzUUID, actor.UserUUID).
+			Scan(&inserted.ID, &inserted.PvzID, &inserted.CreatedAt, &inserted.Status, &inserted.Type, &inserted.CreatedBy)
+		if err != nil {
+			log.Logger.Err(err).Str("pvz_uuid", pvzUUID.String()).Msg("method CreateTransferReception")
+			return errors.New("could not create transfer reception")
+		}
+
+		return r.insertReceptionStatusChange(ctx, models.ReceptionTransition{
+			ReceptionID: inserted.ID,
+			To:          inserted.Status,
+			ActorID:     actor.UserUUID,
+			ActorRole:   actor.Role,
+		})
+	})
+	if err != nil {
+		return api.Reception{}, err
+	}
+
+	return inserted.ToModelAPIReception(), nil
+}
+
+func (r *repository) getTransferItems(ctx context.Context, transferUUIDs []uuid.UUID) (map[uuid.UUID][]models.TransferItemDB, error) {
+	query := `
+		SELECT transfer_id, product_id, source_reception_id
+		FROM shop.transfer_items
+		WHERE transfer_id = ANY($1)
+		ORDER BY product_id
+	`
+
+	var items []models.TransferItemDB
+	err := r.conn(ctx).SelectContext(ctx, &items, query, pq.Array(transferUUIDs))
+	if err != nil {
+		log.Logger.Err(err).Msg("method getTransferItems")
+		return nil, errors.New("could not get transfer items")
+	}
+
+	itemsByTransfer := make(map[uuid.UUID][]models.TransferItemDB)
+	for _, item := range items {
+		itemsByTransfer[item.TransferID] = append(itemsByTransfer[item.TransferID], item)
+	}
+
+	return itemsByTransfer, nil
+}
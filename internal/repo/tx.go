@@ -0,0 +1,58 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/devWaylander/pvz_store/pkg/log"
+	"github.com/jmoiron/sqlx"
+)
+
+type txKey struct{}
+
+// querier общий набор методов *sqlx.DB и *sqlx.Tx, которым пользуются запросы репозитория
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+	GetContext(ctx context.Context, dest any, query string, args ...any) error
+	SelectContext(ctx context.Context, dest any, query string, args ...any) error
+}
+
+// WithTx выполняет fn в транзакции, переданной через контекст.
+// Все методы репозитория, вызванные с этим контекстом, работают в одной транзакции.
+// Вложенный вызов переиспользует уже открытую транзакцию
+func (r *repository) WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*sqlx.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		log.Logger.Err(err).Msg("method WithTx, BeginTxx")
+		return errors.New("could not begin transaction")
+	}
+	defer tx.Rollback()
+
+	err = fn(context.WithValue(ctx, txKey{}, tx))
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Logger.Err(err).Msg("method WithTx, Commit")
+		return errors.New("could not commit transaction")
+	}
+
+	return nil
+}
+
+// conn возвращает транзакцию из контекста, если она есть, иначе пул соединений
+func (r *repository) conn(ctx context.Context) querier {
+	if tx, ok := ctx.Value(txKey{}).(*sqlx.Tx); ok {
+		return tx
+	}
+
+	return r.db
+}
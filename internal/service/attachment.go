@@ -0,0 +1,158 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/devWaylander/pvz_store/api"
+	internalErrors "github.com/devWaylander/pvz_store/pkg/errors"
+	"github.com/devWaylander/pvz_store/pkg/log"
+	"github.com/devWaylander/pvz_store/pkg/models"
+	"github.com/google/uuid"
+)
+
+// maxAttachmentSize максимальный размер вложения в байтах, совпадает с описанием в swagger
+const maxAttachmentSize = 10 << 20
+
+// maxAttachmentFileNameLength длина имени файла вложения, совпадает с размером колонки file_name
+const maxAttachmentFileNameLength = 255
+
+// attachmentContentTypes допустимые типы вложений, тип определяется по содержимому файла, а не по заголовку клиента
+var attachmentContentTypes = []string{
+	string(api.Imagejpeg),
+	string(api.Imagepng),
+	string(api.Applicationpdf),
+}
+
+/*
+Attachment
+*/
+func (s *service) CreateReceptionAttachment(ctx context.Context, recUUID uuid.UUID, fileName string, r io.Reader) (api.Attachment, error) {
+	if _, err := s.getReceptionByUUID(ctx, recUUID); err != nil {
+		return api.Attachment{}, err
+	}
+
+	return s.createAttachment(ctx, models.AttachmentOwner{ReceptionID: &recUUID}, fileName, r)
+}
+
+func (s *service) CreateProductAttachment(ctx context.Context, productUUID uuid.UUID, fileName string, r io.Reader) (api.Attachment, error) {
+	if _, err := s.getProduct(ctx, productUUID); err != nil {
+		return api.Attachment{}, err
+	}
+
+	return s.createAttachment(ctx, models.AttachmentOwner{ProductID: &productUUID}, fileName, r)
+}
+
+func (s *service) GetReceptionAttachments(ctx context.Context, recUUID uuid.UUID) ([]api.Attachment, error) {
+	if _, err := s.getReceptionByUUID(ctx, recUUID); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetAttachmentsByOwner(ctx, models.AttachmentOwner{ReceptionID: &recUUID})
+}
+
+func (s *service) GetProductAttachments(ctx context.Context, productUUID uuid.UUID) ([]api.Attachment, error) {
+	if _, err := s.getProduct(ctx, productUUID); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetAttachmentsByOwner(ctx, models.AttachmentOwner{ProductID: &productUUID})
+}
+
+// GetAttachmentContent возвращает метаданные вложения и его содержимое, закрыть содержимое должен вызывающий
+func (s *service) GetAttachmentContent(ctx context.Context, attachmentUUID uuid.UUID) (api.Attachment, io.ReadCloser, error) {
+	attachment, key, err := s.repo.GetAttachmentByUUID(ctx, attachmentUUID)
+	if err != nil {
+		return api.Attachment{}, nil, err
+	}
+	if attachment.Id == nil {
+		return api.Attachment{}, nil, errors.New(internalErrors.ErrAttachmentDoesntExist)
+	}
+
+	content, err := s.blobs.Get(ctx, key)
+	if err != nil {
+		return api.Attachment{}, nil, err
+	}
+
+	return attachment, content, nil
+}
+
+// createAttachment проверяет размер и тип файла, сохраняет содержимое в хранилище файлов и записывает метаданные.
+// Если метаданные записать не удалось, содержимое удаляется из хранилища
+func (s *service) createAttachment(ctx context.Context, owner models.AttachmentOwner, fileName string, r io.Reader) (api.Attachment, error) {
+	actor, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.Attachment{}, err
+	}
+
+	// читаем на байт больше лимита, чтобы отличить файл ровно в лимит от превышающего его
+	data, err := io.ReadAll(io.LimitReader(r, maxAttachmentSize+1))
+	if err != nil {
+		log.Logger.Err(err).Msg("method createAttachment")
+		return api.Attachment{}, errors.New("could not read attachment")
+	}
+	if len(data) > maxAttachmentSize {
+		return api.Attachment{}, errors.New(internalErrors.ErrAttachmentTooLarge)
+	}
+	if len(data) == 0 {
+		return api.Attachment{}, errors.New(internalErrors.ErrAttachmentRequired)
+	}
+
+	contentType := http.DetectContentType(data)
+	if !slices.Contains(attachmentContentTypes, contentType) {
+		return api.Attachment{}, errors.New(internalErrors.ErrWrongAttachmentType)
+	}
+
+	id := uuid.New()
+	key := attachmentKey(owner, id)
+	if err := s.blobs.Put(ctx, key, bytes.NewReader(data), int64(len(data)), contentType); err != nil {
+		return api.Attachment{}, err
+	}
+
+	attachment, err := s.repo.CreateAttachment(ctx, models.NewAttachment{
+		ID:          id,
+		Owner:       owner,
+		FileName:    attachmentFileName(fileName),
+		ContentType: contentType,
+		Size:        int64(len(data)),
+		StorageKey:  key,
+		CreatedBy:   actor.UserUUID,
+	})
+	if err != nil {
+		if err := s.blobs.Delete(ctx, key); err != nil {
+			log.Logger.Err(err).Str("key", key).Msg("method createAttachment")
+		}
+		return api.Attachment{}, err
+	}
+
+	return attachment, nil
+}
+
+// attachmentKey ключ содержимого вложения в хранилище файлов, вложения группируются по приемке или товару
+func attachmentKey(owner models.AttachmentOwner, id uuid.UUID) string {
+	if owner.ReceptionID != nil {
+		return fmt.Sprintf("receptions/%s/%s", owner.ReceptionID, id)
+	}
+
+	return fmt.Sprintf("products/%s/%s", owner.ProductID, id)
+}
+
+// attachmentFileName оставляет от присланного имени файла только последний элемент пути
+func attachmentFileName(fileName string) string {
+	name := path.Base(strings.ReplaceAll(strings.TrimSpace(fileName), "\\", "/"))
+	if name == "." || name == "/" {
+		return "attachment"
+	}
+	if len([]rune(name)) > maxAttachmentFileNameLength {
+		name = string([]rune(name)[:maxAttachmentFileNameLength])
+	}
+
+	return name
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/devWaylander/pvz_store/api"
+	internalErrors "github.com/devWaylander/pvz_store/pkg/errors"
+	"github.com/devWaylander/pvz_store/pkg/models"
+	"github.com/google/uuid"
+)
+
+// memoryBlobStore хранилище файлов в памяти для тестов сервиса
+type memoryBlobStore struct {
+	blobs map[string][]byte
+}
+
+func (m *memoryBlobStore) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	m.blobs[key] = data
+	return nil
+}
+
+func (m *memoryBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	data, ok := m.blobs[key]
+	if !ok {
+		return nil, errors.New("could not get blob")
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (m *memoryBlobStore) Delete(ctx context.Context, key string) error {
+	delete(m.blobs, key)
+	return nil
+}
+
+func Test_service_CreateReceptionAttachment(t *testing.T) {
+	recUuid := uuid.New()
+	pdf := "%PDF-1.7\nscanned delivery note"
+
+	tests := []struct {
+		name      string
+		fileName  string
+		content   string
+		repoErr   error
+		wantType  string
+		wantName  string
+		wantErr   string
+		wantBlobs int
+	}{
+		{
+			name:      "PDF is stored with sniffed content type",
+			fileName:  `C:\scans\note.pdf`,
+			content:   pdf,
+			wantType:  "application/pdf",
+			wantName:  "note.pdf",
+			wantBlobs: 1,
+		},
+		{
+			name:     "Content type is sniffed, not taken from file name",
+			fileName: "photo.jpg",
+			content:  "<html>not an image</html>",
+			wantErr:  internalErrors.ErrWrongAttachmentType,
+		},
+		{
+			name:     "File over size limit",
+			fileName: "photo.png",
+			content:  "\x89PNG\r\n\x1a\n" + strings.Repeat("x", maxAttachmentSize),
+			wantErr:  internalErrors.ErrAttachmentTooLarge,
+		},
+		{
+			name:     "Empty file",
+			fileName: "photo.png",
+			wantErr:  internalErrors.ErrAttachmentRequired,
+		},
+		{
+			name:      "Blob is removed when metadata is not saved",
+			fileName:  "note.pdf",
+			content:   pdf,
+			repoErr:   errors.New("could not create attachment"),
+			wantErr:   "could not create attachment",
+			wantBlobs: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blobs := &memoryBlobStore{blobs: map[string][]byte{}}
+			repo := &MockRepository{
+				GetReceptionByUUIDFunc: func(ctx context.Context, id uuid.UUID) (api.Reception, error) {
+					return api.Reception{Id: &id}, nil
+				},
+				CreateAttachmentFunc: func(ctx context.Context, attachment models.NewAttachment) (api.Attachment, error) {
+					if tt.repoErr != nil {
+						return api.Attachment{}, tt.repoErr
+					}
+					if attachment.Owner.ReceptionID == nil || *attachment.Owner.ReceptionID != recUuid {
+						t.Errorf("CreateAttachment() owner = %v, want reception %v", attachment.Owner, recUuid)
+					}
+					return api.Attachment{
+						Id:          &attachment.ID,
+						ReceptionId: attachment.Owner.ReceptionID,
+						FileName:    attachment.FileName,
+						ContentType: api.AttachmentContentType(attachment.ContentType),
+						Size:        int(attachment.Size),
+					}, nil
+				},
+			}
+			s := New(repo, nil, nil, blobs, nil)
+
+			got, err := s.CreateReceptionAttachment(employeeCtx(), recUuid, tt.fileName, strings.NewReader(tt.content))
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("CreateReceptionAttachment() error = %v, want %v", err, tt.wantErr)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("CreateReceptionAttachment() unexpected error = %v", err)
+				}
+				if string(got.ContentType) != tt.wantType || got.FileName != tt.wantName || got.Size != len(tt.content) {
+					t.Errorf("CreateReceptionAttachment() = %+v, want type %v, name %v, size %v", got, tt.wantType, tt.wantName, len(tt.content))
+				}
+			}
+			if len(blobs.blobs) != tt.wantBlobs {
+				t.Errorf("stored blobs = %v, want %v", len(blobs.blobs), tt.wantBlobs)
+			}
+		})
+	}
+}
+
+func Test_service_GetAttachmentContent(t *testing.T) {
+	attachmentUuid := uuid.New()
+	blobs := &memoryBlobStore{blobs: map[string][]byte{"products/1/2": []byte("\x89PNG\r\n\x1a\n")}}
+	repo := &MockRepository{
+		GetAttachmentByUUIDFunc: func(ctx context.Context, id uuid.UUID) (api.Attachment, string, error) {
+			if id != attachmentUuid {
+				return api.Attachment{}, "", nil
+			}
+			return api.Attachment{Id: &id, ContentType: api.Imagepng}, "products/1/2", nil
+		},
+	}
+	s := New(repo, nil, nil, blobs, nil)
+
+	_, content, err := s.GetAttachmentContent(employeeCtx(), attachmentUuid)
+	if err != nil {
+		t.Fatalf("GetAttachmentContent() unexpected error = %v", err)
+	}
+	data, _ := io.ReadAll(content)
+	content.Close()
+	if string(data) != "\x89PNG\r\n\x1a\n" {
+		t.Errorf("GetAttachmentContent() content = %q", data)
+	}
+
+	_, _, err = s.GetAttachmentContent(employeeCtx(), uuid.New())
+	if err == nil || err.Error() != internalErrors.ErrAttachmentDoesntExist {
+		t.Errorf("GetAttachmentContent() error = %v, want %v", err, internalErrors.ErrAttachmentDoesntExist)
+	}
+}
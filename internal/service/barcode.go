@@ -0,0 +1,80 @@
+package service
+
+import "unicode/utf8"
+
+const maxBarcodeLength = 64
+
+// isValidBarcode проверяет формат и контрольный символ штрихкода.
+// Строка из 13 цифр проверяется как EAN-13, остальные строки как Code128 (набор B),
+// последний символ которой является контрольным
+func isValidBarcode(barcode string) bool {
+	if barcode == "" || len(barcode) > maxBarcodeLength || !utf8.ValidString(barcode) {
+		return false
+	}
+
+	if isDigits(barcode) && len(barcode) == 13 {
+		return isValidEAN13(barcode)
+	}
+
+	return isValidCode128(barcode)
+}
+
+// isValidEAN13 сверяет последнюю цифру с контрольной суммой по модулю 10 с весами 1 и 3
+func isValidEAN13(barcode string) bool {
+	sum := 0
+	for i := 0; i < 12; i++ {
+		digit := int(barcode[i] - '0')
+		if i%2 == 1 {
+			digit *= 3
+		}
+		sum += digit
+	}
+
+	return (10-sum%10)%10 == int(barcode[12]-'0')
+}
+
+// isValidCode128 сверяет последний символ с контрольной суммой Code128 по модулю 103.
+// Значения символов 0-94 передаются как ASCII 32-126, значения 95-106 как символы 195-206
+func isValidCode128(barcode string) bool {
+	runes := []rune(barcode)
+	if len(runes) < 2 {
+		return false
+	}
+
+	// стартовый символ Code B имеет значение 104
+	sum := 104
+	for i, r := range runes[:len(runes)-1] {
+		if r < 32 || r > 126 {
+			return false
+		}
+		sum += (i + 1) * int(r-32)
+	}
+
+	check, ok := code128Value(runes[len(runes)-1])
+	if !ok {
+		return false
+	}
+
+	return sum%103 == check
+}
+
+func code128Value(r rune) (int, bool) {
+	switch {
+	case r >= 32 && r <= 126:
+		return int(r - 32), true
+	case r >= 195 && r <= 206:
+		return int(r - 100), true
+	default:
+		return 0, false
+	}
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,27 @@
+package service
+
+import "testing"
+
+func Test_isValidBarcode(t *testing.T) {
+	tests := []struct {
+		name    string
+		barcode string
+		want    bool
+	}{
+		{name: "Valid EAN-13", barcode: "4006381333931", want: true},
+		{name: "EAN-13 with wrong check digit", barcode: "4006381333932", want: false},
+		{name: "Valid Code128", barcode: "ABC!", want: true},
+		{name: "Code128 with wrong check symbol", barcode: "ABC1", want: false},
+		{name: "Code128 with check value above 94", barcode: "PVZ-0003Ê", want: true},
+		{name: "Code128 with control character", barcode: "A\tB", want: false},
+		{name: "Single symbol", barcode: "A", want: false},
+		{name: "Empty", barcode: "", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isValidBarcode(tt.barcode); got != tt.want {
+				t.Errorf("isValidBarcode(%q) = %v, want %v", tt.barcode, got, tt.want)
+			}
+		})
+	}
+}
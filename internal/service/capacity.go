@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/devWaylander/pvz_store/api"
+	internalErrors "github.com/devWaylander/pvz_store/pkg/errors"
+	"github.com/devWaylander/pvz_store/pkg/models"
+	"github.com/google/uuid"
+)
+
+const (
+	// maxProductWeight максимальный вес товара в граммах, совпадает с maximum в swagger
+	maxProductWeight = 1_000_000
+	// maxProductDimension максимальная сторона товара в сантиметрах, совпадает с maximum в swagger.
+	// Объем товара при этом не превышает 10^9 см³ и не переполняет ни int, ни сумму объемов в БД
+	maxProductDimension = 1_000
+)
+
+/*
+Capacity
+*/
+func (s *service) SetPvzLimits(ctx context.Context, pvzUUID uuid.UUID, limits api.PvzLimits) (api.PvzLimits, error) {
+	actor, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.PvzLimits{}, err
+	}
+
+	isPVZExist, err := s.repo.IsPVZExist(ctx, pvzUUID)
+	if err != nil {
+		return api.PvzLimits{}, err
+	}
+	if !isPVZExist {
+		return api.PvzLimits{}, errors.New(internalErrors.ErrPVZDoesntExist)
+	}
+
+	return s.repo.SetPvzLimits(ctx, pvzUUID, limits, actor.UserUUID)
+}
+
+// GetPvzCapacity возвращает ограничения ПВЗ и занятые товарами на руках вес и объем ПВЗ и его ячеек
+func (s *service) GetPvzCapacity(ctx context.Context, pvzUUID uuid.UUID) (api.PvzCapacity, error) {
+	isPVZExist, err := s.repo.IsPVZExist(ctx, pvzUUID)
+	if err != nil {
+		return api.PvzCapacity{}, err
+	}
+	if !isPVZExist {
+		return api.PvzCapacity{}, errors.New(internalErrors.ErrPVZDoesntExist)
+	}
+
+	limits, err := s.repo.GetPvzLimits(ctx, pvzUUID)
+	if err != nil {
+		return api.PvzCapacity{}, err
+	}
+
+	used, err := s.repo.GetPvzCapacityUsage(ctx, pvzUUID)
+	if err != nil {
+		return api.PvzCapacity{}, err
+	}
+
+	cells, err := s.repo.GetCellsCapacityUsage(ctx, pvzUUID)
+	if err != nil {
+		return api.PvzCapacity{}, err
+	}
+	if cells == nil {
+		cells = []api.CellCapacity{}
+	}
+
+	return api.PvzCapacity{
+		PvzId:  pvzUUID,
+		Limits: limits,
+		Used:   used,
+		Cells:  cells,
+	}, nil
+}
+
+// checkPvzLimits проверяет новые товары ПВЗ на лимиты веса и объема в порядке добавления.
+// При onExceed=reject первое превышение возвращается ошибкой, при onExceed=warn превышения
+// возвращаются предупреждениями по каждому товару
+func (s *service) checkPvzLimits(ctx context.Context, pvzUUID uuid.UUID, items []api.ProductBatchItem) ([][]string, error) {
+	limits, err := s.repo.GetPvzLimits(ctx, pvzUUID)
+	if err != nil {
+		return nil, err
+	}
+	if limits.MaxItemWeight == nil && limits.MaxItemVolume == nil && limits.MaxTotalWeight == nil && limits.MaxTotalVolume == nil {
+		return nil, nil
+	}
+
+	var used api.CapacityUsage
+	if limits.MaxTotalWeight != nil || limits.MaxTotalVolume != nil {
+		used, err = s.repo.GetPvzCapacityUsage(ctx, pvzUUID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	warnings := make([][]string, len(items))
+	for i, item := range items {
+		weight := 0
+		if item.Weight != nil {
+			weight = *item.Weight
+		}
+		volume := productVolume(item.Dimensions)
+		used.Weight += weight
+		used.Volume += volume
+
+		var exceeded []string
+		if limits.MaxItemWeight != nil && weight > *limits.MaxItemWeight {
+			exceeded = append(exceeded, internalErrors.ErrItemWeightLimit)
+		}
+		if limits.MaxItemVolume != nil && volume > *limits.MaxItemVolume {
+			exceeded = append(exceeded, internalErrors.ErrItemVolumeLimit)
+		}
+		if limits.MaxTotalWeight != nil && weight > 0 && used.Weight > *limits.MaxTotalWeight {
+			exceeded = append(exceeded, internalErrors.ErrTotalWeightLimit)
+		}
+		if limits.MaxTotalVolume != nil && volume > 0 && used.Volume > *limits.MaxTotalVolume {
+			exceeded = append(exceeded, internalErrors.ErrTotalVolumeLimit)
+		}
+
+		if len(exceeded) > 0 && limits.OnExceed == api.Reject {
+			return nil, errors.New(exceeded[0])
+		}
+		warnings[i] = exceeded
+	}
+
+	return warnings, nil
+}
+
+// attachLimitWarnings добавляет товарам предупреждения о превышенных лимитах ПВЗ
+func attachLimitWarnings(products []api.Product, warnings [][]string) {
+	for i := range products {
+		if i < len(warnings) && len(warnings[i]) > 0 {
+			products[i].Warnings = &warnings[i]
+		}
+	}
+}
+
+// isValidProductPhysical проверяет, что вес и габариты товара, если заданы, лежат в допустимых пределах
+func isValidProductPhysical(weight *int, dimensions *api.ProductDimensions) bool {
+	if weight != nil && (*weight < 1 || *weight > maxProductWeight) {
+		return false
+	}
+	if dimensions == nil {
+		return true
+	}
+
+	for _, side := range []int{dimensions.Length, dimensions.Width, dimensions.Height} {
+		if side < 1 || side > maxProductDimension {
+			return false
+		}
+	}
+
+	return true
+}
+
+// productVolume возвращает объем товара в кубических сантиметрах, товар без габаритов объема не занимает
+func productVolume(dimensions *api.ProductDimensions) int {
+	if dimensions == nil {
+		return 0
+	}
+
+	return dimensions.Length * dimensions.Width * dimensions.Height
+}
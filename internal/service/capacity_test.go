@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/devWaylander/pvz_store/api"
+	internalErrors "github.com/devWaylander/pvz_store/pkg/errors"
+	"github.com/google/uuid"
+)
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func Test_service_checkPvzLimits(t *testing.T) {
+	pvzUuid := uuid.New()
+	heavy := api.ProductBatchItem{Weight: intPtr(25_000), Dimensions: &api.ProductDimensions{Length: 10, Width: 10, Height: 10}}
+	light := api.ProductBatchItem{Weight: intPtr(1_000), Dimensions: &api.ProductDimensions{Length: 10, Width: 10, Height: 10}}
+
+	tests := []struct {
+		name         string
+		limits       api.PvzLimits
+		used         api.CapacityUsage
+		items        []api.ProductBatchItem
+		wantErr      string
+		wantWarnings [][]string
+	}{
+		{
+			name:   "No limits configured",
+			limits: api.PvzLimits{OnExceed: api.Reject},
+			items:  []api.ProductBatchItem{heavy},
+		},
+		{
+			name:    "Reject item over weight limit",
+			limits:  api.PvzLimits{MaxItemWeight: intPtr(20_000), OnExceed: api.Reject},
+			items:   []api.ProductBatchItem{light, heavy},
+			wantErr: internalErrors.ErrItemWeightLimit,
+		},
+		{
+			name:         "Warn item over weight limit",
+			limits:       api.PvzLimits{MaxItemWeight: intPtr(20_000), OnExceed: api.Warn},
+			items:        []api.ProductBatchItem{light, heavy},
+			wantWarnings: [][]string{nil, {internalErrors.ErrItemWeightLimit}},
+		},
+		{
+			name:    "Reject when total volume exceeded",
+			limits:  api.PvzLimits{MaxTotalVolume: intPtr(2_500), OnExceed: api.Reject},
+			used:    api.CapacityUsage{Volume: 1_000},
+			items:   []api.ProductBatchItem{light, light},
+			wantErr: internalErrors.ErrTotalVolumeLimit,
+		},
+		{
+			name:         "Warn when batch crosses total weight",
+			limits:       api.PvzLimits{MaxTotalWeight: intPtr(30_000), OnExceed: api.Warn},
+			used:         api.CapacityUsage{Weight: 5_000},
+			items:        []api.ProductBatchItem{heavy, light, {}},
+			wantWarnings: [][]string{nil, {internalErrors.ErrTotalWeightLimit}, nil},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &MockRepository{
+				GetPvzLimitsFunc: func(ctx context.Context, id uuid.UUID) (api.PvzLimits, error) {
+					return tt.limits, nil
+				},
+				GetPvzCapacityUsageFunc: func(ctx context.Context, id uuid.UUID) (api.CapacityUsage, error) {
+					return tt.used, nil
+				},
+			}
+			s := New(repo, nil, nil, nil, nil)
+
+			got, err := s.checkPvzLimits(employeeCtx(), pvzUuid, tt.items)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Errorf("checkPvzLimits() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("checkPvzLimits() unexpected error = %v", err)
+			}
+			if tt.wantWarnings == nil {
+				if got != nil {
+					t.Errorf("checkPvzLimits() = %v, want no warnings", got)
+				}
+				return
+			}
+			if !slices.EqualFunc(got, tt.wantWarnings, slices.Equal) {
+				t.Errorf("checkPvzLimits() = %v, want %v", got, tt.wantWarnings)
+			}
+		})
+	}
+}
+
+func Test_productVolume(t *testing.T) {
+	if got := productVolume(nil); got != 0 {
+		t.Errorf("productVolume(nil) = %v, want 0", got)
+	}
+	if got := productVolume(&api.ProductDimensions{Length: 20, Width: 30, Height: 40}); got != 24_000 {
+		t.Errorf("productVolume() = %v, want 24000", got)
+	}
+}
+
+func Test_isValidProductPhysical(t *testing.T) {
+	tests := []struct {
+		name       string
+		weight     *int
+		dimensions *api.ProductDimensions
+		want       bool
+	}{
+		{
+			name: "Without weight and dimensions",
+			want: true,
+		},
+		{
+			name:       "Maximum weight and dimensions",
+			weight:     intPtr(maxProductWeight),
+			dimensions: &api.ProductDimensions{Length: maxProductDimension, Width: maxProductDimension, Height: maxProductDimension},
+			want:       true,
+		},
+		{
+			name:   "Weight above maximum",
+			weight: intPtr(maxProductWeight + 1),
+		},
+		{
+			name:       "Zero side",
+			dimensions: &api.ProductDimensions{Length: 10, Width: 0, Height: 10},
+		},
+		{
+			// такой объем переполняет int и уходит в минус, проходя проверки лимитов
+			name:       "Sides overflow volume",
+			dimensions: &api.ProductDimensions{Length: 3_000_000, Width: 3_000_000, Height: 3_000_000},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isValidProductPhysical(tt.weight, tt.dimensions); got != tt.want {
+				t.Errorf("isValidProductPhysical() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_service_CreateProducts_physicalOutOfRange(t *testing.T) {
+	repo := &MockRepository{
+		GetPvzLimitsFunc: func(ctx context.Context, id uuid.UUID) (api.PvzLimits, error) {
+			t.Fatal("limits must not be checked for product out of range")
+			return api.PvzLimits{}, nil
+		},
+	}
+	s := New(repo, nil, nil, nil, nil)
+
+	_, err := s.CreateProducts(employeeCtx(), api.PostProductsBatchJSONBody{
+		PvzId: uuid.New(),
+		Items: []api.ProductBatchItem{
+			{Type: "электроника", Dimensions: &api.ProductDimensions{Length: 3_000_000, Width: 3_000_000, Height: 3_000_000}},
+		},
+	})
+	if err == nil || err.Error() != internalErrors.ErrWrongProductPhysical {
+		t.Errorf("CreateProducts() error = %v, want %v", err, internalErrors.ErrWrongProductPhysical)
+	}
+}
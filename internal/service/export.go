@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/devWaylander/pvz_store/api"
+	internalErrors "github.com/devWaylander/pvz_store/pkg/errors"
+	"github.com/devWaylander/pvz_store/pkg/models"
+)
+
+/*
+Export
+*/
+// ExportPVZs проверяет параметры выгрузки ПВЗ и возвращает функцию, которая пишет в w заголовок и строки по мере чтения из БД.
+// Ошибки параметров возвращаются до начала выгрузки, пока ответ еще не отправлен
+func (s *service) ExportPVZs(ctx context.Context, params api.GetExportPvzParams) (func(w models.RowWriter) error, error) {
+	if err := validateDateRange(params.StartDate, params.EndDate); err != nil {
+		return nil, err
+	}
+
+	return func(w models.RowWriter) error {
+		if err := w.Write(models.PvzExportHeader); err != nil {
+			return err
+		}
+
+		return s.repo.ExportPVZs(ctx, params.StartDate, params.EndDate, func(row models.PvzExportRowDB) error {
+			return w.Write(row.Record())
+		})
+	}, nil
+}
+
+// ExportReceptions проверяет фильтры выгрузки приемок и возвращает функцию, которая пишет в w заголовок и строки по мере чтения из БД
+func (s *service) ExportReceptions(ctx context.Context, params api.GetExportReceptionsParams) (func(w models.RowWriter) error, error) {
+	if err := validateDateRange(params.StartDate, params.EndDate); err != nil {
+		return nil, err
+	}
+
+	filter := models.ReceptionFilter{
+		PvzID:     params.PvzId,
+		CreatedBy: params.CreatedBy,
+		StartDate: params.StartDate,
+		EndDate:   params.EndDate,
+	}
+	if params.Status != nil {
+		status := string(*params.Status)
+		filter.Status = &status
+	}
+
+	return func(w models.RowWriter) error {
+		if err := w.Write(models.ReceptionExportHeader); err != nil {
+			return err
+		}
+
+		return s.repo.ExportReceptions(ctx, filter, func(row models.ReceptionExportRowDB) error {
+			return w.Write(row.Record())
+		})
+	}, nil
+}
+
+func validateDateRange(startDate, endDate *time.Time) error {
+	if startDate != nil && endDate != nil && startDate.After(*endDate) {
+		return errors.New(internalErrors.ErrWrongDateRange)
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/devWaylander/pvz_store/api"
+	internalErrors "github.com/devWaylander/pvz_store/pkg/errors"
+	"github.com/devWaylander/pvz_store/pkg/models"
+	"github.com/google/uuid"
+)
+
+// recordsWriter собирает строки выгрузки в памяти
+type recordsWriter struct {
+	records [][]string
+}
+
+func (w *recordsWriter) Write(record []string) error {
+	w.records = append(w.records, record)
+	return nil
+}
+
+func Test_service_ExportPVZs(t *testing.T) {
+	pvzUuid := uuid.New()
+	registered := time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC)
+	repo := &MockRepository{
+		ExportPVZsFunc: func(ctx context.Context, startDate, endDate *time.Time, fn func(row models.PvzExportRowDB) error) error {
+			return fn(models.PvzExportRowDB{PvzID: pvzUuid, City: "Казань", RegistrationDate: registered})
+		},
+	}
+	s := New(repo, nil, nil, nil, nil)
+
+	write, err := s.ExportPVZs(employeeCtx(), api.GetExportPvzParams{})
+	if err != nil {
+		t.Fatalf("ExportPVZs() unexpected error = %v", err)
+	}
+
+	w := &recordsWriter{}
+	if err := write(w); err != nil {
+		t.Fatalf("ExportPVZs() write error = %v", err)
+	}
+
+	want := [][]string{
+		models.PvzExportHeader,
+		{pvzUuid.String(), "Казань", "2025-04-01T10:00:00Z", "", "", "", "", "", "", "", "", ""},
+	}
+	if !reflect.DeepEqual(w.records, want) {
+		t.Errorf("ExportPVZs() records = %v, want %v", w.records, want)
+	}
+
+	start, end := registered, registered.Add(-time.Hour)
+	_, err = s.ExportPVZs(employeeCtx(), api.GetExportPvzParams{StartDate: &start, EndDate: &end})
+	if err == nil || err.Error() != internalErrors.ErrWrongDateRange {
+		t.Errorf("ExportPVZs() error = %v, want %v", err, internalErrors.ErrWrongDateRange)
+	}
+}
+
+func Test_service_ExportReceptions(t *testing.T) {
+	pvzUuid := uuid.New()
+	status := api.ReceptionStatusClosed
+
+	var gotFilter models.ReceptionFilter
+	repo := &MockRepository{
+		ExportReceptionsFunc: func(ctx context.Context, filter models.ReceptionFilter, fn func(row models.ReceptionExportRowDB) error) error {
+			gotFilter = filter
+			return nil
+		},
+	}
+	s := New(repo, nil, nil, nil, nil)
+
+	write, err := s.ExportReceptions(employeeCtx(), api.GetExportReceptionsParams{PvzId: &pvzUuid, Status: &status})
+	if err != nil {
+		t.Fatalf("ExportReceptions() unexpected error = %v", err)
+	}
+
+	w := &recordsWriter{}
+	if err := write(w); err != nil {
+		t.Fatalf("ExportReceptions() write error = %v", err)
+	}
+
+	// выгрузка не постраничная, поэтому страница и размер страницы не задаются
+	wantFilter := models.ReceptionFilter{PvzID: &pvzUuid, Status: (*string)(&status)}
+	if !reflect.DeepEqual(gotFilter, wantFilter) {
+		t.Errorf("ExportReceptions() filter = %+v, want %+v", gotFilter, wantFilter)
+	}
+	if len(w.records) != 1 || !reflect.DeepEqual(w.records[0], models.ReceptionExportHeader) {
+		t.Errorf("ExportReceptions() records = %v, want only header", w.records)
+	}
+}
@@ -0,0 +1,273 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/devWaylander/pvz_store/api"
+	internalErrors "github.com/devWaylander/pvz_store/pkg/errors"
+	"github.com/devWaylander/pvz_store/pkg/models"
+	"github.com/google/uuid"
+)
+
+/*
+Manifest
+*/
+func (s *service) CreateManifest(ctx context.Context, data api.PostManifestsJSONBody) (api.Manifest, error) {
+	if strings.TrimSpace(data.Supplier) == "" || !isValidManifestItems(data.Items) {
+		return api.Manifest{}, errors.New(internalErrors.ErrWrongManifestItems)
+	}
+
+	actor, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.Manifest{}, err
+	}
+
+	isPVZExist, err := s.repo.IsPVZExist(ctx, data.PvzId)
+	if err != nil {
+		return api.Manifest{}, err
+	}
+	if !isPVZExist {
+		return api.Manifest{}, errors.New(internalErrors.ErrPVZDoesntExist)
+	}
+
+	for _, item := range data.Items {
+		productType, err := s.repo.GetProductTypeByName(ctx, item.Type)
+		if err != nil {
+			return api.Manifest{}, err
+		}
+		if productType.Name == "" {
+			return api.Manifest{}, errors.New(internalErrors.ErrProductTypeDoesntExist)
+		}
+	}
+
+	strict := false
+	if data.Strict != nil {
+		strict = *data.Strict
+	}
+
+	return s.repo.CreateManifest(ctx, data.PvzId, data.Supplier, strict, data.Items, actor.UserUUID)
+}
+
+func (s *service) GetManifest(ctx context.Context, manifestUUID uuid.UUID) (api.Manifest, error) {
+	manifest, err := s.repo.GetManifestByUUID(ctx, manifestUUID)
+	if err != nil {
+		return api.Manifest{}, err
+	}
+	if manifest.Id == nil {
+		return api.Manifest{}, errors.New(internalErrors.ErrManifestDoesntExist)
+	}
+
+	return manifest, nil
+}
+
+func (s *service) GetDiscrepancyReport(ctx context.Context, recUUID uuid.UUID) (api.DiscrepancyReport, error) {
+	if _, err := s.getReceptionByUUID(ctx, recUUID); err != nil {
+		return api.DiscrepancyReport{}, err
+	}
+
+	report, err := s.repo.GetDiscrepancyReportByReceptionUUID(ctx, recUUID)
+	if err != nil {
+		return api.DiscrepancyReport{}, err
+	}
+	if report.Id == nil {
+		return api.DiscrepancyReport{}, errors.New(internalErrors.ErrDiscrepancyReportDoesntExist)
+	}
+
+	return report, nil
+}
+
+// manifestReconciliation итог сверки приемки с манифестами ПВЗ
+type manifestReconciliation struct {
+	manifests []api.Manifest
+	report    api.DiscrepancyReport
+}
+
+// reconcileManifests сверяет приемку с манифестами ПВЗ перед закрытием. Без манифестов сверки нет и возвращается nil.
+// При расхождениях и строгом манифесте закрытие возможно только с подтверждением модератора (override)
+func (s *service) reconcileManifests(ctx context.Context, rec api.Reception, override bool) (*manifestReconciliation, error) {
+	manifests, err := s.repo.GetManifestsToReconcile(ctx, rec.PvzId, *rec.Id)
+	if err != nil {
+		return nil, err
+	}
+	if len(manifests) == 0 {
+		return nil, nil
+	}
+
+	counts, err := s.repo.GetProductCountsByReceptionUUID(ctx, *rec.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var skuTypes map[string]string
+	if skus := manifestSKUs(manifests); len(skus) > 0 {
+		skuTypes, err = s.repo.GetProductTypesByReceptionBarcodes(ctx, *rec.Id, skus)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	report := buildDiscrepancyReport(*rec.Id, manifests, counts, skuTypes)
+	strict := slices.ContainsFunc(manifests, func(m api.Manifest) bool { return m.Strict })
+	if report.HasDiscrepancies && strict && !override {
+		return nil, errors.New(internalErrors.ErrReceptionDiscrepancy)
+	}
+
+	return &manifestReconciliation{manifests: manifests, report: report}, nil
+}
+
+// saveManifestReconciliation сохраняет отчет о расхождениях закрытой приемки и отмечает её манифесты полученными
+func (s *service) saveManifestReconciliation(ctx context.Context, rec api.Reception, reconciliation *manifestReconciliation, override bool, reason string) error {
+	if reconciliation == nil {
+		return nil
+	}
+
+	report := reconciliation.report
+	if override {
+		actor, err := models.GetAuthPrincipal(ctx)
+		if err != nil {
+			return err
+		}
+		report.Overridden = true
+		report.OverriddenBy = &actor.UserUUID
+		report.OverrideReason = &reason
+	}
+
+	if _, err := s.repo.SaveDiscrepancyReport(ctx, report); err != nil {
+		return err
+	}
+
+	manifestUUIDs := make([]uuid.UUID, 0, len(reconciliation.manifests))
+	for _, manifest := range reconciliation.manifests {
+		manifestUUIDs = append(manifestUUIDs, *manifest.Id)
+	}
+
+	return s.repo.MarkManifestsReceived(ctx, manifestUUIDs, *rec.Id)
+}
+
+// discrepancyKey строка сверки: тип товара и штрихкод, для строк по типу штрихкод пустой
+type discrepancyKey struct {
+	prType string
+	sku    string
+}
+
+// buildDiscrepancyReport сравнивает ожидаемые по манифестам количества товаров с фактически принятыми.
+// Товар, найденный по штрихкоду строки манифеста того же типа, засчитывается этой строке и не учитывается в строке его типа
+func buildDiscrepancyReport(recUUID uuid.UUID, manifests []api.Manifest, counts map[string]int, skuTypes map[string]string) api.DiscrepancyReport {
+	expected := make(map[discrepancyKey]int)
+	for _, manifest := range manifests {
+		for _, item := range manifest.Items {
+			key := discrepancyKey{prType: item.Type}
+			if item.Sku != nil {
+				key.sku = *item.Sku
+			}
+			expected[key] += item.Count
+		}
+	}
+
+	actual := make(map[discrepancyKey]int, len(counts))
+	for prType, count := range counts {
+		actual[discrepancyKey{prType: prType}] = count
+	}
+	for key := range expected {
+		if key.sku == "" || skuTypes[key.sku] != key.prType {
+			continue
+		}
+		actual[key] = 1
+		typeKey := discrepancyKey{prType: key.prType}
+		if actual[typeKey]--; actual[typeKey] <= 0 {
+			delete(actual, typeKey)
+		}
+	}
+
+	keys := make([]discrepancyKey, 0, len(expected)+len(actual))
+	for key := range expected {
+		keys = append(keys, key)
+	}
+	for key := range actual {
+		if _, ok := expected[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.SortFunc(keys, func(a, b discrepancyKey) int {
+		if c := strings.Compare(a.prType, b.prType); c != 0 {
+			return c
+		}
+		return strings.Compare(a.sku, b.sku)
+	})
+
+	report := api.DiscrepancyReport{
+		ReceptionId: recUUID,
+		Items:       make([]api.DiscrepancyItem, 0, len(keys)),
+	}
+	for _, key := range keys {
+		item := api.DiscrepancyItem{
+			Type:     key.prType,
+			Expected: expected[key],
+			Actual:   actual[key],
+		}
+		if key.sku != "" {
+			item.Sku = &key.sku
+		}
+		switch {
+		case item.Expected == item.Actual:
+			item.Kind = api.Matched
+		case item.Expected == 0:
+			item.Kind = api.Extra
+		case item.Actual == 0:
+			item.Kind = api.Missing
+		default:
+			item.Kind = api.Mismatched
+		}
+		if item.Kind != api.Matched {
+			report.HasDiscrepancies = true
+		}
+		report.Items = append(report.Items, item)
+	}
+
+	return report
+}
+
+// manifestSKUs возвращает штрихкоды строк манифестов без повторов
+func manifestSKUs(manifests []api.Manifest) []string {
+	var skus []string
+	for _, manifest := range manifests {
+		for _, item := range manifest.Items {
+			if item.Sku != nil && !slices.Contains(skus, *item.Sku) {
+				skus = append(skus, *item.Sku)
+			}
+		}
+	}
+
+	return skus
+}
+
+// isValidManifestItems проверяет, что строки манифеста (тип и штрихкод) не повторяются, а количества положительны.
+// Штрихкод уникален среди товаров на складе, поэтому строка по штрихкоду ожидает ровно один товар
+func isValidManifestItems(items []api.ManifestItem) bool {
+	if len(items) == 0 {
+		return false
+	}
+
+	seen := make(map[discrepancyKey]struct{}, len(items))
+	for _, item := range items {
+		if item.Count < 1 {
+			return false
+		}
+		key := discrepancyKey{prType: item.Type}
+		if item.Sku != nil {
+			if item.Count != 1 || !isValidBarcode(*item.Sku) {
+				return false
+			}
+			key.sku = *item.Sku
+		}
+		if _, ok := seen[key]; ok {
+			return false
+		}
+		seen[key] = struct{}{}
+	}
+
+	return true
+}
@@ -0,0 +1,262 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/devWaylander/pvz_store/api"
+	internalErrors "github.com/devWaylander/pvz_store/pkg/errors"
+	"github.com/devWaylander/pvz_store/pkg/models"
+	"github.com/google/uuid"
+)
+
+func Test_buildDiscrepancyReport(t *testing.T) {
+	recUuid := uuid.New()
+	sku, otherSku := "4006381333931", "ABC!"
+
+	tests := []struct {
+		name      string
+		manifests []api.Manifest
+		actual    map[string]int
+		skuTypes  map[string]string
+		want      api.DiscrepancyReport
+	}{
+		{
+			name: "All matched",
+			manifests: []api.Manifest{
+				{Items: []api.ManifestItem{{Type: "обувь", Count: 2}}},
+			},
+			actual: map[string]int{"обувь": 2},
+			want: api.DiscrepancyReport{
+				ReceptionId: recUuid,
+				Items: []api.DiscrepancyItem{
+					{Type: "обувь", Expected: 2, Actual: 2, Kind: api.Matched},
+				},
+			},
+		},
+		{
+			name: "Missing, extra and mismatched",
+			manifests: []api.Manifest{
+				{Items: []api.ManifestItem{{Type: "обувь", Count: 2}, {Type: "одежда", Count: 1}}},
+				{Items: []api.ManifestItem{{Type: "обувь", Count: 1}}},
+			},
+			actual: map[string]int{"обувь": 1, "электроника": 4},
+			want: api.DiscrepancyReport{
+				ReceptionId:      recUuid,
+				HasDiscrepancies: true,
+				Items: []api.DiscrepancyItem{
+					{Type: "обувь", Expected: 3, Actual: 1, Kind: api.Mismatched},
+					{Type: "одежда", Expected: 1, Actual: 0, Kind: api.Missing},
+					{Type: "электроника", Expected: 0, Actual: 4, Kind: api.Extra},
+				},
+			},
+		},
+		{
+			name: "SKU lines matched by barcode",
+			manifests: []api.Manifest{
+				{Items: []api.ManifestItem{
+					{Type: "обувь", Count: 1},
+					{Type: "обувь", Sku: &sku, Count: 1},
+					{Type: "одежда", Sku: &otherSku, Count: 1},
+				}},
+			},
+			actual:   map[string]int{"обувь": 2, "электроника": 1},
+			skuTypes: map[string]string{sku: "обувь", otherSku: "электроника"},
+			want: api.DiscrepancyReport{
+				ReceptionId:      recUuid,
+				HasDiscrepancies: true,
+				Items: []api.DiscrepancyItem{
+					{Type: "обувь", Expected: 1, Actual: 1, Kind: api.Matched},
+					{Type: "обувь", Sku: &sku, Expected: 1, Actual: 1, Kind: api.Matched},
+					{Type: "одежда", Sku: &otherSku, Expected: 1, Actual: 0, Kind: api.Missing},
+					{Type: "электроника", Expected: 0, Actual: 1, Kind: api.Extra},
+				},
+			},
+		},
+		{
+			name: "SKU line consumes the only product of its type",
+			manifests: []api.Manifest{
+				{Items: []api.ManifestItem{{Type: "обувь", Sku: &sku, Count: 1}}},
+			},
+			actual:   map[string]int{"обувь": 1},
+			skuTypes: map[string]string{sku: "обувь"},
+			want: api.DiscrepancyReport{
+				ReceptionId: recUuid,
+				Items: []api.DiscrepancyItem{
+					{Type: "обувь", Sku: &sku, Expected: 1, Actual: 1, Kind: api.Matched},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildDiscrepancyReport(recUuid, tt.manifests, tt.actual, tt.skuTypes)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("buildDiscrepancyReport() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_service_CreateManifest(t *testing.T) {
+	pvzUuid := uuid.New()
+	sku, wrongSku := "4006381333931", "4006381333932"
+
+	repo := &MockRepository{
+		IsPVZExistFunc: func(ctx context.Context, id uuid.UUID) (bool, error) {
+			return true, nil
+		},
+		CreateManifestFunc: func(ctx context.Context, pvzUUID uuid.UUID, supplier string, strict bool, items []api.ManifestItem, createdBy uuid.UUID) (api.Manifest, error) {
+			return api.Manifest{PvzId: pvzUUID, Supplier: supplier, Strict: strict, Status: api.ManifestStatusExpected, Items: items}, nil
+		},
+	}
+
+	tests := []struct {
+		name    string
+		data    api.PostManifestsJSONBody
+		wantErr string
+	}{
+		{
+			name: "Create Manifest",
+			data: api.PostManifestsJSONBody{PvzId: pvzUuid, Supplier: "ООО Поставщик", Items: []api.ManifestItem{{Type: "обувь", Count: 3}}},
+		},
+		{
+			name:    "Duplicate item type",
+			data:    api.PostManifestsJSONBody{PvzId: pvzUuid, Supplier: "ООО Поставщик", Items: []api.ManifestItem{{Type: "обувь", Count: 1}, {Type: "обувь", Count: 2}}},
+			wantErr: internalErrors.ErrWrongManifestItems,
+		},
+		{
+			name: "Create Manifest with SKU line",
+			data: api.PostManifestsJSONBody{PvzId: pvzUuid, Supplier: "ООО Поставщик", Items: []api.ManifestItem{{Type: "обувь", Count: 3}, {Type: "обувь", Sku: &sku, Count: 1}}},
+		},
+		{
+			name:    "Duplicate SKU line",
+			data:    api.PostManifestsJSONBody{PvzId: pvzUuid, Supplier: "ООО Поставщик", Items: []api.ManifestItem{{Type: "обувь", Sku: &sku, Count: 1}, {Type: "обувь", Sku: &sku, Count: 1}}},
+			wantErr: internalErrors.ErrWrongManifestItems,
+		},
+		{
+			name:    "SKU line expects more than one product",
+			data:    api.PostManifestsJSONBody{PvzId: pvzUuid, Supplier: "ООО Поставщик", Items: []api.ManifestItem{{Type: "обувь", Sku: &sku, Count: 2}}},
+			wantErr: internalErrors.ErrWrongManifestItems,
+		},
+		{
+			name:    "SKU with wrong check digit",
+			data:    api.PostManifestsJSONBody{PvzId: pvzUuid, Supplier: "ООО Поставщик", Items: []api.ManifestItem{{Type: "обувь", Sku: &wrongSku, Count: 1}}},
+			wantErr: internalErrors.ErrWrongManifestItems,
+		},
+		{
+			name:    "Empty supplier",
+			data:    api.PostManifestsJSONBody{PvzId: pvzUuid, Supplier: " ", Items: []api.ManifestItem{{Type: "обувь", Count: 1}}},
+			wantErr: internalErrors.ErrWrongManifestItems,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &service{repo: repo}
+			_, err := s.CreateManifest(moderatorCtx(), tt.data)
+			if tt.wantErr == "" && err != nil {
+				t.Errorf("service.CreateManifest() unexpected error = %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || err.Error() != tt.wantErr) {
+				t.Errorf("service.CreateManifest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_service_CloseReception_WithManifests(t *testing.T) {
+	recUuid := uuid.New()
+	pvzUuid := uuid.New()
+	manifestUuid := uuid.New()
+
+	newRepo := func(strict bool, saved *api.DiscrepancyReport) *MockRepository {
+		return &MockRepository{
+			IsPVZExistFunc: func(ctx context.Context, id uuid.UUID) (bool, error) {
+				return true, nil
+			},
+			GetReceptionByPvzUUIDFunc: func(ctx context.Context, pvzUUID uuid.UUID) (api.Reception, error) {
+				return api.Reception{Id: &recUuid, PvzId: pvzUuid, Status: api.ReceptionStatusInProgress}, nil
+			},
+			GetReceptionByUUIDFunc: func(ctx context.Context, recUUID uuid.UUID) (api.Reception, error) {
+				return api.Reception{Id: &recUuid, PvzId: pvzUuid, Status: api.ReceptionStatusInProgress}, nil
+			},
+			GetManifestsToReconcileFunc: func(ctx context.Context, pvzUUID, recUUID uuid.UUID) ([]api.Manifest, error) {
+				return []api.Manifest{{
+					Id:     &manifestUuid,
+					Strict: strict,
+					Items:  []api.ManifestItem{{Type: "обувь", Count: 2}},
+				}}, nil
+			},
+			GetProductCountsByReceptionUUIDFunc: func(ctx context.Context, recUUID uuid.UUID) (map[string]int, error) {
+				return map[string]int{"обувь": 1}, nil
+			},
+			UpdateReceptionStatusFunc: func(ctx context.Context, transition models.ReceptionTransition) error {
+				return nil
+			},
+			SaveDiscrepancyReportFunc: func(ctx context.Context, report api.DiscrepancyReport) (api.DiscrepancyReport, error) {
+				*saved = report
+				return report, nil
+			},
+			MarkManifestsReceivedFunc: func(ctx context.Context, manifestUUIDs []uuid.UUID, recUUID uuid.UUID) error {
+				if len(manifestUUIDs) != 1 || manifestUUIDs[0] != manifestUuid {
+					return errors.New("unexpected manifests")
+				}
+				return nil
+			},
+		}
+	}
+
+	t.Run("Non-strict manifest closes with report", func(t *testing.T) {
+		var saved api.DiscrepancyReport
+		s := &service{repo: newRepo(false, &saved)}
+
+		got, err := s.CloseReception(employeeCtx(), pvzUuid)
+		if err != nil {
+			t.Fatalf("service.CloseReception() error = %v", err)
+		}
+		if got.Status != api.ReceptionStatusClosed {
+			t.Errorf("service.CloseReception() status = %v, want %v", got.Status, api.ReceptionStatusClosed)
+		}
+		if !saved.HasDiscrepancies || saved.Overridden {
+			t.Errorf("saved report = %+v, want discrepancies without override", saved)
+		}
+	})
+
+	t.Run("Strict manifest blocks close", func(t *testing.T) {
+		var saved api.DiscrepancyReport
+		s := &service{repo: newRepo(true, &saved)}
+
+		_, err := s.CloseReception(employeeCtx(), pvzUuid)
+		if err == nil || err.Error() != internalErrors.ErrReceptionDiscrepancy {
+			t.Errorf("service.CloseReception() error = %v, want %v", err, internalErrors.ErrReceptionDiscrepancy)
+		}
+	})
+
+	t.Run("Moderator override closes strict manifest", func(t *testing.T) {
+		var saved api.DiscrepancyReport
+		s := &service{repo: newRepo(true, &saved)}
+
+		got, err := s.CloseReceptionWithOverride(moderatorCtx(), recUuid, "поставщик подтвердил недовоз")
+		if err != nil {
+			t.Fatalf("service.CloseReceptionWithOverride() error = %v", err)
+		}
+		if got.Status != api.ReceptionStatusClosed {
+			t.Errorf("service.CloseReceptionWithOverride() status = %v, want %v", got.Status, api.ReceptionStatusClosed)
+		}
+		if !saved.Overridden || saved.OverriddenBy == nil || saved.OverrideReason == nil {
+			t.Errorf("saved report = %+v, want override details", saved)
+		}
+	})
+
+	t.Run("Override requires reason", func(t *testing.T) {
+		var saved api.DiscrepancyReport
+		s := &service{repo: newRepo(true, &saved)}
+
+		_, err := s.CloseReceptionWithOverride(moderatorCtx(), recUuid, "")
+		if err == nil || err.Error() != internalErrors.ErrReasonRequired {
+			t.Errorf("service.CloseReceptionWithOverride() error = %v, want %v", err, internalErrors.ErrReasonRequired)
+		}
+	})
+}
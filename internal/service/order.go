@@ -0,0 +1,302 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/devWaylander/pvz_store/api"
+	internalErrors "github.com/devWaylander/pvz_store/pkg/errors"
+	"github.com/devWaylander/pvz_store/pkg/log"
+	"github.com/devWaylander/pvz_store/pkg/models"
+	"github.com/google/uuid"
+)
+
+const (
+	// maxOrderItems максимальное число позиций в заказе, совпадает с maxItems в swagger
+	maxOrderItems = 100
+	// maxPickupCodeAttempts число неверных попыток ввода кода, после которого нужен новый код
+	maxPickupCodeAttempts = 5
+)
+
+/*
+Order
+*/
+// CreateOrder регистрирует заказ и сразу сопоставляет с ним товары, которые уже на руках ПВЗ
+func (s *service) CreateOrder(ctx context.Context, data api.PostOrdersJSONBody) (api.Order, error) {
+	number := strings.TrimSpace(data.Number)
+	if number == "" || !isValidOrderBarcodes(data.Barcodes) {
+		return api.Order{}, errors.New(internalErrors.ErrWrongOrderItems)
+	}
+
+	phone := trimmedOrNil(data.RecipientPhone)
+	var email *string
+	if data.RecipientEmail != nil {
+		email = trimmedOrNil((*string)(data.RecipientEmail))
+	}
+	if phone == nil && email == nil {
+		return api.Order{}, errors.New(internalErrors.ErrWrongOrderRecipient)
+	}
+
+	actor, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.Order{}, err
+	}
+
+	var order api.Order
+	err = s.repo.WithTx(ctx, func(ctx context.Context) error {
+		isPVZExist, err := s.repo.IsPVZExist(ctx, data.PvzId)
+		if err != nil {
+			return err
+		}
+		if !isPVZExist {
+			return errors.New(internalErrors.ErrPVZDoesntExist)
+		}
+
+		order, err = s.repo.CreateOrder(ctx, data.PvzId, number, phone, email, data.Barcodes, actor.UserUUID)
+		if err != nil {
+			return err
+		}
+
+		inStock, err := s.repo.GetInStockProductsByBarcodes(ctx, data.Barcodes)
+		if err != nil {
+			return err
+		}
+		if len(inStock) == 0 {
+			return nil
+		}
+		if err := s.matchOrderItems(ctx, inStock); err != nil {
+			return err
+		}
+		if err := s.prepareReadyOrders(ctx, data.PvzId); err != nil {
+			return err
+		}
+
+		order, err = s.getOrder(ctx, *order.Id)
+		return err
+	})
+	if err != nil {
+		return api.Order{}, err
+	}
+
+	return order, nil
+}
+
+func (s *service) GetOrder(ctx context.Context, orderUUID uuid.UUID) (api.Order, error) {
+	order, err := s.repo.GetOrderByUUID(ctx, orderUUID)
+	if err != nil {
+		return api.Order{}, err
+	}
+	if order.Id == nil {
+		return api.Order{}, errors.New(internalErrors.ErrOrderDoesntExist)
+	}
+
+	return order, nil
+}
+
+// IssueOrder выдает все товары готового заказа получателю, назвавшему код выдачи.
+// Неверная попытка сохраняется, после maxPickupCodeAttempts попыток код перестает приниматься
+func (s *service) IssueOrder(ctx context.Context, orderUUID uuid.UUID, pickupCode string) (api.Order, error) {
+	actor, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.Order{}, err
+	}
+
+	var order api.Order
+	wrongCode := false
+	err = s.repo.WithTx(ctx, func(ctx context.Context) error {
+		order, err = s.getOrder(ctx, orderUUID)
+		if err != nil {
+			return err
+		}
+		if order.Status != api.Ready {
+			return errors.New(internalErrors.ErrWrongOrderStatus)
+		}
+		if order.PickupAttempts >= maxPickupCodeAttempts {
+			return errors.New(internalErrors.ErrPickupAttemptsExceeded)
+		}
+
+		codeHash, err := s.repo.GetOrderPickupCodeHash(ctx, orderUUID)
+		if err != nil {
+			return err
+		}
+		if codeHash == "" || !s.checkPickupCode(codeHash, pickupCode) {
+			// попытка должна сохраниться, поэтому транзакция фиксируется, а ошибка возвращается после неё
+			wrongCode = true
+			return s.repo.RecordPickupAttempt(ctx, orderUUID)
+		}
+
+		for _, item := range order.Items {
+			if item.ProductId == nil {
+				return errors.New(internalErrors.ErrWrongOrderStatus)
+			}
+			err := s.repo.UpdateProductStatus(ctx, models.ProductTransition{
+				ProductID: *item.ProductId,
+				From:      string(api.ProductStatusStored),
+				To:        string(api.ProductStatusIssued),
+				ActorID:   actor.UserUUID,
+				ActorRole: actor.Role,
+				Reason:    "выдача заказа " + order.Number,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		order, err = s.repo.IssueOrder(ctx, orderUUID, actor.UserUUID)
+		return err
+	})
+	if err != nil {
+		return api.Order{}, err
+	}
+	if wrongCode {
+		return api.Order{}, errors.New(internalErrors.ErrWrongPickupCode)
+	}
+
+	return order, nil
+}
+
+// RegenerateOrderPickupCode выпускает готовому заказу новый код выдачи и сбрасывает счетчик попыток
+func (s *service) RegenerateOrderPickupCode(ctx context.Context, orderUUID uuid.UUID) (api.Order, error) {
+	var order api.Order
+	err := s.repo.WithTx(ctx, func(ctx context.Context) error {
+		current, err := s.getOrder(ctx, orderUUID)
+		if err != nil {
+			return err
+		}
+		if current.Status != api.Ready {
+			return errors.New(internalErrors.ErrWrongOrderStatus)
+		}
+
+		order, err = s.issueOrderPickupCode(ctx, current)
+		return err
+	})
+	if err != nil {
+		return api.Order{}, err
+	}
+
+	return order, nil
+}
+
+// getOrder возвращает заказ, блокируя его до конца транзакции, вызывается только внутри WithTx
+func (s *service) getOrder(ctx context.Context, orderUUID uuid.UUID) (api.Order, error) {
+	order, err := s.repo.GetOrderByUUIDForUpdate(ctx, orderUUID)
+	if err != nil {
+		return api.Order{}, err
+	}
+	if order.Id == nil {
+		return api.Order{}, errors.New(internalErrors.ErrOrderDoesntExist)
+	}
+
+	return order, nil
+}
+
+// matchOrderItems сопоставляет отсканированные товары со штрихкодами с позициями заказов
+func (s *service) matchOrderItems(ctx context.Context, products []api.Product) error {
+	productUUIDs := make([]uuid.UUID, 0, len(products))
+	for _, product := range products {
+		if product.Id != nil && product.Barcode != nil {
+			productUUIDs = append(productUUIDs, *product.Id)
+		}
+	}
+	if len(productUUIDs) == 0 {
+		return nil
+	}
+
+	return s.repo.MatchOrderItems(ctx, productUUIDs)
+}
+
+// prepareReadyOrders выпускает коды выдачи заказам ПВЗ, все товары которых на хранении
+func (s *service) prepareReadyOrders(ctx context.Context, pvzUUID uuid.UUID) error {
+	orders, err := s.repo.GetOrdersReadyForPickup(ctx, pvzUUID)
+	if err != nil {
+		return err
+	}
+
+	for _, order := range orders {
+		if _, err := s.issueOrderPickupCode(ctx, order); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// issueOrderPickupCode сохраняет хеш нового кода выдачи и отправляет сам код получателю.
+// Ошибка отправки не отменяет выпуск кода: модератор может выпустить код повторно
+func (s *service) issueOrderPickupCode(ctx context.Context, order api.Order) (api.Order, error) {
+	code, err := generatePickupCode()
+	if err != nil {
+		return api.Order{}, err
+	}
+
+	updated, err := s.repo.SetOrderPickupCode(ctx, *order.Id, s.hashPickupCode(code))
+	if err != nil {
+		return api.Order{}, err
+	}
+
+	if s.notifier != nil {
+		if err := s.notifier.SendPickupCode(ctx, updated, code); err != nil {
+			log.Logger.Err(err).Str("order_id", order.Id.String()).Msg("method issueOrderPickupCode")
+		}
+	}
+
+	return updated, nil
+}
+
+// generatePickupCode возвращает случайный шестизначный код выдачи
+func generatePickupCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		log.Logger.Err(err).Msg("method generatePickupCode")
+		return "", errors.New("could not generate pickup code")
+	}
+
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
+
+// hashPickupCode возвращает HMAC-SHA256 кода выдачи на ключе сервиса: без ключа хеш шестизначного кода не перебрать
+func (s *service) hashPickupCode(code string) string {
+	mac := hmac.New(sha256.New, s.pickupSecret)
+	mac.Write([]byte(code))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// checkPickupCode сравнивает хеш названного кода с сохраненным за постоянное время
+func (s *service) checkPickupCode(codeHash, code string) bool {
+	return hmac.Equal([]byte(codeHash), []byte(s.hashPickupCode(code)))
+}
+
+// isValidOrderBarcodes проверяет, что штрихкоды заказа корректны и не повторяются
+func isValidOrderBarcodes(barcodes []string) bool {
+	if len(barcodes) == 0 || len(barcodes) > maxOrderItems {
+		return false
+	}
+
+	for i, barcode := range barcodes {
+		if !isValidBarcode(barcode) || slices.Contains(barcodes[:i], barcode) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func trimmedOrNil(value *string) *string {
+	if value == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*value)
+	if trimmed == "" {
+		return nil
+	}
+
+	return &trimmed
+}
@@ -0,0 +1,300 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/devWaylander/pvz_store/api"
+	internalErrors "github.com/devWaylander/pvz_store/pkg/errors"
+	"github.com/devWaylander/pvz_store/pkg/models"
+	"github.com/google/uuid"
+)
+
+var testPickupSecret = []byte("test pickup code secret")
+
+type mockNotifier struct {
+	sent map[string]string
+}
+
+func (n *mockNotifier) SendPickupCode(ctx context.Context, order api.Order, code string) error {
+	n.sent[order.Number] = code
+	return nil
+}
+
+func Test_isValidOrderBarcodes(t *testing.T) {
+	tests := []struct {
+		name     string
+		barcodes []string
+		want     bool
+	}{
+		{"Valid barcodes", []string{"4006381333931", "ABC!"}, true},
+		{"Empty order", nil, false},
+		{"Duplicate barcode", []string{"4006381333931", "4006381333931"}, false},
+		{"Wrong check digit", []string{"4006381333932"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isValidOrderBarcodes(tt.barcodes); got != tt.want {
+				t.Errorf("isValidOrderBarcodes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_service_CreateOrder(t *testing.T) {
+	pvzUuid := uuid.New()
+	phone := "+79990001122"
+
+	tests := []struct {
+		name    string
+		data    api.PostOrdersJSONBody
+		wantErr string
+	}{
+		{
+			name:    "Create order",
+			data:    api.PostOrdersJSONBody{Number: "A-1", PvzId: pvzUuid, RecipientPhone: &phone, Barcodes: []string{"4006381333931"}},
+			wantErr: "",
+		},
+		{
+			name:    "Recipient contact is required",
+			data:    api.PostOrdersJSONBody{Number: "A-1", PvzId: pvzUuid, Barcodes: []string{"4006381333931"}},
+			wantErr: internalErrors.ErrWrongOrderRecipient,
+		},
+		{
+			name:    "Wrong barcode",
+			data:    api.PostOrdersJSONBody{Number: "A-1", PvzId: pvzUuid, RecipientPhone: &phone, Barcodes: []string{"123"}},
+			wantErr: internalErrors.ErrWrongOrderItems,
+		},
+		{
+			name:    "PVZ doesnt exist",
+			data:    api.PostOrdersJSONBody{Number: "A-1", PvzId: uuid.New(), RecipientPhone: &phone, Barcodes: []string{"4006381333931"}},
+			wantErr: internalErrors.ErrPVZDoesntExist,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &MockRepository{
+				IsPVZExistFunc: func(ctx context.Context, id uuid.UUID) (bool, error) {
+					return id == pvzUuid, nil
+				},
+				CreateOrderFunc: func(ctx context.Context, pvzUUID uuid.UUID, number string, recipientPhone, recipientEmail *string, barcodes []string, createdBy uuid.UUID) (api.Order, error) {
+					id := uuid.New()
+					return api.Order{Id: &id, Number: number, PvzId: pvzUUID, RecipientPhone: recipientPhone, Status: api.Awaiting}, nil
+				},
+			}
+			s := New(repo, nil, nil, nil, testPickupSecret)
+
+			got, err := s.CreateOrder(moderatorCtx(), tt.data)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("CreateOrder() unexpected error = %v", err)
+				}
+				if got.Status != api.Awaiting {
+					t.Errorf("CreateOrder() status = %v, want %v", got.Status, api.Awaiting)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("CreateOrder() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_service_IssueOrder(t *testing.T) {
+	orderUuid := uuid.New()
+	productUuid := uuid.New()
+
+	tests := []struct {
+		name         string
+		status       api.OrderStatus
+		attempts     int
+		pickupCode   string
+		wantErr      string
+		wantAttempts int
+	}{
+		{
+			name:       "Issue ready order",
+			status:     api.Ready,
+			pickupCode: "123456",
+		},
+		{
+			name:         "Wrong pickup code is counted",
+			status:       api.Ready,
+			pickupCode:   "654321",
+			wantErr:      internalErrors.ErrWrongPickupCode,
+			wantAttempts: 1,
+		},
+		{
+			name:         "Attempts exceeded",
+			status:       api.Ready,
+			attempts:     maxPickupCodeAttempts,
+			pickupCode:   "123456",
+			wantErr:      internalErrors.ErrPickupAttemptsExceeded,
+			wantAttempts: maxPickupCodeAttempts,
+		},
+		{
+			name:       "Order is not ready",
+			status:     api.Awaiting,
+			pickupCode: "123456",
+			wantErr:    internalErrors.ErrWrongOrderStatus,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := tt.attempts
+			var transitions []models.ProductTransition
+			repo := &MockRepository{
+				GetOrderByUUIDFunc: func(ctx context.Context, id uuid.UUID) (api.Order, error) {
+					return api.Order{
+						Id:             &orderUuid,
+						Number:         "A-1",
+						Status:         tt.status,
+						PickupAttempts: attempts,
+						Items:          []api.OrderItem{{Barcode: "4006381333931", ProductId: &productUuid}},
+					}, nil
+				},
+				GetOrderPickupCodeHashFunc: func(ctx context.Context, id uuid.UUID) (string, error) {
+					return (&service{pickupSecret: testPickupSecret}).hashPickupCode("123456"), nil
+				},
+				RecordPickupAttemptFunc: func(ctx context.Context, id uuid.UUID) error {
+					attempts++
+					return nil
+				},
+				UpdateProductStatusFunc: func(ctx context.Context, transition models.ProductTransition) error {
+					transitions = append(transitions, transition)
+					return nil
+				},
+				IssueOrderFunc: func(ctx context.Context, id uuid.UUID, issuedBy uuid.UUID) (api.Order, error) {
+					return api.Order{Id: &orderUuid, Status: api.Issued}, nil
+				},
+			}
+			s := New(repo, nil, nil, nil, testPickupSecret)
+
+			got, err := s.IssueOrder(employeeCtx(), orderUuid, tt.pickupCode)
+			if attempts != tt.wantAttempts {
+				t.Errorf("IssueOrder() attempts = %v, want %v", attempts, tt.wantAttempts)
+			}
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("IssueOrder() unexpected error = %v", err)
+				}
+				if got.Status != api.Issued {
+					t.Errorf("IssueOrder() status = %v, want %v", got.Status, api.Issued)
+				}
+				if len(transitions) != 1 || transitions[0].ProductID != productUuid || transitions[0].To != string(api.ProductStatusIssued) {
+					t.Errorf("IssueOrder() transitions = %+v", transitions)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("IssueOrder() error = %v, want %v", err, tt.wantErr)
+			}
+			if len(transitions) != 0 {
+				t.Errorf("IssueOrder() transitions = %+v, want none", transitions)
+			}
+		})
+	}
+}
+
+func Test_service_prepareReadyOrders(t *testing.T) {
+	pvzUuid := uuid.New()
+	orderUuid := uuid.New()
+
+	var savedHash string
+	repo := &MockRepository{
+		GetOrdersReadyForPickupFunc: func(ctx context.Context, id uuid.UUID) ([]api.Order, error) {
+			return []api.Order{{Id: &orderUuid, Number: "A-1", Status: api.Awaiting}}, nil
+		},
+		SetOrderPickupCodeFunc: func(ctx context.Context, id uuid.UUID, codeHash string) (api.Order, error) {
+			savedHash = codeHash
+			return api.Order{Id: &orderUuid, Number: "A-1", Status: api.Ready}, nil
+		},
+	}
+	notifier := &mockNotifier{sent: make(map[string]string)}
+	s := New(repo, notifier, nil, nil, testPickupSecret)
+
+	if err := s.prepareReadyOrders(context.Background(), pvzUuid); err != nil {
+		t.Fatalf("prepareReadyOrders() unexpected error = %v", err)
+	}
+
+	code, ok := notifier.sent["A-1"]
+	if !ok || len(code) != 6 {
+		t.Fatalf("prepareReadyOrders() sent code = %q", code)
+	}
+	// в базе хранится только хеш кода
+	if savedHash != s.hashPickupCode(code) {
+		t.Errorf("prepareReadyOrders() saved hash doesnt match sent code")
+	}
+}
+
+func Test_service_hashPickupCode(t *testing.T) {
+	s := &service{pickupSecret: testPickupSecret}
+	other := &service{pickupSecret: []byte("other secret")}
+
+	codeHash := s.hashPickupCode("123456")
+	if !s.checkPickupCode(codeHash, "123456") {
+		t.Errorf("checkPickupCode() = false for the issued code")
+	}
+	if s.checkPickupCode(codeHash, "654321") {
+		t.Errorf("checkPickupCode() = true for a wrong code")
+	}
+	// хеш зависит от ключа: без ключа сервиса код не проверить и не перебрать
+	if other.checkPickupCode(codeHash, "123456") {
+		t.Errorf("checkPickupCode() = true with another secret")
+	}
+	sum := sha256.Sum256([]byte("123456"))
+	if codeHash == hex.EncodeToString(sum[:]) {
+		t.Errorf("hashPickupCode() = unkeyed sha256")
+	}
+}
+
+func Test_service_IssueProduct_InOrder(t *testing.T) {
+	productUuid := uuid.New()
+	status := api.ProductStatusStored
+
+	repo := &MockRepository{
+		GetProductByUUIDFunc: func(ctx context.Context, id uuid.UUID) (api.Product, error) {
+			return api.Product{Id: &productUuid, Status: &status}, nil
+		},
+		IsProductInActiveOrderFunc: func(ctx context.Context, id uuid.UUID) (bool, error) {
+			return true, nil
+		},
+	}
+	s := New(repo, nil, nil, nil, nil)
+
+	_, err := s.IssueProduct(employeeCtx(), productUuid, "123456")
+	if err == nil || err.Error() != internalErrors.ErrProductInOrder {
+		t.Errorf("IssueProduct() error = %v, want %v", err, internalErrors.ErrProductInOrder)
+	}
+}
+
+func Test_service_GetOrder(t *testing.T) {
+	orderUuid := uuid.New()
+	repo := &MockRepository{
+		GetOrderByUUIDFunc: func(ctx context.Context, id uuid.UUID) (api.Order, error) {
+			if id != orderUuid {
+				return api.Order{}, nil
+			}
+			return api.Order{Id: &orderUuid, Status: api.Awaiting}, nil
+		},
+		// чтение заказа не должно блокировать его
+		GetOrderByUUIDForUpdateFunc: func(ctx context.Context, id uuid.UUID) (api.Order, error) {
+			return api.Order{}, errors.New("unexpected lock")
+		},
+	}
+	s := New(repo, nil, nil, nil, nil)
+
+	got, err := s.GetOrder(employeeCtx(), orderUuid)
+	if err != nil || got.Id == nil || *got.Id != orderUuid {
+		t.Errorf("GetOrder() = %v, %v, want order %v", got, err, orderUuid)
+	}
+
+	_, err = s.GetOrder(employeeCtx(), uuid.New())
+	if err == nil || err.Error() != internalErrors.ErrOrderDoesntExist {
+		t.Errorf("GetOrder() error = %v, want %v", err, internalErrors.ErrOrderDoesntExist)
+	}
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/devWaylander/pvz_store/api"
+)
+
+const (
+	// PlacementFirstFit размещает товар в первую по коду ячейку со свободным местом, товары собираются плотно
+	PlacementFirstFit = "first_fit"
+	// PlacementLeastLoaded размещает товар в наименее заполненную ячейку, товары распределяются равномерно
+	PlacementLeastLoaded = "least_loaded"
+)
+
+// PlacementStrategy выбирает ячейку хранения для нового товара.
+// cells отсортированы по коду и содержат актуальную заполненность, возвращается индекс выбранной ячейки
+type PlacementStrategy interface {
+	SuggestCell(product api.Product, cells []api.StorageCell) (int, bool)
+}
+
+// NewPlacementStrategy возвращает стратегию размещения по её названию из конфигурации
+func NewPlacementStrategy(name string) (PlacementStrategy, error) {
+	switch name {
+	case PlacementFirstFit:
+		return firstFitPlacement{}, nil
+	case PlacementLeastLoaded:
+		return leastLoadedPlacement{}, nil
+	default:
+		return nil, fmt.Errorf("unknown placement strategy %q", name)
+	}
+}
+
+type firstFitPlacement struct{}
+
+func (firstFitPlacement) SuggestCell(_ api.Product, cells []api.StorageCell) (int, bool) {
+	for i, cell := range cells {
+		if cellFreeSpace(cell) > 0 {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
+type leastLoadedPlacement struct{}
+
+func (leastLoadedPlacement) SuggestCell(_ api.Product, cells []api.StorageCell) (int, bool) {
+	best, found := 0, false
+	for i, cell := range cells {
+		if cellFreeSpace(cell) <= 0 {
+			continue
+		}
+		// доли заполнения сравниваются без деления: occupied_i/capacity_i < occupied_best/capacity_best
+		if !found || cellOccupied(cell)*cells[best].Capacity < cellOccupied(cells[best])*cell.Capacity {
+			best, found = i, true
+		}
+	}
+
+	return best, found
+}
+
+func cellOccupied(cell api.StorageCell) int {
+	if cell.Occupied == nil {
+		return 0
+	}
+
+	return *cell.Occupied
+}
+
+func cellFreeSpace(cell api.StorageCell) int {
+	return cell.Capacity - cellOccupied(cell)
+}
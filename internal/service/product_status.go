@@ -0,0 +1,241 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/devWaylander/pvz_store/api"
+	internalErrors "github.com/devWaylander/pvz_store/pkg/errors"
+	"github.com/devWaylander/pvz_store/pkg/models"
+	"github.com/google/uuid"
+)
+
+type productTransitionKey struct {
+	from api.ProductStatus
+	to   api.ProductStatus
+}
+
+// productTransitions допустимые переходы между статусами товара и роли, которым они доступны
+//
+//	received   -> stored (вместе с закрытием приемки)
+//	stored     -> received (вместе с повторным открытием приемки), in_transit (вместе с отправкой перемещения),
+//	              issued (employee), returned (employee), returned_to_sender (employee, moderator)
+//	in_transit -> received (вместе с получением перемещения в приемку ПВЗ назначения)
+//	issued     -> returned (employee)
+//	returned   -> returned_to_sender (вместе с закрытием отправки возвратов)
+//
+// returned_to_sender является конечным статусом
+var productTransitions = map[productTransitionKey][]string{
+	{api.ProductStatusStored, api.ProductStatusIssued}:           {string(api.Employee)},
+	{api.ProductStatusStored, api.ProductStatusReturned}:         {string(api.Employee)},
+	{api.ProductStatusIssued, api.ProductStatusReturned}:         {string(api.Employee)},
+	{api.ProductStatusStored, api.ProductStatusReturnedToSender}: {string(api.Employee), string(api.Moderator)},
+}
+
+// canTransitionProduct проверяет, разрешён ли переход товара из статуса from в статус to для роли role
+func canTransitionProduct(from, to api.ProductStatus, role string) bool {
+	roles, ok := productTransitions[productTransitionKey{from, to}]
+	if !ok {
+		return false
+	}
+
+	return slices.Contains(roles, role)
+}
+
+// isOnHand сообщает, находится ли товар в данном статусе на руках ПВЗ
+func isOnHand(status api.ProductStatus) bool {
+	return status == api.ProductStatusReceived || status == api.ProductStatusStored
+}
+
+// IssueProduct выдает товар на хранении получателю, назвавшему код выдачи.
+// Неверная попытка сохраняется, после maxPickupCodeAttempts попыток код перестает приниматься
+func (s *service) IssueProduct(ctx context.Context, productUUID uuid.UUID, pickupCode string) (api.Product, error) {
+	var product api.Product
+	wrongCode := false
+	err := s.repo.WithTx(ctx, func(ctx context.Context) error {
+		transition, err := s.newProductTransition(ctx, productUUID, api.ProductStatusIssued, "")
+		if err != nil {
+			return err
+		}
+
+		// товары заказов выдаются только целым заказом по коду заказа
+		inOrder, err := s.repo.IsProductInActiveOrder(ctx, productUUID)
+		if err != nil {
+			return err
+		}
+		if inOrder {
+			return errors.New(internalErrors.ErrProductInOrder)
+		}
+
+		codeHash, attempts, err := s.repo.GetProductPickupCodeHashForUpdate(ctx, productUUID)
+		if err != nil {
+			return err
+		}
+		if attempts >= maxPickupCodeAttempts {
+			return errors.New(internalErrors.ErrPickupAttemptsExceeded)
+		}
+		if codeHash == "" || !s.checkPickupCode(codeHash, pickupCode) {
+			// попытка должна сохраниться, поэтому транзакция фиксируется, а ошибка возвращается после неё
+			wrongCode = true
+			return s.repo.RecordProductPickupAttempt(ctx, productUUID)
+		}
+
+		product, err = s.transitionProduct(ctx, transition)
+		return err
+	})
+	if err != nil {
+		return api.Product{}, err
+	}
+	if wrongCode {
+		return api.Product{}, errors.New(internalErrors.ErrWrongPickupCode)
+	}
+
+	return product, nil
+}
+
+// ReturnProductToSender возвращает товар на хранении отправителю с указанием причины
+func (s *service) ReturnProductToSender(ctx context.Context, productUUID uuid.UUID, reason string) (api.Product, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return api.Product{}, errors.New(internalErrors.ErrReasonRequired)
+	}
+
+	var product api.Product
+	err := s.repo.WithTx(ctx, func(ctx context.Context) error {
+		transition, err := s.newProductTransition(ctx, productUUID, api.ProductStatusReturnedToSender, reason)
+		if err != nil {
+			return err
+		}
+
+		product, err = s.transitionProduct(ctx, transition)
+		return err
+	})
+	if err != nil {
+		return api.Product{}, err
+	}
+
+	return product, nil
+}
+
+// IssueProductPickupCode выпускает товару на хранении новый код выдачи и сбрасывает счетчик попыток.
+// Код возвращается только здесь, сохраняется его хеш. Товары заказов выдаются по коду заказа
+func (s *service) IssueProductPickupCode(ctx context.Context, productUUID uuid.UUID) (api.PickupCode, error) {
+	var code string
+	err := s.repo.WithTx(ctx, func(ctx context.Context) error {
+		product, err := s.getProduct(ctx, productUUID)
+		if err != nil {
+			return err
+		}
+		if *product.Status != api.ProductStatusStored {
+			return errors.New(internalErrors.ErrProductTransition)
+		}
+
+		inOrder, err := s.repo.IsProductInActiveOrder(ctx, productUUID)
+		if err != nil {
+			return err
+		}
+		if inOrder {
+			return errors.New(internalErrors.ErrProductInOrder)
+		}
+
+		code, err = generatePickupCode()
+		if err != nil {
+			return err
+		}
+
+		return s.repo.SetProductPickupCode(ctx, productUUID, s.hashPickupCode(code))
+	})
+	if err != nil {
+		return api.PickupCode{}, err
+	}
+
+	return api.PickupCode{PickupCode: code}, nil
+}
+
+// GetPVZStock возвращает товары, которые еще находятся на руках ПВЗ
+func (s *service) GetPVZStock(ctx context.Context, pvzUUID uuid.UUID, data api.GetPvzPvzIdStockParams) (api.PvzStock, error) {
+	page, limit := 1, 10
+	if data.Page != nil && *data.Page > 0 {
+		page = *data.Page
+	}
+	if data.Limit != nil && *data.Limit > 0 {
+		limit = *data.Limit
+	}
+
+	isPVZExist, err := s.repo.IsPVZExist(ctx, pvzUUID)
+	if err != nil {
+		return api.PvzStock{}, err
+	}
+	if !isPVZExist {
+		return api.PvzStock{}, errors.New(internalErrors.ErrPVZDoesntExist)
+	}
+
+	products, total, err := s.repo.GetStockByPvzUUID(ctx, pvzUUID, page, limit)
+	if err != nil {
+		return api.PvzStock{}, err
+	}
+
+	counts, err := s.repo.GetStockCountsByPvzUUID(ctx, pvzUUID)
+	if err != nil {
+		return api.PvzStock{}, err
+	}
+
+	return api.PvzStock{
+		PvzId:         pvzUUID,
+		ProductCounts: productCounts(counts),
+		Products:      products,
+		TotalProducts: total,
+		Page:          page,
+		Limit:         limit,
+	}, nil
+}
+
+// getProduct возвращает товар, который не удален и не аннулирован
+func (s *service) getProduct(ctx context.Context, productUUID uuid.UUID) (api.Product, error) {
+	product, err := s.repo.GetProductByUUID(ctx, productUUID)
+	if err != nil {
+		return api.Product{}, err
+	}
+	if product.Id == nil || product.VoidedAt != nil {
+		return api.Product{}, errors.New(internalErrors.ErrProductDoesntExist)
+	}
+
+	return product, nil
+}
+
+// newProductTransition проверяет переход товара в статус to от имени текущего пользователя
+func (s *service) newProductTransition(ctx context.Context, productUUID uuid.UUID, to api.ProductStatus, reason string) (models.ProductTransition, error) {
+	actor, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return models.ProductTransition{}, err
+	}
+
+	product, err := s.getProduct(ctx, productUUID)
+	if err != nil {
+		return models.ProductTransition{}, err
+	}
+
+	if !canTransitionProduct(*product.Status, to, actor.Role) {
+		return models.ProductTransition{}, errors.New(internalErrors.ErrProductTransition)
+	}
+
+	return models.ProductTransition{
+		ProductID: productUUID,
+		From:      string(*product.Status),
+		To:        string(to),
+		ActorID:   actor.UserUUID,
+		ActorRole: actor.Role,
+		Reason:    reason,
+	}, nil
+}
+
+// transitionProduct сохраняет переход товара и возвращает товар в новом статусе
+func (s *service) transitionProduct(ctx context.Context, transition models.ProductTransition) (api.Product, error) {
+	if err := s.repo.UpdateProductStatus(ctx, transition); err != nil {
+		return api.Product{}, err
+	}
+
+	return s.repo.GetProductByUUID(ctx, transition.ProductID)
+}
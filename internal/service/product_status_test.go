@@ -0,0 +1,252 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/devWaylander/pvz_store/api"
+	internalErrors "github.com/devWaylander/pvz_store/pkg/errors"
+	"github.com/devWaylander/pvz_store/pkg/models"
+	"github.com/google/uuid"
+)
+
+func Test_canTransitionProduct(t *testing.T) {
+	tests := []struct {
+		from, to api.ProductStatus
+		role     string
+		want     bool
+	}{
+		{api.ProductStatusStored, api.ProductStatusIssued, string(api.Employee), true},
+		{api.ProductStatusStored, api.ProductStatusIssued, string(api.Moderator), false},
+		{api.ProductStatusStored, api.ProductStatusReturnedToSender, string(api.Employee), true},
+		{api.ProductStatusStored, api.ProductStatusReturnedToSender, string(api.Moderator), true},
+		{api.ProductStatusReceived, api.ProductStatusIssued, string(api.Employee), false},
+		{api.ProductStatusIssued, api.ProductStatusReturnedToSender, string(api.Employee), false},
+		{api.ProductStatusReturnedToSender, api.ProductStatusStored, string(api.Moderator), false},
+		{api.ProductStatusIssued, api.ProductStatusReturned, string(api.Employee), true},
+		{api.ProductStatusIssued, api.ProductStatusReturned, string(api.Moderator), false},
+		{api.ProductStatusReceived, api.ProductStatusReturned, string(api.Employee), false},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.from)+"->"+string(tt.to)+" "+tt.role, func(t *testing.T) {
+			if got := canTransitionProduct(tt.from, tt.to, tt.role); got != tt.want {
+				t.Errorf("canTransitionProduct() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_service_IssueProduct(t *testing.T) {
+	productUuid := uuid.New()
+
+	codeHash := (&service{pickupSecret: testPickupSecret}).hashPickupCode("123456")
+
+	tests := []struct {
+		name         string
+		status       api.ProductStatus
+		voided       bool
+		codeHash     string
+		attempts     int
+		pickupCode   string
+		wantErr      string
+		wantAttempts int
+	}{
+		{
+			name:       "Issue stored product",
+			status:     api.ProductStatusStored,
+			codeHash:   codeHash,
+			pickupCode: "123456",
+		},
+		{
+			name:         "Wrong pickup code",
+			status:       api.ProductStatusStored,
+			codeHash:     codeHash,
+			pickupCode:   "654321",
+			wantErr:      internalErrors.ErrWrongPickupCode,
+			wantAttempts: 1,
+		},
+		{
+			name:         "Pickup code not issued",
+			status:       api.ProductStatusStored,
+			pickupCode:   "123456",
+			wantErr:      internalErrors.ErrWrongPickupCode,
+			wantAttempts: 1,
+		},
+		{
+			name:         "Attempts exceeded",
+			status:       api.ProductStatusStored,
+			codeHash:     codeHash,
+			attempts:     maxPickupCodeAttempts,
+			pickupCode:   "123456",
+			wantErr:      internalErrors.ErrPickupAttemptsExceeded,
+			wantAttempts: maxPickupCodeAttempts,
+		},
+		{
+			name:       "Product is not stored yet",
+			status:     api.ProductStatusReceived,
+			pickupCode: "123456",
+			wantErr:    internalErrors.ErrProductTransition,
+		},
+		{
+			name:       "Product already issued",
+			status:     api.ProductStatusIssued,
+			pickupCode: "123456",
+			wantErr:    internalErrors.ErrProductTransition,
+		},
+		{
+			name:       "Product of cancelled reception",
+			status:     api.ProductStatusStored,
+			voided:     true,
+			pickupCode: "123456",
+			wantErr:    internalErrors.ErrProductDoesntExist,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := tt.status
+			attempts := tt.attempts
+			var saved models.ProductTransition
+			repo := &MockRepository{
+				GetProductByUUIDFunc: func(ctx context.Context, id uuid.UUID) (api.Product, error) {
+					product := api.Product{Id: &productUuid, Status: &status}
+					if tt.voided {
+						now := time.Now()
+						product.VoidedAt = &now
+					}
+					return product, nil
+				},
+				GetProductPickupCodeHashForUpdateFunc: func(ctx context.Context, id uuid.UUID) (string, int, error) {
+					return tt.codeHash, attempts, nil
+				},
+				RecordProductPickupAttemptFunc: func(ctx context.Context, id uuid.UUID) error {
+					attempts++
+					return nil
+				},
+				UpdateProductStatusFunc: func(ctx context.Context, transition models.ProductTransition) error {
+					saved = transition
+					status = api.ProductStatus(transition.To)
+					return nil
+				},
+			}
+			s := New(repo, nil, nil, nil, testPickupSecret)
+
+			got, err := s.IssueProduct(employeeCtx(), productUuid, tt.pickupCode)
+			if attempts != tt.wantAttempts {
+				t.Errorf("IssueProduct() attempts = %v, want %v", attempts, tt.wantAttempts)
+			}
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("IssueProduct() unexpected error = %v", err)
+				}
+				if *got.Status != api.ProductStatusIssued {
+					t.Errorf("IssueProduct() status = %v, want %v", *got.Status, api.ProductStatusIssued)
+				}
+				if saved.From != string(api.ProductStatusStored) || saved.ActorRole != string(api.Employee) {
+					t.Errorf("IssueProduct() transition = %+v", saved)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("IssueProduct() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_service_IssueProductPickupCode(t *testing.T) {
+	productUuid := uuid.New()
+
+	tests := []struct {
+		name    string
+		status  api.ProductStatus
+		inOrder bool
+		wantErr string
+	}{
+		{
+			name:   "Issue code for stored product",
+			status: api.ProductStatusStored,
+		},
+		{
+			name:    "Product is not stored yet",
+			status:  api.ProductStatusReceived,
+			wantErr: internalErrors.ErrProductTransition,
+		},
+		{
+			name:    "Product in order",
+			status:  api.ProductStatusStored,
+			inOrder: true,
+			wantErr: internalErrors.ErrProductInOrder,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var savedHash string
+			repo := &MockRepository{
+				GetProductByUUIDFunc: func(ctx context.Context, id uuid.UUID) (api.Product, error) {
+					return api.Product{Id: &productUuid, Status: &tt.status}, nil
+				},
+				IsProductInActiveOrderFunc: func(ctx context.Context, id uuid.UUID) (bool, error) {
+					return tt.inOrder, nil
+				},
+				SetProductPickupCodeFunc: func(ctx context.Context, id uuid.UUID, codeHash string) error {
+					savedHash = codeHash
+					return nil
+				},
+			}
+			s := New(repo, nil, nil, nil, testPickupSecret)
+
+			got, err := s.IssueProductPickupCode(moderatorCtx(), productUuid)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Errorf("IssueProductPickupCode() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("IssueProductPickupCode() unexpected error = %v", err)
+			}
+			if len(got.PickupCode) != 6 {
+				t.Errorf("IssueProductPickupCode() code = %q", got.PickupCode)
+			}
+			// в базе хранится только хеш кода
+			if savedHash == got.PickupCode || !s.checkPickupCode(savedHash, got.PickupCode) {
+				t.Errorf("IssueProductPickupCode() saved hash doesnt match issued code")
+			}
+		})
+	}
+}
+
+func Test_service_GetPVZStock(t *testing.T) {
+	pvzUuid := uuid.New()
+
+	repo := &MockRepository{
+		IsPVZExistFunc: func(ctx context.Context, id uuid.UUID) (bool, error) {
+			return id == pvzUuid, nil
+		},
+		GetStockByPvzUUIDFunc: func(ctx context.Context, id uuid.UUID, page, limit int) ([]api.Product, int, error) {
+			return []api.Product{}, 0, nil
+		},
+		GetStockCountsByPvzUUIDFunc: func(ctx context.Context, id uuid.UUID) (map[string]int, error) {
+			return nil, nil
+		},
+	}
+	s := New(repo, nil, nil, nil, nil)
+
+	t.Run("Empty stock", func(t *testing.T) {
+		got, err := s.GetPVZStock(employeeCtx(), pvzUuid, api.GetPvzPvzIdStockParams{})
+		if err != nil {
+			t.Fatalf("GetPVZStock() unexpected error = %v", err)
+		}
+		if got.ProductCounts == nil || got.Page != 1 || got.Limit != 10 {
+			t.Errorf("GetPVZStock() = %+v", got)
+		}
+	})
+
+	t.Run("PVZ doesnt exist", func(t *testing.T) {
+		_, err := s.GetPVZStock(employeeCtx(), uuid.New(), api.GetPvzPvzIdStockParams{})
+		if err == nil || err.Error() != internalErrors.ErrPVZDoesntExist {
+			t.Errorf("GetPVZStock() error = %v, want %v", err, internalErrors.ErrPVZDoesntExist)
+		}
+	})
+}
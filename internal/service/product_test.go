@@ -0,0 +1,239 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/devWaylander/pvz_store/api"
+	internalErrors "github.com/devWaylander/pvz_store/pkg/errors"
+	"github.com/google/uuid"
+)
+
+func Test_service_DeleteProduct(t *testing.T) {
+	productUuid := uuid.New()
+	recUuid := uuid.New()
+	issued := api.ProductStatusIssued
+
+	tests := []struct {
+		name      string
+		recStatus api.ReceptionStatus
+		product   api.Product
+		reason    string
+		wantErr   string
+	}{
+		{
+			name:      "Delete product from reception in progress",
+			recStatus: api.ReceptionStatusInProgress,
+			product:   api.Product{Id: &productUuid, ReceptionId: recUuid},
+			reason:    "ошибка сканирования",
+		},
+		{
+			name:      "Reason is required",
+			recStatus: api.ReceptionStatusInProgress,
+			product:   api.Product{Id: &productUuid, ReceptionId: recUuid},
+			reason:    "  ",
+			wantErr:   internalErrors.ErrReasonRequired,
+		},
+		{
+			name:      "Product doesnt exist",
+			recStatus: api.ReceptionStatusInProgress,
+			reason:    "ошибка сканирования",
+			wantErr:   internalErrors.ErrProductDoesntExist,
+		},
+		{
+			name:      "Reception is closed",
+			recStatus: api.ReceptionStatusClosed,
+			product:   api.Product{Id: &productUuid, ReceptionId: recUuid},
+			reason:    "ошибка сканирования",
+			wantErr:   internalErrors.ErrWrongReceptionStatus,
+		},
+		{
+			name:      "Product from reopened reception is already issued",
+			recStatus: api.ReceptionStatusInProgress,
+			product:   api.Product{Id: &productUuid, ReceptionId: recUuid, Status: &issued},
+			reason:    "ошибка сканирования",
+			wantErr:   internalErrors.ErrProductTransition,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var deletedReason string
+			repo := &MockRepository{
+				GetProductByUUIDFunc: func(ctx context.Context, id uuid.UUID) (api.Product, error) {
+					return tt.product, nil
+				},
+				GetReceptionByUUIDFunc: func(ctx context.Context, id uuid.UUID) (api.Reception, error) {
+					return api.Reception{Id: &recUuid, Status: tt.recStatus}, nil
+				},
+				DeleteProductFunc: func(ctx context.Context, id uuid.UUID, deletedBy uuid.UUID, reason string) error {
+					deletedReason = reason
+					return nil
+				},
+			}
+			s := New(repo, nil, nil, nil, nil)
+
+			err := s.DeleteProduct(employeeCtx(), productUuid, tt.reason)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("DeleteProduct() unexpected error = %v", err)
+				}
+				if deletedReason != tt.reason {
+					t.Errorf("DeleteProduct() reason = %v, want %v", deletedReason, tt.reason)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("DeleteProduct() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_service_UpdateProduct(t *testing.T) {
+	productUuid := uuid.New()
+	recUuid := uuid.New()
+	newType := "обувь"
+	var productStatus api.ProductStatus
+
+	repo := &MockRepository{
+		GetProductByUUIDFunc: func(ctx context.Context, id uuid.UUID) (api.Product, error) {
+			return api.Product{
+				Id:          &productUuid,
+				ReceptionId: recUuid,
+				Type:        "одежда",
+				Attributes:  &api.ProductAttributes{"size": "M"},
+				Status:      &productStatus,
+			}, nil
+		},
+		GetReceptionByUUIDFunc: func(ctx context.Context, id uuid.UUID) (api.Reception, error) {
+			return api.Reception{Id: &recUuid, Status: api.ReceptionStatusInProgress}, nil
+		},
+		GetProductTypeByNameFunc: func(ctx context.Context, name string) (api.ProductType, error) {
+			if name == "обувь" {
+				return api.ProductType{Name: name, AttributesSchema: shoesSchema}, nil
+			}
+			return api.ProductType{Name: name, AttributesSchema: map[string]interface{}{"type": "object"}}, nil
+		},
+		UpdateProductFunc: func(ctx context.Context, id uuid.UUID, prType string, attributes api.ProductAttributes) (api.Product, error) {
+			return api.Product{Id: &id, ReceptionId: recUuid, Type: prType, Attributes: &attributes}, nil
+		},
+	}
+	s := New(repo, nil, nil, nil, nil)
+
+	tests := []struct {
+		name     string
+		status   api.ProductStatus
+		data     api.ProductPatch
+		wantType string
+		wantErr  string
+	}{
+		{
+			name:    "Empty patch",
+			status:  api.ProductStatusReceived,
+			data:    api.ProductPatch{},
+			wantErr: internalErrors.ErrEmptyProductPatch,
+		},
+		{
+			name:    "New type keeps old attributes that dont match its schema",
+			status:  api.ProductStatusReceived,
+			data:    api.ProductPatch{Type: &newType},
+			wantErr: internalErrors.ErrInvalidProductAttributes,
+		},
+		{
+			name:     "New type with matching attributes",
+			status:   api.ProductStatusReceived,
+			data:     api.ProductPatch{Type: &newType, Attributes: &api.ProductAttributes{"size": 42}},
+			wantType: "обувь",
+		},
+		{
+			name:     "Attributes only",
+			status:   api.ProductStatusReceived,
+			data:     api.ProductPatch{Attributes: &api.ProductAttributes{"size": "L"}},
+			wantType: "одежда",
+		},
+		{
+			name:    "Product from reopened reception is stored in another PVZ",
+			status:  api.ProductStatusStored,
+			data:    api.ProductPatch{Attributes: &api.ProductAttributes{"size": "L"}},
+			wantErr: internalErrors.ErrProductTransition,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			productStatus = tt.status
+			got, err := s.UpdateProduct(employeeCtx(), productUuid, tt.data)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("UpdateProduct() unexpected error = %v", err)
+				}
+				if got.Type != tt.wantType {
+					t.Errorf("UpdateProduct() type = %v, want %v", got.Type, tt.wantType)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("UpdateProduct() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_service_GetDeletedProducts(t *testing.T) {
+	recUuid := uuid.New()
+	productUuid := uuid.New()
+	deletedBy := uuid.New()
+	reason := "ошибка сканирования"
+
+	tests := []struct {
+		name      string
+		reception api.Reception
+		deleted   []api.Product
+		wantLen   int
+		wantErr   string
+	}{
+		{
+			name:      "Deleted products with actor and reason",
+			reception: api.Reception{Id: &recUuid, Status: api.ReceptionStatusClosed},
+			deleted:   []api.Product{{Id: &productUuid, ReceptionId: recUuid, DeletedBy: &deletedBy, DeleteReason: &reason}},
+			wantLen:   1,
+		},
+		{
+			name:      "Reception without deleted products",
+			reception: api.Reception{Id: &recUuid, Status: api.ReceptionStatusClosed},
+		},
+		{
+			name:    "Reception doesnt exist",
+			wantErr: internalErrors.ErrReceptionDoesntExist,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &MockRepository{
+				GetReceptionByUUIDFunc: func(ctx context.Context, id uuid.UUID) (api.Reception, error) {
+					return tt.reception, nil
+				},
+				GetDeletedProductsByReceptionUUIDFunc: func(ctx context.Context, id uuid.UUID) ([]api.Product, error) {
+					return tt.deleted, nil
+				},
+			}
+			s := New(repo, nil, nil, nil, nil)
+
+			got, err := s.GetDeletedProducts(moderatorCtx(), recUuid)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Errorf("GetDeletedProducts() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetDeletedProducts() unexpected error = %v", err)
+			}
+			if got == nil || len(got) != tt.wantLen {
+				t.Fatalf("GetDeletedProducts() = %v, want %d products", got, tt.wantLen)
+			}
+			if tt.wantLen > 0 && (got[0].DeletedBy == nil || *got[0].DeletedBy != deletedBy || *got[0].DeleteReason != reason) {
+				t.Errorf("GetDeletedProducts() = %+v, want deletedBy %v and reason %q", got[0], deletedBy, reason)
+			}
+		})
+	}
+}
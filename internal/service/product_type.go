@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/devWaylander/pvz_store/api"
+	internalErrors "github.com/devWaylander/pvz_store/pkg/errors"
+	"github.com/devWaylander/pvz_store/pkg/log"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+/*
+Product type
+*/
+func (s *service) GetProductTypes(ctx context.Context) ([]api.ProductType, error) {
+	return s.repo.GetProductTypes(ctx)
+}
+
+func (s *service) CreateProductType(ctx context.Context, data api.ProductType) (api.ProductType, error) {
+	name := strings.TrimSpace(data.Name)
+	if name == "" {
+		return api.ProductType{}, errors.New(internalErrors.ErrInvalidAttributesSchema)
+	}
+
+	if _, err := parseAttributesSchema(ctx, data.AttributesSchema); err != nil {
+		return api.ProductType{}, err
+	}
+
+	return s.repo.CreateProductType(ctx, name, data.AttributesSchema)
+}
+
+func (s *service) UpdateProductTypeSchema(ctx context.Context, name string, data api.PutProductTypesTypeNameJSONBody) (api.ProductType, error) {
+	if _, err := parseAttributesSchema(ctx, data.AttributesSchema); err != nil {
+		return api.ProductType{}, err
+	}
+
+	productType, err := s.repo.UpdateProductTypeSchema(ctx, name, data.AttributesSchema)
+	if err != nil {
+		return api.ProductType{}, err
+	}
+	if productType.Name == "" {
+		return api.ProductType{}, errors.New(internalErrors.ErrProductTypeDoesntExist)
+	}
+
+	return productType, nil
+}
+
+// validateProductAttributes проверяет атрибуты товара по схеме его типа из справочника
+func (s *service) validateProductAttributes(ctx context.Context, prType string, attributes api.ProductAttributes) error {
+	schema, err := s.getAttributesSchema(ctx, prType)
+	if err != nil {
+		return err
+	}
+
+	return checkProductAttributes(schema, prType, attributes)
+}
+
+// getAttributesSchema возвращает разобранную схему атрибутов типа товара из справочника
+func (s *service) getAttributesSchema(ctx context.Context, prType string) (*openapi3.Schema, error) {
+	productType, err := s.repo.GetProductTypeByName(ctx, prType)
+	if err != nil {
+		return nil, err
+	}
+	if productType.Name == "" {
+		return nil, errors.New(internalErrors.ErrProductTypeDoesntExist)
+	}
+
+	return parseAttributesSchema(ctx, productType.AttributesSchema)
+}
+
+func checkProductAttributes(schema *openapi3.Schema, prType string, attributes api.ProductAttributes) error {
+	if err := schema.VisitJSON(map[string]any(attributes)); err != nil {
+		log.Logger.Debug().Err(err).Str("type", prType).Msg("method checkProductAttributes")
+		return errors.New(internalErrors.ErrInvalidProductAttributes)
+	}
+
+	return nil
+}
+
+// parseAttributesSchema разбирает JSON Schema атрибутов, схема должна описывать объект
+func parseAttributesSchema(ctx context.Context, raw map[string]interface{}) (*openapi3.Schema, error) {
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, errors.New(internalErrors.ErrInvalidAttributesSchema)
+	}
+
+	var schema openapi3.Schema
+	if err := json.Unmarshal(data, &schema); err != nil {
+		log.Logger.Debug().Err(err).Msg("method parseAttributesSchema")
+		return nil, errors.New(internalErrors.ErrInvalidAttributesSchema)
+	}
+	if err := schema.Validate(ctx); err != nil {
+		log.Logger.Debug().Err(err).Msg("method parseAttributesSchema")
+		return nil, errors.New(internalErrors.ErrInvalidAttributesSchema)
+	}
+	if schema.Type == nil || !schema.Type.Is(openapi3.TypeObject) {
+		return nil, errors.New(internalErrors.ErrInvalidAttributesSchema)
+	}
+
+	return &schema, nil
+}
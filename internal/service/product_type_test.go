@@ -0,0 +1,333 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/devWaylander/pvz_store/api"
+	internalErrors "github.com/devWaylander/pvz_store/pkg/errors"
+	"github.com/devWaylander/pvz_store/pkg/models"
+	"github.com/google/uuid"
+)
+
+var shoesSchema = map[string]interface{}{
+	"type":     "object",
+	"required": []interface{}{"size"},
+	"properties": map[string]interface{}{
+		"size": map[string]interface{}{"type": "number", "minimum": 15, "maximum": 55},
+	},
+}
+
+func Test_service_validateProductAttributes(t *testing.T) {
+	repo := &MockRepository{
+		GetProductTypeByNameFunc: func(ctx context.Context, name string) (api.ProductType, error) {
+			if name != "обувь" {
+				return api.ProductType{}, nil
+			}
+			return api.ProductType{Name: name, AttributesSchema: shoesSchema}, nil
+		},
+	}
+	s := New(repo, nil, nil, nil, nil)
+
+	tests := []struct {
+		name       string
+		prType     string
+		attributes api.ProductAttributes
+		wantErr    string
+	}{
+		{
+			name:       "Valid attributes",
+			prType:     "обувь",
+			attributes: api.ProductAttributes{"size": 42},
+		},
+		{
+			name:       "Unknown product type",
+			prType:     "мебель",
+			attributes: api.ProductAttributes{},
+			wantErr:    internalErrors.ErrProductTypeDoesntExist,
+		},
+		{
+			name:       "Missing required attribute",
+			prType:     "обувь",
+			attributes: api.ProductAttributes{},
+			wantErr:    internalErrors.ErrInvalidProductAttributes,
+		},
+		{
+			name:       "Attribute out of range",
+			prType:     "обувь",
+			attributes: api.ProductAttributes{"size": 70},
+			wantErr:    internalErrors.ErrInvalidProductAttributes,
+		},
+		{
+			name:       "Attribute of wrong type",
+			prType:     "обувь",
+			attributes: api.ProductAttributes{"size": "42"},
+			wantErr:    internalErrors.ErrInvalidProductAttributes,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := s.validateProductAttributes(context.Background(), tt.prType, tt.attributes)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("validateProductAttributes() unexpected error = %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("validateProductAttributes() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_service_CreateProductType(t *testing.T) {
+	repo := &MockRepository{
+		CreateProductTypeFunc: func(ctx context.Context, name string, attributesSchema map[string]interface{}) (api.ProductType, error) {
+			return api.ProductType{Name: name, AttributesSchema: attributesSchema}, nil
+		},
+	}
+	s := New(repo, nil, nil, nil, nil)
+
+	tests := []struct {
+		name    string
+		data    api.ProductType
+		wantErr string
+	}{
+		{
+			name: "Valid schema",
+			data: api.ProductType{Name: "обувь", AttributesSchema: shoesSchema},
+		},
+		{
+			name:    "Empty name",
+			data:    api.ProductType{Name: " ", AttributesSchema: shoesSchema},
+			wantErr: internalErrors.ErrInvalidAttributesSchema,
+		},
+		{
+			name:    "Schema is not an object",
+			data:    api.ProductType{Name: "обувь", AttributesSchema: map[string]interface{}{"type": "string"}},
+			wantErr: internalErrors.ErrInvalidAttributesSchema,
+		},
+		{
+			name:    "Malformed schema",
+			data:    api.ProductType{Name: "обувь", AttributesSchema: map[string]interface{}{"type": "object", "properties": "size"}},
+			wantErr: internalErrors.ErrInvalidAttributesSchema,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.CreateProductType(moderatorCtx(), tt.data)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("CreateProductType() unexpected error = %v", err)
+				}
+				if got.Name != tt.data.Name {
+					t.Errorf("CreateProductType() name = %v, want %v", got.Name, tt.data.Name)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("CreateProductType() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_service_UpdateProductTypeSchema(t *testing.T) {
+	repo := &MockRepository{
+		UpdateProductTypeSchemaFunc: func(ctx context.Context, name string, attributesSchema map[string]interface{}) (api.ProductType, error) {
+			return api.ProductType{}, nil
+		},
+	}
+	s := New(repo, nil, nil, nil, nil)
+
+	_, err := s.UpdateProductTypeSchema(moderatorCtx(), "мебель", api.PutProductTypesTypeNameJSONBody{AttributesSchema: shoesSchema})
+	if err == nil || err.Error() != internalErrors.ErrProductTypeDoesntExist {
+		t.Errorf("UpdateProductTypeSchema() error = %v, want %v", err, internalErrors.ErrProductTypeDoesntExist)
+	}
+}
+
+func Test_service_CreateProducts(t *testing.T) {
+	pvzUuid := uuid.New()
+	recUuid := uuid.New()
+
+	var inserted []api.ProductBatchItem
+	typeLookups := 0
+	repo := &MockRepository{
+		IsPVZExistFunc: func(ctx context.Context, id uuid.UUID) (bool, error) {
+			return true, nil
+		},
+		GetReceptionByPvzUUIDFunc: func(ctx context.Context, pvzUUID uuid.UUID) (api.Reception, error) {
+			return api.Reception{Id: &recUuid, PvzId: pvzUUID, Status: api.ReceptionStatusInProgress}, nil
+		},
+		GetProductTypeByNameFunc: func(ctx context.Context, name string) (api.ProductType, error) {
+			typeLookups++
+			if name == "обувь" {
+				return api.ProductType{Name: name, AttributesSchema: shoesSchema}, nil
+			}
+			return api.ProductType{Name: name, AttributesSchema: map[string]interface{}{"type": "object"}}, nil
+		},
+		CreateProductsFunc: func(ctx context.Context, receptionUUID uuid.UUID, items []api.ProductBatchItem, createdBy uuid.UUID) ([]api.Product, error) {
+			inserted = items
+			products := make([]api.Product, len(items))
+			for i, item := range items {
+				id := uuid.New()
+				products[i] = api.Product{Id: &id, ReceptionId: receptionUUID, Type: item.Type}
+			}
+			return products, nil
+		},
+	}
+	s := New(repo, nil, nil, nil, nil)
+
+	items := make([]api.ProductBatchItem, maxProductsBatchSize+1)
+	for i := range items {
+		items[i] = api.ProductBatchItem{Type: "одежда"}
+	}
+
+	tests := []struct {
+		name    string
+		items   []api.ProductBatchItem
+		wantErr string
+	}{
+		{
+			name:    "Empty batch",
+			items:   nil,
+			wantErr: internalErrors.ErrWrongProductsBatch,
+		},
+		{
+			name:    "Batch too large",
+			items:   items,
+			wantErr: internalErrors.ErrWrongProductsBatch,
+		},
+		{
+			name: "One invalid item rejects the whole batch",
+			items: []api.ProductBatchItem{
+				{Type: "обувь", Attributes: &api.ProductAttributes{"size": 42}},
+				{Type: "обувь"},
+			},
+			wantErr: internalErrors.ErrInvalidProductAttributes,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inserted = nil
+			_, err := s.CreateProducts(employeeCtx(), api.PostProductsBatchJSONBody{PvzId: pvzUuid, Items: tt.items})
+			if err == nil || err.Error() != tt.wantErr {
+				t.Fatalf("CreateProducts() error = %v, want %v", err, tt.wantErr)
+			}
+			if inserted != nil {
+				t.Errorf("CreateProducts() inserted items despite validation error")
+			}
+		})
+	}
+
+	t.Run("Valid batch keeps item order", func(t *testing.T) {
+		typeLookups = 0
+		batch := []api.ProductBatchItem{
+			{Type: "обувь", Attributes: &api.ProductAttributes{"size": 42}},
+			{Type: "одежда"},
+			{Type: "обувь", Attributes: &api.ProductAttributes{"size": 38}},
+		}
+		got, err := s.CreateProducts(employeeCtx(), api.PostProductsBatchJSONBody{PvzId: pvzUuid, Items: batch})
+		if err != nil {
+			t.Fatalf("CreateProducts() unexpected error = %v", err)
+		}
+		if len(got) != len(batch) {
+			t.Fatalf("CreateProducts() returned %d products, want %d", len(got), len(batch))
+		}
+		for i, product := range got {
+			if product.Type != batch[i].Type {
+				t.Errorf("CreateProducts() product %d type = %v, want %v", i, product.Type, batch[i].Type)
+			}
+		}
+		if inserted[1].Attributes == nil {
+			t.Errorf("CreateProducts() item without attributes must be passed with empty attributes")
+		}
+		if typeLookups != 2 {
+			t.Errorf("CreateProducts() looked up product types %d times, want 2", typeLookups)
+		}
+	})
+}
+
+func Test_service_CreateProduct_Barcode(t *testing.T) {
+	pvzUuid := uuid.New()
+	recUuid := uuid.New()
+	existingUuid := uuid.New()
+	existingBarcode := "4006381333931"
+
+	repo := &MockRepository{
+		IsPVZExistFunc: func(ctx context.Context, id uuid.UUID) (bool, error) {
+			return true, nil
+		},
+		GetReceptionByPvzUUIDFunc: func(ctx context.Context, pvzUUID uuid.UUID) (api.Reception, error) {
+			return api.Reception{Id: &recUuid, PvzId: pvzUUID, Status: api.ReceptionStatusInProgress}, nil
+		},
+		GetInStockProductsByBarcodesFunc: func(ctx context.Context, barcodes []string) ([]api.Product, error) {
+			if barcodes[0] != existingBarcode {
+				return nil, nil
+			}
+			return []api.Product{{Id: &existingUuid, ReceptionId: recUuid, Type: "одежда", Barcode: &existingBarcode}}, nil
+		},
+		CreateProductFunc: func(ctx context.Context, receptionUUID uuid.UUID, prType string, attributes api.ProductAttributes, barcode *string, weight *int, dimensions *api.ProductDimensions, createdBy uuid.UUID) (api.Product, error) {
+			id := uuid.New()
+			return api.Product{Id: &id, ReceptionId: receptionUUID, Type: prType, Barcode: barcode}, nil
+		},
+		CreateProductsFunc: func(ctx context.Context, receptionUUID uuid.UUID, items []api.ProductBatchItem, createdBy uuid.UUID) ([]api.Product, error) {
+			return make([]api.Product, len(items)), nil
+		},
+	}
+	s := New(repo, nil, nil, nil, nil)
+
+	t.Run("New barcode", func(t *testing.T) {
+		barcode := "ABC!"
+		got, err := s.CreateProduct(employeeCtx(), api.PostProductsJSONBody{PvzId: pvzUuid, Type: "одежда", Barcode: &barcode})
+		if err != nil {
+			t.Fatalf("CreateProduct() unexpected error = %v", err)
+		}
+		if got.Barcode == nil || *got.Barcode != barcode {
+			t.Errorf("CreateProduct() barcode = %v, want %v", got.Barcode, barcode)
+		}
+	})
+
+	t.Run("Wrong check digit", func(t *testing.T) {
+		barcode := "4006381333932"
+		_, err := s.CreateProduct(employeeCtx(), api.PostProductsJSONBody{PvzId: pvzUuid, Type: "одежда", Barcode: &barcode})
+		if err == nil || err.Error() != internalErrors.ErrWrongBarcode {
+			t.Errorf("CreateProduct() error = %v, want %v", err, internalErrors.ErrWrongBarcode)
+		}
+	})
+
+	t.Run("Duplicate scan points to existing product", func(t *testing.T) {
+		_, err := s.CreateProduct(employeeCtx(), api.PostProductsJSONBody{PvzId: pvzUuid, Type: "одежда", Barcode: &existingBarcode})
+		var duplicate *models.DuplicateBarcodeError
+		if !errors.As(err, &duplicate) {
+			t.Fatalf("CreateProduct() error = %v, want DuplicateBarcodeError", err)
+		}
+		if *duplicate.Product.Id != existingUuid {
+			t.Errorf("CreateProduct() duplicate product = %v, want %v", *duplicate.Product.Id, existingUuid)
+		}
+	})
+
+	t.Run("Duplicate barcodes inside batch", func(t *testing.T) {
+		barcode := "ABC!"
+		_, err := s.CreateProducts(employeeCtx(), api.PostProductsBatchJSONBody{PvzId: pvzUuid, Items: []api.ProductBatchItem{
+			{Type: "одежда", Barcode: &barcode},
+			{Type: "одежда", Barcode: &barcode},
+		}})
+		if err == nil || err.Error() != internalErrors.ErrDuplicateBarcodeInBatch {
+			t.Errorf("CreateProducts() error = %v, want %v", err, internalErrors.ErrDuplicateBarcodeInBatch)
+		}
+	})
+
+	t.Run("Batch with barcode already in stock", func(t *testing.T) {
+		_, err := s.CreateProducts(employeeCtx(), api.PostProductsBatchJSONBody{PvzId: pvzUuid, Items: []api.ProductBatchItem{
+			{Type: "одежда"},
+			{Type: "одежда", Barcode: &existingBarcode},
+		}})
+		var duplicate *models.DuplicateBarcodeError
+		if !errors.As(err, &duplicate) {
+			t.Errorf("CreateProducts() error = %v, want DuplicateBarcodeError", err)
+		}
+	})
+}
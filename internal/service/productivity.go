@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/devWaylander/pvz_store/api"
+	internalErrors "github.com/devWaylander/pvz_store/pkg/errors"
+	"github.com/devWaylander/pvz_store/pkg/models"
+	"github.com/google/uuid"
+	"github.com/oapi-codegen/runtime/types"
+)
+
+// Пороги отчета о скорости приемок по умолчанию, совпадают с default в swagger
+const (
+	defaultSLAMinutes     = 120
+	defaultIdleGapMinutes = 10
+)
+
+/*
+Productivity report
+*/
+// GetProductivityReport строит отчет о скорости приемок, закрытых в диапазоне дат.
+// Без дат берутся предыдущие сутки UTC, без конца диапазона - до текущего момента,
+// без начала - сутки до конца диапазона
+func (s *service) GetProductivityReport(ctx context.Context, params api.GetReportsProductivityParams) (api.ProductivityReport, error) {
+	now := time.Now().UTC()
+	endDate := now.Truncate(24 * time.Hour)
+	startDate := endDate.AddDate(0, 0, -1)
+	switch {
+	case params.StartDate != nil && params.EndDate != nil:
+		startDate, endDate = *params.StartDate, *params.EndDate
+	case params.StartDate != nil:
+		startDate, endDate = *params.StartDate, now
+	case params.EndDate != nil:
+		startDate, endDate = params.EndDate.AddDate(0, 0, -1), *params.EndDate
+	}
+	if err := validateDateRange(&startDate, &endDate); err != nil {
+		return api.ProductivityReport{}, err
+	}
+
+	slaMinutes, idleGapMinutes := defaultSLAMinutes, defaultIdleGapMinutes
+	if params.SlaMinutes != nil {
+		slaMinutes = *params.SlaMinutes
+	}
+	if params.IdleGapMinutes != nil {
+		idleGapMinutes = *params.IdleGapMinutes
+	}
+
+	filter := models.ProductivityFilter{
+		StartDate: startDate,
+		EndDate:   endDate,
+		PvzID:     params.PvzId,
+	}
+
+	return s.buildProductivityReport(ctx, filter, slaMinutes, idleGapMinutes)
+}
+
+// BuildDailyProductivityReport строит отчет за сутки UTC, в которые попадает day, и сохраняет его.
+// Повторный запуск за тот же день перезаписывает отчет
+func (s *service) BuildDailyProductivityReport(ctx context.Context, day time.Time, sla, idleGap time.Duration) (api.ProductivityReport, error) {
+	startDate := day.UTC().Truncate(24 * time.Hour)
+	filter := models.ProductivityFilter{
+		StartDate: startDate,
+		EndDate:   startDate.AddDate(0, 0, 1),
+	}
+
+	report, err := s.buildProductivityReport(ctx, filter, durationMinutes(sla), durationMinutes(idleGap))
+	if err != nil {
+		return api.ProductivityReport{}, err
+	}
+
+	if err := s.repo.SaveProductivityReport(ctx, startDate, report); err != nil {
+		return api.ProductivityReport{}, err
+	}
+
+	return report, nil
+}
+
+// GetDailyProductivityReport возвращает сохраненный ежедневный отчет
+func (s *service) GetDailyProductivityReport(ctx context.Context, date types.Date) (api.ProductivityReport, error) {
+	report, err := s.repo.GetProductivityReportByDate(ctx, date.Time)
+	if err != nil {
+		return api.ProductivityReport{}, err
+	}
+	if report.GeneratedAt.IsZero() {
+		return api.ProductivityReport{}, errors.New(internalErrors.ErrProductivityReportDoesntExist)
+	}
+
+	return report, nil
+}
+
+func (s *service) buildProductivityReport(ctx context.Context, filter models.ProductivityFilter, slaMinutes, idleGapMinutes int) (api.ProductivityReport, error) {
+	timings, err := s.repo.GetReceptionTimings(ctx, filter)
+	if err != nil {
+		return api.ProductivityReport{}, err
+	}
+
+	return api.ProductivityReport{
+		StartDate:      filter.StartDate,
+		EndDate:        filter.EndDate,
+		SlaMinutes:     slaMinutes,
+		IdleGapMinutes: idleGapMinutes,
+		GeneratedAt:    time.Now().UTC(),
+		Rows:           productivityRows(timings, time.Duration(slaMinutes)*time.Minute, time.Duration(idleGapMinutes)*time.Minute),
+	}, nil
+}
+
+// productivityKey приемки одного ПВЗ, открытые одним сотрудником
+type productivityKey struct {
+	pvzID      uuid.UUID
+	employeeID uuid.NullUUID
+}
+
+// productivityTotals накопленные показатели строки отчета
+type productivityTotals struct {
+	receptions    int
+	products      int
+	totalDuration time.Duration
+	maxDuration   time.Duration
+	idleGaps      int
+	idleTime      time.Duration
+	maxGap        time.Duration
+	slaBreaches   int
+}
+
+// productivityRows считает показатели приемок и сводит их в строки по ПВЗ и сотруднику, открывшему приемку.
+// Простоем считается промежуток длиннее idleGap между открытием, сканированиями товаров и закрытием,
+// нарушением SLA - приемка длительностью больше sla. Строки упорядочены по ПВЗ, затем по сотруднику
+func productivityRows(timings []models.ReceptionTiming, sla, idleGap time.Duration) []api.ProductivityReportRow {
+	totals := make(map[productivityKey]*productivityTotals)
+	var keys []productivityKey
+	for _, timing := range timings {
+		key := productivityKey{pvzID: timing.PvzID, employeeID: timing.EmployeeID}
+		total, ok := totals[key]
+		if !ok {
+			total = &productivityTotals{}
+			totals[key] = total
+			keys = append(keys, key)
+		}
+
+		duration := max(timing.ClosedAt.Sub(timing.OpenedAt), 0)
+		total.receptions++
+		total.products += len(timing.ScanTimes)
+		total.totalDuration += duration
+		total.maxDuration = max(total.maxDuration, duration)
+		if duration > sla {
+			total.slaBreaches++
+		}
+
+		// ScanTimes упорядочены, промежутки считаются между соседними событиями приемки
+		prev := timing.OpenedAt
+		for _, event := range append(slices.Clone(timing.ScanTimes), timing.ClosedAt) {
+			gap := max(event.Sub(prev), 0)
+			total.maxGap = max(total.maxGap, gap)
+			if gap > idleGap {
+				total.idleGaps++
+				total.idleTime += gap
+			}
+			prev = event
+		}
+	}
+
+	slices.SortFunc(keys, func(a, b productivityKey) int {
+		if c := compareUUID(a.pvzID, b.pvzID); c != 0 {
+			return c
+		}
+		// приемки без автора идут перед остальными
+		if a.employeeID.Valid != b.employeeID.Valid {
+			if a.employeeID.Valid {
+				return 1
+			}
+			return -1
+		}
+		return compareUUID(a.employeeID.UUID, b.employeeID.UUID)
+	})
+
+	rows := make([]api.ProductivityReportRow, 0, len(keys))
+	for _, key := range keys {
+		total := totals[key]
+		row := api.ProductivityReportRow{
+			PvzId:                key.pvzID,
+			Receptions:           total.receptions,
+			Products:             total.products,
+			TotalDurationSeconds: int(total.totalDuration / time.Second),
+			AvgDurationSeconds:   int(total.totalDuration / time.Duration(total.receptions) / time.Second),
+			MaxDurationSeconds:   int(total.maxDuration / time.Second),
+			IdleGaps:             total.idleGaps,
+			IdleSeconds:          int(total.idleTime / time.Second),
+			MaxIdleGapSeconds:    int(total.maxGap / time.Second),
+			SlaBreaches:          total.slaBreaches,
+		}
+		if key.employeeID.Valid {
+			employeeID := key.employeeID.UUID
+			row.EmployeeId = &employeeID
+		}
+		if total.totalDuration > 0 {
+			// округляем до сотых товара в минуту
+			row.ItemsPerMinute = float32(math.Round(float64(total.products)/total.totalDuration.Minutes()*100) / 100)
+		}
+		rows = append(rows, row)
+	}
+
+	return rows
+}
+
+func compareUUID(a, b uuid.UUID) int {
+	return slices.Compare(a[:], b[:])
+}
+
+// durationMinutes переводит порог из конфигурации в целые минуты, не меньше одной
+func durationMinutes(d time.Duration) int {
+	return max(int(d/time.Minute), 1)
+}
@@ -0,0 +1,240 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/devWaylander/pvz_store/api"
+	internalErrors "github.com/devWaylander/pvz_store/pkg/errors"
+	"github.com/devWaylander/pvz_store/pkg/models"
+	"github.com/google/uuid"
+	"github.com/oapi-codegen/runtime/types"
+)
+
+func Test_productivityRows(t *testing.T) {
+	pvzUuid, employeeUuid := uuid.New(), uuid.New()
+	opened := time.Date(2025, 5, 1, 10, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time {
+		return opened.Add(time.Duration(minutes) * time.Minute)
+	}
+
+	timings := []models.ReceptionTiming{
+		{
+			// 30 минут, 6 товаров, простой 12 минут перед закрытием
+			ReceptionID: uuid.New(),
+			PvzID:       pvzUuid,
+			EmployeeID:  uuid.NullUUID{UUID: employeeUuid, Valid: true},
+			OpenedAt:    opened,
+			ClosedAt:    at(30),
+			ScanTimes:   []time.Time{at(2), at(5), at(8), at(12), at(15), at(18)},
+		},
+		{
+			// 150 минут без товаров: нарушение SLA и один простой на всю приемку
+			ReceptionID: uuid.New(),
+			PvzID:       pvzUuid,
+			EmployeeID:  uuid.NullUUID{UUID: employeeUuid, Valid: true},
+			OpenedAt:    opened,
+			ClosedAt:    at(150),
+		},
+		{
+			// приемка без автора попадает в отдельную строку
+			ReceptionID: uuid.New(),
+			PvzID:       pvzUuid,
+			OpenedAt:    opened,
+			ClosedAt:    at(4),
+			ScanTimes:   []time.Time{at(1), at(2)},
+		},
+	}
+
+	got := productivityRows(timings, 2*time.Hour, 10*time.Minute)
+	want := []api.ProductivityReportRow{
+		{
+			PvzId:                pvzUuid,
+			Receptions:           1,
+			Products:             2,
+			TotalDurationSeconds: 240,
+			AvgDurationSeconds:   240,
+			MaxDurationSeconds:   240,
+			ItemsPerMinute:       0.5,
+			MaxIdleGapSeconds:    120,
+		},
+		{
+			PvzId:                pvzUuid,
+			EmployeeId:           &employeeUuid,
+			Receptions:           2,
+			Products:             6,
+			TotalDurationSeconds: 180 * 60,
+			AvgDurationSeconds:   90 * 60,
+			MaxDurationSeconds:   150 * 60,
+			ItemsPerMinute:       0.03,
+			IdleGaps:             2,
+			IdleSeconds:          (12 + 150) * 60,
+			MaxIdleGapSeconds:    150 * 60,
+			SlaBreaches:          1,
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("productivityRows() = %+v, want %+v", got, want)
+	}
+}
+
+func Test_service_GetProductivityReport(t *testing.T) {
+	start := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 5, 8, 0, 0, 0, 0, time.UTC)
+	pvzUuid := uuid.New()
+	sla, idleGap := 60, 5
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	tests := []struct {
+		name        string
+		params      api.GetReportsProductivityParams
+		wantFilter  models.ProductivityFilter
+		wantSLA     int
+		wantIdleGap int
+		wantErr     string
+	}{
+		{
+			name:        "Previous UTC day with default thresholds",
+			params:      api.GetReportsProductivityParams{},
+			wantFilter:  models.ProductivityFilter{StartDate: today.AddDate(0, 0, -1), EndDate: today},
+			wantSLA:     defaultSLAMinutes,
+			wantIdleGap: defaultIdleGapMinutes,
+		},
+		{
+			name: "Range, PVZ and thresholds are passed through",
+			params: api.GetReportsProductivityParams{
+				StartDate:      &start,
+				EndDate:        &end,
+				PvzId:          &pvzUuid,
+				SlaMinutes:     &sla,
+				IdleGapMinutes: &idleGap,
+			},
+			wantFilter:  models.ProductivityFilter{StartDate: start, EndDate: end, PvzID: &pvzUuid},
+			wantSLA:     sla,
+			wantIdleGap: idleGap,
+		},
+		{
+			name:        "Only end date gives one day before it",
+			params:      api.GetReportsProductivityParams{EndDate: &end},
+			wantFilter:  models.ProductivityFilter{StartDate: end.AddDate(0, 0, -1), EndDate: end},
+			wantSLA:     defaultSLAMinutes,
+			wantIdleGap: defaultIdleGapMinutes,
+		},
+		{
+			name:    "Start date after end date",
+			params:  api.GetReportsProductivityParams{StartDate: &end, EndDate: &start},
+			wantErr: internalErrors.ErrWrongDateRange,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotFilter models.ProductivityFilter
+			repo := &MockRepository{
+				GetReceptionTimingsFunc: func(ctx context.Context, filter models.ProductivityFilter) ([]models.ReceptionTiming, error) {
+					gotFilter = filter
+					return nil, nil
+				},
+			}
+			s := New(repo, nil, nil, nil, nil)
+
+			report, err := s.GetProductivityReport(moderatorCtx(), tt.params)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("GetProductivityReport() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetProductivityReport() unexpected error = %v", err)
+			}
+			if !reflect.DeepEqual(gotFilter, tt.wantFilter) {
+				t.Errorf("GetProductivityReport() filter = %+v, want %+v", gotFilter, tt.wantFilter)
+			}
+			if report.SlaMinutes != tt.wantSLA || report.IdleGapMinutes != tt.wantIdleGap {
+				t.Errorf("GetProductivityReport() thresholds = %d/%d, want %d/%d",
+					report.SlaMinutes, report.IdleGapMinutes, tt.wantSLA, tt.wantIdleGap)
+			}
+			if report.Rows == nil {
+				t.Errorf("GetProductivityReport() rows = nil, want empty slice")
+			}
+		})
+	}
+}
+
+func Test_service_BuildDailyProductivityReport(t *testing.T) {
+	day := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	var (
+		gotFilter models.ProductivityFilter
+		savedDay  time.Time
+		saved     api.ProductivityReport
+	)
+	repo := &MockRepository{
+		GetReceptionTimingsFunc: func(ctx context.Context, filter models.ProductivityFilter) ([]models.ReceptionTiming, error) {
+			gotFilter = filter
+			return nil, nil
+		},
+		SaveProductivityReportFunc: func(ctx context.Context, d time.Time, report api.ProductivityReport) error {
+			savedDay, saved = d, report
+			return nil
+		},
+	}
+	s := New(repo, nil, nil, nil, nil)
+
+	report, err := s.BuildDailyProductivityReport(context.Background(), day.Add(17*time.Hour), 2*time.Hour, 30*time.Second)
+	if err != nil {
+		t.Fatalf("BuildDailyProductivityReport() unexpected error = %v", err)
+	}
+
+	wantFilter := models.ProductivityFilter{StartDate: day, EndDate: day.AddDate(0, 0, 1)}
+	if !reflect.DeepEqual(gotFilter, wantFilter) {
+		t.Errorf("BuildDailyProductivityReport() filter = %+v, want %+v", gotFilter, wantFilter)
+	}
+	if !savedDay.Equal(day) || !reflect.DeepEqual(saved, report) {
+		t.Errorf("BuildDailyProductivityReport() saved %v %+v, want %v %+v", savedDay, saved, day, report)
+	}
+	// пороги меньше минуты округляются до одной минуты
+	if report.SlaMinutes != 120 || report.IdleGapMinutes != 1 {
+		t.Errorf("BuildDailyProductivityReport() thresholds = %d/%d, want 120/1", report.SlaMinutes, report.IdleGapMinutes)
+	}
+}
+
+func Test_service_GetDailyProductivityReport(t *testing.T) {
+	date := types.Date{Time: time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)}
+	stored := api.ProductivityReport{GeneratedAt: time.Now(), Rows: []api.ProductivityReportRow{}}
+
+	tests := []struct {
+		name    string
+		report  api.ProductivityReport
+		wantErr string
+	}{
+		{name: "Stored report", report: stored},
+		{name: "Report is not built", report: api.ProductivityReport{}, wantErr: internalErrors.ErrProductivityReportDoesntExist},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &MockRepository{
+				GetProductivityReportByDateFunc: func(ctx context.Context, day time.Time) (api.ProductivityReport, error) {
+					return tt.report, nil
+				},
+			}
+			s := New(repo, nil, nil, nil, nil)
+
+			got, err := s.GetDailyProductivityReport(moderatorCtx(), date)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("GetDailyProductivityReport() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetDailyProductivityReport() unexpected error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.report) {
+				t.Errorf("GetDailyProductivityReport() = %+v, want %+v", got, tt.report)
+			}
+		})
+	}
+}
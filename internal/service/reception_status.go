@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/devWaylander/pvz_store/api"
+	internalErrors "github.com/devWaylander/pvz_store/pkg/errors"
+	"github.com/devWaylander/pvz_store/pkg/models"
+	"github.com/google/uuid"
+)
+
+type receptionTransitionKey struct {
+	from api.ReceptionStatus
+	to   api.ReceptionStatus
+}
+
+// receptionTransitions допустимые переходы между статусами приемки и роли, которым они доступны
+//
+//	draft       -> in_progress (employee), cancelled (moderator)
+//	in_progress -> closed (employee; moderator при подтверждении расхождений с манифестом; system для зависших приемок), cancelled (moderator)
+//	closed      -> verified, in_progress, cancelled (moderator)
+//
+// verified и cancelled являются конечными статусами
+var receptionTransitions = map[receptionTransitionKey][]string{
+	{api.ReceptionStatusDraft, api.ReceptionStatusInProgress}:     {string(api.Employee)},
+	{api.ReceptionStatusDraft, api.ReceptionStatusCancelled}:      {string(api.Moderator)},
+	{api.ReceptionStatusInProgress, api.ReceptionStatusClosed}:    {string(api.Employee), string(api.Moderator), models.SystemRole},
+	{api.ReceptionStatusInProgress, api.ReceptionStatusCancelled}: {string(api.Moderator)},
+	{api.ReceptionStatusClosed, api.ReceptionStatusVerified}:      {string(api.Moderator)},
+	{api.ReceptionStatusClosed, api.ReceptionStatusInProgress}:    {string(api.Moderator)},
+	{api.ReceptionStatusClosed, api.ReceptionStatusCancelled}:     {string(api.Moderator)},
+}
+
+// canTransition проверяет, разрешён ли переход приемки из статуса from в статус to для роли role
+func canTransition(from, to api.ReceptionStatus, role string) bool {
+	roles, ok := receptionTransitions[receptionTransitionKey{from, to}]
+	if !ok {
+		return false
+	}
+
+	return slices.Contains(roles, role)
+}
+
+// isOpenReception сообщает, блокирует ли приемка в данном статусе создание новой приемки в ПВЗ
+func isOpenReception(status api.ReceptionStatus) bool {
+	return status == api.ReceptionStatusDraft || status == api.ReceptionStatusInProgress
+}
+
+// newReceptionTransition проверяет переход приемки в статус to от имени текущего пользователя
+func newReceptionTransition(ctx context.Context, rec api.Reception, to api.ReceptionStatus, reason string) (models.ReceptionTransition, error) {
+	actor, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return models.ReceptionTransition{}, err
+	}
+
+	if !canTransition(rec.Status, to, actor.Role) {
+		return models.ReceptionTransition{}, errors.New(internalErrors.ErrReceptionTransition)
+	}
+	// статусом приемки перемещения управляет только получение перемещения
+	if rec.Type != nil && *rec.Type == api.ReceptionTypeTransfer {
+		return models.ReceptionTransition{}, errors.New(internalErrors.ErrReceptionTransition)
+	}
+
+	return models.ReceptionTransition{
+		ReceptionID: *rec.Id,
+		From:        string(rec.Status),
+		To:          string(to),
+		ActorID:     actor.UserUUID,
+		ActorRole:   actor.Role,
+		Reason:      reason,
+	}, nil
+}
+
+// transitionReception переводит приемку в статус to от имени текущего пользователя
+func (s *service) transitionReception(ctx context.Context, rec api.Reception, to api.ReceptionStatus, reason string) (api.Reception, error) {
+	transition, err := newReceptionTransition(ctx, rec, to, reason)
+	if err != nil {
+		return api.Reception{}, err
+	}
+
+	err = s.repo.UpdateReceptionStatus(ctx, transition)
+	if err != nil {
+		return api.Reception{}, err
+	}
+	rec.Status = to
+
+	return rec, nil
+}
+
+// closeReception сверяет приемку с манифестами ПВЗ и закрывает её, после чего выпускает коды выдачи
+// заказам, все товары которых теперь на хранении
+func (s *service) closeReception(ctx context.Context, rec api.Reception, override bool, reason string) (api.Reception, error) {
+	reconciliation, err := s.reconcileManifests(ctx, rec, override)
+	if err != nil {
+		return api.Reception{}, err
+	}
+
+	reception, err := s.transitionReception(ctx, rec, api.ReceptionStatusClosed, reason)
+	if err != nil {
+		return api.Reception{}, err
+	}
+
+	if err := s.saveManifestReconciliation(ctx, rec, reconciliation, override, reason); err != nil {
+		return api.Reception{}, err
+	}
+
+	if err := s.prepareReadyOrders(ctx, rec.PvzId); err != nil {
+		return api.Reception{}, err
+	}
+
+	return reception, nil
+}
+
+// transitionReceptionByUUID блокирует приемку и переводит её в статус to в одной транзакции
+func (s *service) transitionReceptionByUUID(ctx context.Context, recUUID uuid.UUID, to api.ReceptionStatus, reason string) (api.Reception, error) {
+	var reception api.Reception
+	err := s.repo.WithTx(ctx, func(ctx context.Context) error {
+		rec, err := s.lockReceptionByUUID(ctx, recUUID)
+		if err != nil {
+			return err
+		}
+
+		reception, err = s.transitionReception(ctx, rec, to, reason)
+		return err
+	})
+	if err != nil {
+		return api.Reception{}, err
+	}
+
+	return reception, nil
+}
@@ -5,24 +5,127 @@ import (
 	"time"
 
 	"github.com/devWaylander/pvz_store/api"
+	"github.com/devWaylander/pvz_store/pkg/models"
 	"github.com/google/uuid"
 )
 
 type MockRepository struct {
+	// Transaction
+	WithTxFunc func(ctx context.Context, fn func(ctx context.Context) error) error
 	// PVZ
+	LockPVZFunc               func(ctx context.Context, id uuid.UUID) (bool, error)
 	CreatePVZFunc             func(ctx context.Context, id uuid.UUID, city string, registrationDate time.Time) (api.PVZ, error)
 	IsPVZExistFunc            func(ctx context.Context, id uuid.UUID) (bool, error)
 	GetPVZsWithPaginationFunc func(ctx context.Context, page, limit int) ([]api.PVZ, error)
+	GetPVZsInfoFunc           func(ctx context.Context, filter models.PvzInfoFilter) ([]models.PvzInfo, error)
+	StreamPVZsInfoFunc        func(ctx context.Context, filter models.PvzInfoFilter, fn func(info models.PvzInfo) error) error
 	// Reception
-	CreateReceptionFunc                 func(ctx context.Context, pvzUUID uuid.UUID, status string) (api.Reception, error)
+	CreateReceptionFunc                 func(ctx context.Context, pvzUUID uuid.UUID, status string, actor models.AuthPrincipal) (api.Reception, error)
+	GetReceptionByUUIDFunc              func(ctx context.Context, recUUID uuid.UUID) (api.Reception, error)
+	GetReceptionByUUIDForUpdateFunc     func(ctx context.Context, recUUID uuid.UUID) (api.Reception, error)
 	GetReceptionByPvzUUIDFunc           func(ctx context.Context, pvzUUID uuid.UUID) (api.Reception, error)
+	GetReceptionByPvzUUIDForUpdateFunc  func(ctx context.Context, pvzUUID uuid.UUID) (api.Reception, error)
 	GetReceptionsByPvzUUIDsFilteredFunc func(ctx context.Context, pvzUUIDs []uuid.UUID, startDate, endDate *time.Time) ([]api.Reception, error)
 	GetReceptionStatusByPvzUUIDFunc     func(ctx context.Context, pvzUUID uuid.UUID) (string, error)
-	UpdateReceptionStatusFunc           func(ctx context.Context, recUUID uuid.UUID, status string) error
+	UpdateReceptionStatusFunc           func(ctx context.Context, transition models.ReceptionTransition) error
+	CancelReceptionFunc                 func(ctx context.Context, transition models.ReceptionTransition) error
+	GetReceptionStatusHistoryFunc       func(ctx context.Context, recUUID uuid.UUID) ([]api.ReceptionStatusChange, error)
+	GetReceptionsFilteredFunc           func(ctx context.Context, filter models.ReceptionFilter) ([]api.Reception, error)
+	GetStaleReceptionsFunc              func(ctx context.Context, idleSince time.Time) ([]api.Reception, error)
+	MarkReceptionStaleFunc              func(ctx context.Context, recUUID uuid.UUID) error
 	// Product
-	CreateProductFunc                    func(ctx context.Context, receptionUUID uuid.UUID, prType string) (api.Product, error)
-	GetProductsByRecsUUIDsFunc           func(ctx context.Context, recsUUIDs []uuid.UUID) ([]api.Product, error)
-	DeleteLastProductByReceptionUUIDFunc func(ctx context.Context, receptionUUID uuid.UUID) error
+	CreateProductFunc                            func(ctx context.Context, receptionUUID uuid.UUID, prType string, attributes api.ProductAttributes, barcode *string, weight *int, dimensions *api.ProductDimensions, createdBy uuid.UUID) (api.Product, error)
+	CreateProductsFunc                           func(ctx context.Context, receptionUUID uuid.UUID, items []api.ProductBatchItem, createdBy uuid.UUID) ([]api.Product, error)
+	GetInStockProductsByBarcodesFunc             func(ctx context.Context, barcodes []string) ([]api.Product, error)
+	GetProductsByRecsUUIDsFunc                   func(ctx context.Context, recsUUIDs []uuid.UUID) ([]api.Product, error)
+	GetProductsByUUIDsFunc                       func(ctx context.Context, productUUIDs []uuid.UUID) ([]api.Product, error)
+	GetDeletedProductsByReceptionUUIDFunc        func(ctx context.Context, receptionUUID uuid.UUID) ([]api.Product, error)
+	DeleteLastProductByReceptionUUIDFunc         func(ctx context.Context, receptionUUID uuid.UUID, deletedBy uuid.UUID) error
+	GetProductByUUIDFunc                         func(ctx context.Context, productUUID uuid.UUID) (api.Product, error)
+	DeleteProductFunc                            func(ctx context.Context, productUUID uuid.UUID, deletedBy uuid.UUID, reason string) error
+	UpdateProductFunc                            func(ctx context.Context, productUUID uuid.UUID, prType string, attributes api.ProductAttributes) (api.Product, error)
+	UpdateProductStatusFunc                      func(ctx context.Context, transition models.ProductTransition) error
+	GetProductPickupCodeHashForUpdateFunc        func(ctx context.Context, productUUID uuid.UUID) (string, int, error)
+	SetProductPickupCodeFunc                     func(ctx context.Context, productUUID uuid.UUID, codeHash string) error
+	RecordProductPickupAttemptFunc               func(ctx context.Context, productUUID uuid.UUID) error
+	GetStockByPvzUUIDFunc                        func(ctx context.Context, pvzUUID uuid.UUID, page, limit int) ([]api.Product, int, error)
+	GetStockCountsByPvzUUIDFunc                  func(ctx context.Context, pvzUUID uuid.UUID) (map[string]int, error)
+	GetProductsByReceptionUUIDWithPaginationFunc func(ctx context.Context, recUUID uuid.UUID, page, limit int) ([]api.Product, int, error)
+	GetProductCountsByReceptionUUIDFunc          func(ctx context.Context, recUUID uuid.UUID) (map[string]int, error)
+	GetProductTypesByReceptionBarcodesFunc       func(ctx context.Context, recUUID uuid.UUID, barcodes []string) (map[string]string, error)
+	GetProductCountsByRecsUUIDsFunc              func(ctx context.Context, recsUUIDs []uuid.UUID) (map[uuid.UUID]map[string]int, error)
+	// Product type
+	GetProductTypesFunc         func(ctx context.Context) ([]api.ProductType, error)
+	GetProductTypeByNameFunc    func(ctx context.Context, name string) (api.ProductType, error)
+	CreateProductTypeFunc       func(ctx context.Context, name string, attributesSchema map[string]interface{}) (api.ProductType, error)
+	UpdateProductTypeSchemaFunc func(ctx context.Context, name string, attributesSchema map[string]interface{}) (api.ProductType, error)
+	// Manifest
+	CreateManifestFunc                      func(ctx context.Context, pvzUUID uuid.UUID, supplier string, strict bool, items []api.ManifestItem, createdBy uuid.UUID) (api.Manifest, error)
+	GetManifestByUUIDFunc                   func(ctx context.Context, manifestUUID uuid.UUID) (api.Manifest, error)
+	GetManifestsToReconcileFunc             func(ctx context.Context, pvzUUID, recUUID uuid.UUID) ([]api.Manifest, error)
+	MarkManifestsReceivedFunc               func(ctx context.Context, manifestUUIDs []uuid.UUID, recUUID uuid.UUID) error
+	SaveDiscrepancyReportFunc               func(ctx context.Context, report api.DiscrepancyReport) (api.DiscrepancyReport, error)
+	GetDiscrepancyReportByReceptionUUIDFunc func(ctx context.Context, recUUID uuid.UUID) (api.DiscrepancyReport, error)
+	// Order
+	CreateOrderFunc             func(ctx context.Context, pvzUUID uuid.UUID, number string, recipientPhone, recipientEmail *string, barcodes []string, createdBy uuid.UUID) (api.Order, error)
+	GetOrderByUUIDFunc          func(ctx context.Context, orderUUID uuid.UUID) (api.Order, error)
+	GetOrderByUUIDForUpdateFunc func(ctx context.Context, orderUUID uuid.UUID) (api.Order, error)
+	GetOrderPickupCodeHashFunc  func(ctx context.Context, orderUUID uuid.UUID) (string, error)
+	MatchOrderItemsFunc         func(ctx context.Context, productUUIDs []uuid.UUID) error
+	GetOrdersReadyForPickupFunc func(ctx context.Context, pvzUUID uuid.UUID) ([]api.Order, error)
+	SetOrderPickupCodeFunc      func(ctx context.Context, orderUUID uuid.UUID, codeHash string) (api.Order, error)
+	RecordPickupAttemptFunc     func(ctx context.Context, orderUUID uuid.UUID) error
+	IssueOrderFunc              func(ctx context.Context, orderUUID uuid.UUID, issuedBy uuid.UUID) (api.Order, error)
+	IsProductInActiveOrderFunc  func(ctx context.Context, productUUID uuid.UUID) (bool, error)
+	// Return
+	CreateReturnShipmentFunc                 func(ctx context.Context, pvzUUID uuid.UUID, createdBy uuid.UUID) (api.ReturnShipment, error)
+	GetReturnShipmentByUUIDFunc              func(ctx context.Context, shipmentUUID uuid.UUID) (api.ReturnShipment, error)
+	GetOpenReturnShipmentByPvzUUIDFunc       func(ctx context.Context, pvzUUID uuid.UUID) (api.ReturnShipment, error)
+	CloseReturnShipmentFunc                  func(ctx context.Context, shipmentUUID uuid.UUID, actor models.AuthPrincipal) (api.ReturnShipment, error)
+	GetReturnShipmentsByPvzUUIDsFilteredFunc func(ctx context.Context, pvzUUIDs []uuid.UUID, startDate, endDate *time.Time) ([]api.ReturnShipment, error)
+	CreateReturnFunc                         func(ctx context.Context, shipmentUUID, productUUID uuid.UUID, reasonCode, condition string, comment *string, createdBy uuid.UUID) (api.Return, error)
+	CreateTransferFunc                       func(ctx context.Context, sourcePvzUUID, destinationPvzUUID uuid.UUID, productUUIDs []uuid.UUID, createdBy uuid.UUID) (api.Transfer, error)
+	GetTransferByUUIDFunc                    func(ctx context.Context, transferUUID uuid.UUID) (api.Transfer, error)
+	GetTransferByUUIDForUpdateFunc           func(ctx context.Context, transferUUID uuid.UUID) (api.Transfer, error)
+	GetTransfersInTransitFunc                func(ctx context.Context, pvzUUID *uuid.UUID) ([]api.Transfer, error)
+	DispatchTransferFunc                     func(ctx context.Context, transferUUID uuid.UUID, actor models.AuthPrincipal) (api.Transfer, error)
+	ReceiveTransferFunc                      func(ctx context.Context, transferUUID, recUUID uuid.UUID, actor models.AuthPrincipal) (api.Transfer, error)
+	CreateTransferReceptionFunc              func(ctx context.Context, pvzUUID uuid.UUID, actor models.AuthPrincipal) (api.Reception, error)
+	CreateStorageCellFunc                    func(ctx context.Context, pvzUUID uuid.UUID, rack, shelf, cell, code string, capacity int) (api.StorageCell, error)
+	GetStorageCellsByPvzUUIDFunc             func(ctx context.Context, pvzUUID uuid.UUID) ([]api.StorageCell, error)
+	GetStorageCellsByPvzUUIDForUpdateFunc    func(ctx context.Context, pvzUUID uuid.UUID) ([]api.StorageCell, error)
+	GetStorageCellByUUIDFunc                 func(ctx context.Context, cellUUID uuid.UUID) (api.StorageCell, error)
+	SetProductCellFunc                       func(ctx context.Context, productUUID, cellUUID uuid.UUID) error
+	GetProductLocationFunc                   func(ctx context.Context, productUUID uuid.UUID) (api.ProductLocation, error)
+	GetPvzLimitsFunc                         func(ctx context.Context, pvzUUID uuid.UUID) (api.PvzLimits, error)
+	SetPvzLimitsFunc                         func(ctx context.Context, pvzUUID uuid.UUID, limits api.PvzLimits, updatedBy uuid.UUID) (api.PvzLimits, error)
+	GetPvzCapacityUsageFunc                  func(ctx context.Context, pvzUUID uuid.UUID) (api.CapacityUsage, error)
+	GetCellsCapacityUsageFunc                func(ctx context.Context, pvzUUID uuid.UUID) ([]api.CellCapacity, error)
+	// Attachment
+	CreateAttachmentFunc      func(ctx context.Context, attachment models.NewAttachment) (api.Attachment, error)
+	GetAttachmentsByOwnerFunc func(ctx context.Context, owner models.AttachmentOwner) ([]api.Attachment, error)
+	GetAttachmentByUUIDFunc   func(ctx context.Context, attachmentUUID uuid.UUID) (api.Attachment, string, error)
+	// Stats
+	GetReceptionStatsFunc func(ctx context.Context, filter models.ReceptionStatsFilter) ([]api.ReceptionStatsRow, error)
+	// Export
+	ExportPVZsFunc       func(ctx context.Context, startDate, endDate *time.Time, fn func(row models.PvzExportRowDB) error) error
+	ExportReceptionsFunc func(ctx context.Context, filter models.ReceptionFilter, fn func(row models.ReceptionExportRowDB) error) error
+	// Productivity report
+	GetReceptionTimingsFunc         func(ctx context.Context, filter models.ProductivityFilter) ([]models.ReceptionTiming, error)
+	SaveProductivityReportFunc      func(ctx context.Context, day time.Time, report api.ProductivityReport) error
+	GetProductivityReportByDateFunc func(ctx context.Context, day time.Time) (api.ProductivityReport, error)
+}
+
+// WithTx по умолчанию просто выполняет fn, если поведение транзакции не переопределено
+func (m *MockRepository) WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if m.WithTxFunc == nil {
+		return fn(ctx)
+	}
+	return m.WithTxFunc(ctx, fn)
+}
+
+func (m *MockRepository) LockPVZ(ctx context.Context, id uuid.UUID) (bool, error) {
+	return m.LockPVZFunc(ctx, id)
 }
 
 func (m *MockRepository) CreatePVZ(ctx context.Context, id uuid.UUID, city string, registrationDate time.Time) (api.PVZ, error) {
@@ -37,14 +140,42 @@ func (m *MockRepository) GetPVZsWithPagination(ctx context.Context, page, limit
 	return m.GetPVZsWithPaginationFunc(ctx, page, limit)
 }
 
-func (m *MockRepository) CreateReception(ctx context.Context, pvzUUID uuid.UUID, status string) (api.Reception, error) {
-	return m.CreateReceptionFunc(ctx, pvzUUID, status)
+func (m *MockRepository) GetPVZsInfo(ctx context.Context, filter models.PvzInfoFilter) ([]models.PvzInfo, error) {
+	return m.GetPVZsInfoFunc(ctx, filter)
+}
+
+func (m *MockRepository) StreamPVZsInfo(ctx context.Context, filter models.PvzInfoFilter, fn func(info models.PvzInfo) error) error {
+	return m.StreamPVZsInfoFunc(ctx, filter, fn)
+}
+
+func (m *MockRepository) CreateReception(ctx context.Context, pvzUUID uuid.UUID, status string, actor models.AuthPrincipal) (api.Reception, error) {
+	return m.CreateReceptionFunc(ctx, pvzUUID, status, actor)
+}
+
+func (m *MockRepository) GetReceptionByUUID(ctx context.Context, recUUID uuid.UUID) (api.Reception, error) {
+	return m.GetReceptionByUUIDFunc(ctx, recUUID)
+}
+
+// GetReceptionByUUIDForUpdate без отдельной заглушки отвечает как GetReceptionByUUID
+func (m *MockRepository) GetReceptionByUUIDForUpdate(ctx context.Context, recUUID uuid.UUID) (api.Reception, error) {
+	if m.GetReceptionByUUIDForUpdateFunc == nil {
+		return m.GetReceptionByUUIDFunc(ctx, recUUID)
+	}
+	return m.GetReceptionByUUIDForUpdateFunc(ctx, recUUID)
 }
 
 func (m *MockRepository) GetReceptionByPvzUUID(ctx context.Context, pvzUUID uuid.UUID) (api.Reception, error) {
 	return m.GetReceptionByPvzUUIDFunc(ctx, pvzUUID)
 }
 
+// GetReceptionByPvzUUIDForUpdate без отдельной заглушки отвечает как GetReceptionByPvzUUID
+func (m *MockRepository) GetReceptionByPvzUUIDForUpdate(ctx context.Context, pvzUUID uuid.UUID) (api.Reception, error) {
+	if m.GetReceptionByPvzUUIDForUpdateFunc == nil {
+		return m.GetReceptionByPvzUUIDFunc(ctx, pvzUUID)
+	}
+	return m.GetReceptionByPvzUUIDForUpdateFunc(ctx, pvzUUID)
+}
+
 func (m *MockRepository) GetReceptionsByPvzUUIDsFiltered(ctx context.Context, pvzUUIDs []uuid.UUID, startDate, endDate *time.Time) ([]api.Reception, error) {
 	return m.GetReceptionsByPvzUUIDsFilteredFunc(ctx, pvzUUIDs, startDate, endDate)
 }
@@ -53,18 +184,362 @@ func (m *MockRepository) GetReceptionStatusByPvzUUID(ctx context.Context, pvzUUI
 	return m.GetReceptionStatusByPvzUUIDFunc(ctx, pvzUUID)
 }
 
-func (m *MockRepository) UpdateReceptionStatus(ctx context.Context, recUUID uuid.UUID, status string) error {
-	return m.UpdateReceptionStatusFunc(ctx, recUUID, status)
+func (m *MockRepository) UpdateReceptionStatus(ctx context.Context, transition models.ReceptionTransition) error {
+	return m.UpdateReceptionStatusFunc(ctx, transition)
+}
+
+func (m *MockRepository) CancelReception(ctx context.Context, transition models.ReceptionTransition) error {
+	return m.CancelReceptionFunc(ctx, transition)
 }
 
-func (m *MockRepository) CreateProduct(ctx context.Context, receptionUUID uuid.UUID, prType string) (api.Product, error) {
-	return m.CreateProductFunc(ctx, receptionUUID, prType)
+func (m *MockRepository) GetReceptionStatusHistory(ctx context.Context, recUUID uuid.UUID) ([]api.ReceptionStatusChange, error) {
+	return m.GetReceptionStatusHistoryFunc(ctx, recUUID)
+}
+
+func (m *MockRepository) CreateProduct(ctx context.Context, receptionUUID uuid.UUID, prType string, attributes api.ProductAttributes, barcode *string, weight *int, dimensions *api.ProductDimensions, createdBy uuid.UUID) (api.Product, error) {
+	return m.CreateProductFunc(ctx, receptionUUID, prType, attributes, barcode, weight, dimensions, createdBy)
+}
+
+func (m *MockRepository) CreateProducts(ctx context.Context, receptionUUID uuid.UUID, items []api.ProductBatchItem, createdBy uuid.UUID) ([]api.Product, error) {
+	return m.CreateProductsFunc(ctx, receptionUUID, items, createdBy)
+}
+
+// GetInStockProductsByBarcodes по умолчанию считает, что товаров с такими штрихкодами на складе нет
+func (m *MockRepository) GetInStockProductsByBarcodes(ctx context.Context, barcodes []string) ([]api.Product, error) {
+	if m.GetInStockProductsByBarcodesFunc == nil {
+		return nil, nil
+	}
+	return m.GetInStockProductsByBarcodesFunc(ctx, barcodes)
 }
 
 func (m *MockRepository) GetProductsByRecsUUIDs(ctx context.Context, recsUUIDs []uuid.UUID) ([]api.Product, error) {
 	return m.GetProductsByRecsUUIDsFunc(ctx, recsUUIDs)
 }
 
-func (m *MockRepository) DeleteLastProductByReceptionUUID(ctx context.Context, receptionUUID uuid.UUID) error {
-	return m.DeleteLastProductByReceptionUUIDFunc(ctx, receptionUUID)
+func (m *MockRepository) GetProductsByUUIDs(ctx context.Context, productUUIDs []uuid.UUID) ([]api.Product, error) {
+	return m.GetProductsByUUIDsFunc(ctx, productUUIDs)
+}
+
+func (m *MockRepository) GetDeletedProductsByReceptionUUID(ctx context.Context, receptionUUID uuid.UUID) ([]api.Product, error) {
+	return m.GetDeletedProductsByReceptionUUIDFunc(ctx, receptionUUID)
+}
+
+func (m *MockRepository) DeleteLastProductByReceptionUUID(ctx context.Context, receptionUUID uuid.UUID, deletedBy uuid.UUID) error {
+	return m.DeleteLastProductByReceptionUUIDFunc(ctx, receptionUUID, deletedBy)
+}
+
+func (m *MockRepository) GetProductByUUID(ctx context.Context, productUUID uuid.UUID) (api.Product, error) {
+	return m.GetProductByUUIDFunc(ctx, productUUID)
+}
+
+func (m *MockRepository) DeleteProduct(ctx context.Context, productUUID uuid.UUID, deletedBy uuid.UUID, reason string) error {
+	return m.DeleteProductFunc(ctx, productUUID, deletedBy, reason)
+}
+
+func (m *MockRepository) UpdateProduct(ctx context.Context, productUUID uuid.UUID, prType string, attributes api.ProductAttributes) (api.Product, error) {
+	return m.UpdateProductFunc(ctx, productUUID, prType, attributes)
+}
+
+func (m *MockRepository) UpdateProductStatus(ctx context.Context, transition models.ProductTransition) error {
+	return m.UpdateProductStatusFunc(ctx, transition)
+}
+
+func (m *MockRepository) GetProductPickupCodeHashForUpdate(ctx context.Context, productUUID uuid.UUID) (string, int, error) {
+	return m.GetProductPickupCodeHashForUpdateFunc(ctx, productUUID)
+}
+
+func (m *MockRepository) SetProductPickupCode(ctx context.Context, productUUID uuid.UUID, codeHash string) error {
+	return m.SetProductPickupCodeFunc(ctx, productUUID, codeHash)
+}
+
+func (m *MockRepository) RecordProductPickupAttempt(ctx context.Context, productUUID uuid.UUID) error {
+	return m.RecordProductPickupAttemptFunc(ctx, productUUID)
+}
+
+func (m *MockRepository) GetStockByPvzUUID(ctx context.Context, pvzUUID uuid.UUID, page, limit int) ([]api.Product, int, error) {
+	return m.GetStockByPvzUUIDFunc(ctx, pvzUUID, page, limit)
+}
+
+func (m *MockRepository) GetStockCountsByPvzUUID(ctx context.Context, pvzUUID uuid.UUID) (map[string]int, error) {
+	return m.GetStockCountsByPvzUUIDFunc(ctx, pvzUUID)
+}
+
+func (m *MockRepository) GetProductCountsByReceptionUUID(ctx context.Context, recUUID uuid.UUID) (map[string]int, error) {
+	return m.GetProductCountsByReceptionUUIDFunc(ctx, recUUID)
+}
+
+func (m *MockRepository) GetProductTypesByReceptionBarcodes(ctx context.Context, recUUID uuid.UUID, barcodes []string) (map[string]string, error) {
+	return m.GetProductTypesByReceptionBarcodesFunc(ctx, recUUID, barcodes)
+}
+
+func (m *MockRepository) CreateManifest(ctx context.Context, pvzUUID uuid.UUID, supplier string, strict bool, items []api.ManifestItem, createdBy uuid.UUID) (api.Manifest, error) {
+	return m.CreateManifestFunc(ctx, pvzUUID, supplier, strict, items, createdBy)
+}
+
+func (m *MockRepository) GetManifestByUUID(ctx context.Context, manifestUUID uuid.UUID) (api.Manifest, error) {
+	return m.GetManifestByUUIDFunc(ctx, manifestUUID)
+}
+
+// GetManifestsToReconcile по умолчанию возвращает пустой список, т.е. приемка закрывается без сверки
+func (m *MockRepository) GetManifestsToReconcile(ctx context.Context, pvzUUID, recUUID uuid.UUID) ([]api.Manifest, error) {
+	if m.GetManifestsToReconcileFunc == nil {
+		return nil, nil
+	}
+	return m.GetManifestsToReconcileFunc(ctx, pvzUUID, recUUID)
+}
+
+func (m *MockRepository) MarkManifestsReceived(ctx context.Context, manifestUUIDs []uuid.UUID, recUUID uuid.UUID) error {
+	return m.MarkManifestsReceivedFunc(ctx, manifestUUIDs, recUUID)
+}
+
+func (m *MockRepository) SaveDiscrepancyReport(ctx context.Context, report api.DiscrepancyReport) (api.DiscrepancyReport, error) {
+	return m.SaveDiscrepancyReportFunc(ctx, report)
+}
+
+func (m *MockRepository) GetDiscrepancyReportByReceptionUUID(ctx context.Context, recUUID uuid.UUID) (api.DiscrepancyReport, error) {
+	return m.GetDiscrepancyReportByReceptionUUIDFunc(ctx, recUUID)
+}
+
+func (m *MockRepository) GetStaleReceptions(ctx context.Context, idleSince time.Time) ([]api.Reception, error) {
+	return m.GetStaleReceptionsFunc(ctx, idleSince)
+}
+
+func (m *MockRepository) MarkReceptionStale(ctx context.Context, recUUID uuid.UUID) error {
+	return m.MarkReceptionStaleFunc(ctx, recUUID)
+}
+
+func (m *MockRepository) GetReceptionsFiltered(ctx context.Context, filter models.ReceptionFilter) ([]api.Reception, error) {
+	return m.GetReceptionsFilteredFunc(ctx, filter)
+}
+
+func (m *MockRepository) GetProductsByReceptionUUIDWithPagination(ctx context.Context, recUUID uuid.UUID, page, limit int) ([]api.Product, int, error) {
+	return m.GetProductsByReceptionUUIDWithPaginationFunc(ctx, recUUID, page, limit)
+}
+
+func (m *MockRepository) GetProductCountsByRecsUUIDs(ctx context.Context, recsUUIDs []uuid.UUID) (map[uuid.UUID]map[string]int, error) {
+	return m.GetProductCountsByRecsUUIDsFunc(ctx, recsUUIDs)
+}
+
+func (m *MockRepository) GetProductTypes(ctx context.Context) ([]api.ProductType, error) {
+	return m.GetProductTypesFunc(ctx)
+}
+
+// GetProductTypeByName по умолчанию возвращает тип без обязательных атрибутов
+func (m *MockRepository) GetProductTypeByName(ctx context.Context, name string) (api.ProductType, error) {
+	if m.GetProductTypeByNameFunc == nil {
+		return api.ProductType{Name: name, AttributesSchema: map[string]interface{}{"type": "object"}}, nil
+	}
+	return m.GetProductTypeByNameFunc(ctx, name)
+}
+
+func (m *MockRepository) CreateProductType(ctx context.Context, name string, attributesSchema map[string]interface{}) (api.ProductType, error) {
+	return m.CreateProductTypeFunc(ctx, name, attributesSchema)
+}
+
+func (m *MockRepository) UpdateProductTypeSchema(ctx context.Context, name string, attributesSchema map[string]interface{}) (api.ProductType, error) {
+	return m.UpdateProductTypeSchemaFunc(ctx, name, attributesSchema)
+}
+
+func (m *MockRepository) CreateOrder(ctx context.Context, pvzUUID uuid.UUID, number string, recipientPhone, recipientEmail *string, barcodes []string, createdBy uuid.UUID) (api.Order, error) {
+	return m.CreateOrderFunc(ctx, pvzUUID, number, recipientPhone, recipientEmail, barcodes, createdBy)
+}
+
+func (m *MockRepository) GetOrderByUUID(ctx context.Context, orderUUID uuid.UUID) (api.Order, error) {
+	return m.GetOrderByUUIDFunc(ctx, orderUUID)
+}
+
+// GetOrderByUUIDForUpdate без отдельной заглушки отвечает как GetOrderByUUID
+func (m *MockRepository) GetOrderByUUIDForUpdate(ctx context.Context, orderUUID uuid.UUID) (api.Order, error) {
+	if m.GetOrderByUUIDForUpdateFunc == nil {
+		return m.GetOrderByUUIDFunc(ctx, orderUUID)
+	}
+	return m.GetOrderByUUIDForUpdateFunc(ctx, orderUUID)
+}
+
+func (m *MockRepository) GetOrderPickupCodeHash(ctx context.Context, orderUUID uuid.UUID) (string, error) {
+	return m.GetOrderPickupCodeHashFunc(ctx, orderUUID)
+}
+
+// MatchOrderItems по умолчанию считает, что ожидающих заказов с такими штрихкодами нет
+func (m *MockRepository) MatchOrderItems(ctx context.Context, productUUIDs []uuid.UUID) error {
+	if m.MatchOrderItemsFunc == nil {
+		return nil
+	}
+	return m.MatchOrderItemsFunc(ctx, productUUIDs)
+}
+
+// GetOrdersReadyForPickup по умолчанию считает, что готовых к выдаче заказов нет
+func (m *MockRepository) GetOrdersReadyForPickup(ctx context.Context, pvzUUID uuid.UUID) ([]api.Order, error) {
+	if m.GetOrdersReadyForPickupFunc == nil {
+		return nil, nil
+	}
+	return m.GetOrdersReadyForPickupFunc(ctx, pvzUUID)
+}
+
+func (m *MockRepository) SetOrderPickupCode(ctx context.Context, orderUUID uuid.UUID, codeHash string) (api.Order, error) {
+	return m.SetOrderPickupCodeFunc(ctx, orderUUID, codeHash)
+}
+
+func (m *MockRepository) RecordPickupAttempt(ctx context.Context, orderUUID uuid.UUID) error {
+	return m.RecordPickupAttemptFunc(ctx, orderUUID)
+}
+
+func (m *MockRepository) IssueOrder(ctx context.Context, orderUUID uuid.UUID, issuedBy uuid.UUID) (api.Order, error) {
+	return m.IssueOrderFunc(ctx, orderUUID, issuedBy)
+}
+
+// IsProductInActiveOrder по умолчанию считает, что товар не относится к заказу
+func (m *MockRepository) IsProductInActiveOrder(ctx context.Context, productUUID uuid.UUID) (bool, error) {
+	if m.IsProductInActiveOrderFunc == nil {
+		return false, nil
+	}
+	return m.IsProductInActiveOrderFunc(ctx, productUUID)
+}
+
+func (m *MockRepository) CreateReturnShipment(ctx context.Context, pvzUUID uuid.UUID, createdBy uuid.UUID) (api.ReturnShipment, error) {
+	return m.CreateReturnShipmentFunc(ctx, pvzUUID, createdBy)
+}
+
+func (m *MockRepository) GetReturnShipmentByUUID(ctx context.Context, shipmentUUID uuid.UUID) (api.ReturnShipment, error) {
+	return m.GetReturnShipmentByUUIDFunc(ctx, shipmentUUID)
+}
+
+func (m *MockRepository) GetOpenReturnShipmentByPvzUUID(ctx context.Context, pvzUUID uuid.UUID) (api.ReturnShipment, error) {
+	return m.GetOpenReturnShipmentByPvzUUIDFunc(ctx, pvzUUID)
+}
+
+func (m *MockRepository) CloseReturnShipment(ctx context.Context, shipmentUUID uuid.UUID, actor models.AuthPrincipal) (api.ReturnShipment, error) {
+	return m.CloseReturnShipmentFunc(ctx, shipmentUUID, actor)
+}
+
+// GetReturnShipmentsByPvzUUIDsFiltered по умолчанию считает, что отправок возвратов нет
+func (m *MockRepository) GetReturnShipmentsByPvzUUIDsFiltered(ctx context.Context, pvzUUIDs []uuid.UUID, startDate, endDate *time.Time) ([]api.ReturnShipment, error) {
+	if m.GetReturnShipmentsByPvzUUIDsFilteredFunc == nil {
+		return nil, nil
+	}
+	return m.GetReturnShipmentsByPvzUUIDsFilteredFunc(ctx, pvzUUIDs, startDate, endDate)
+}
+
+func (m *MockRepository) CreateReturn(ctx context.Context, shipmentUUID, productUUID uuid.UUID, reasonCode, condition string, comment *string, createdBy uuid.UUID) (api.Return, error) {
+	return m.CreateReturnFunc(ctx, shipmentUUID, productUUID, reasonCode, condition, comment, createdBy)
+}
+
+func (m *MockRepository) CreateTransfer(ctx context.Context, sourcePvzUUID, destinationPvzUUID uuid.UUID, productUUIDs []uuid.UUID, createdBy uuid.UUID) (api.Transfer, error) {
+	return m.CreateTransferFunc(ctx, sourcePvzUUID, destinationPvzUUID, productUUIDs, createdBy)
+}
+
+func (m *MockRepository) GetTransferByUUID(ctx context.Context, transferUUID uuid.UUID) (api.Transfer, error) {
+	return m.GetTransferByUUIDFunc(ctx, transferUUID)
+}
+
+// GetTransferByUUIDForUpdate без отдельной заглушки отвечает как GetTransferByUUID
+func (m *MockRepository) GetTransferByUUIDForUpdate(ctx context.Context, transferUUID uuid.UUID) (api.Transfer, error) {
+	if m.GetTransferByUUIDForUpdateFunc == nil {
+		return m.GetTransferByUUIDFunc(ctx, transferUUID)
+	}
+	return m.GetTransferByUUIDForUpdateFunc(ctx, transferUUID)
+}
+
+func (m *MockRepository) GetTransfersInTransit(ctx context.Context, pvzUUID *uuid.UUID) ([]api.Transfer, error) {
+	return m.GetTransfersInTransitFunc(ctx, pvzUUID)
+}
+
+func (m *MockRepository) DispatchTransfer(ctx context.Context, transferUUID uuid.UUID, actor models.AuthPrincipal) (api.Transfer, error) {
+	return m.DispatchTransferFunc(ctx, transferUUID, actor)
+}
+
+func (m *MockRepository) ReceiveTransfer(ctx context.Context, transferUUID, recUUID uuid.UUID, actor models.AuthPrincipal) (api.Transfer, error) {
+	return m.ReceiveTransferFunc(ctx, transferUUID, recUUID, actor)
+}
+
+func (m *MockRepository) CreateTransferReception(ctx context.Context, pvzUUID uuid.UUID, actor models.AuthPrincipal) (api.Reception, error) {
+	return m.CreateTransferReceptionFunc(ctx, pvzUUID, actor)
+}
+
+func (m *MockRepository) CreateStorageCell(ctx context.Context, pvzUUID uuid.UUID, rack, shelf, cell, code string, capacity int) (api.StorageCell, error) {
+	return m.CreateStorageCellFunc(ctx, pvzUUID, rack, shelf, cell, code, capacity)
+}
+
+// GetStorageCellsByPvzUUID по умолчанию считает, что ячеек хранения в ПВЗ нет
+func (m *MockRepository) GetStorageCellsByPvzUUID(ctx context.Context, pvzUUID uuid.UUID) ([]api.StorageCell, error) {
+	if m.GetStorageCellsByPvzUUIDFunc == nil {
+		return nil, nil
+	}
+	return m.GetStorageCellsByPvzUUIDFunc(ctx, pvzUUID)
+}
+
+// GetStorageCellsByPvzUUIDForUpdate по умолчанию считает, что ячеек хранения в ПВЗ нет
+func (m *MockRepository) GetStorageCellsByPvzUUIDForUpdate(ctx context.Context, pvzUUID uuid.UUID) ([]api.StorageCell, error) {
+	if m.GetStorageCellsByPvzUUIDForUpdateFunc == nil {
+		return nil, nil
+	}
+	return m.GetStorageCellsByPvzUUIDForUpdateFunc(ctx, pvzUUID)
+}
+
+func (m *MockRepository) GetStorageCellByUUID(ctx context.Context, cellUUID uuid.UUID) (api.StorageCell, error) {
+	return m.GetStorageCellByUUIDFunc(ctx, cellUUID)
+}
+
+func (m *MockRepository) SetProductCell(ctx context.Context, productUUID, cellUUID uuid.UUID) error {
+	return m.SetProductCellFunc(ctx, productUUID, cellUUID)
+}
+
+func (m *MockRepository) GetProductLocation(ctx context.Context, productUUID uuid.UUID) (api.ProductLocation, error) {
+	return m.GetProductLocationFunc(ctx, productUUID)
+}
+
+// GetPvzLimits по умолчанию считает, что ограничения ПВЗ не заданы
+func (m *MockRepository) GetPvzLimits(ctx context.Context, pvzUUID uuid.UUID) (api.PvzLimits, error) {
+	if m.GetPvzLimitsFunc == nil {
+		return api.PvzLimits{OnExceed: api.Reject}, nil
+	}
+	return m.GetPvzLimitsFunc(ctx, pvzUUID)
+}
+
+func (m *MockRepository) SetPvzLimits(ctx context.Context, pvzUUID uuid.UUID, limits api.PvzLimits, updatedBy uuid.UUID) (api.PvzLimits, error) {
+	return m.SetPvzLimitsFunc(ctx, pvzUUID, limits, updatedBy)
+}
+
+func (m *MockRepository) GetPvzCapacityUsage(ctx context.Context, pvzUUID uuid.UUID) (api.CapacityUsage, error) {
+	return m.GetPvzCapacityUsageFunc(ctx, pvzUUID)
+}
+
+func (m *MockRepository) GetCellsCapacityUsage(ctx context.Context, pvzUUID uuid.UUID) ([]api.CellCapacity, error) {
+	return m.GetCellsCapacityUsageFunc(ctx, pvzUUID)
+}
+
+func (m *MockRepository) CreateAttachment(ctx context.Context, attachment models.NewAttachment) (api.Attachment, error) {
+	return m.CreateAttachmentFunc(ctx, attachment)
+}
+
+func (m *MockRepository) GetAttachmentsByOwner(ctx context.Context, owner models.AttachmentOwner) ([]api.Attachment, error) {
+	return m.GetAttachmentsByOwnerFunc(ctx, owner)
+}
+
+func (m *MockRepository) GetAttachmentByUUID(ctx context.Context, attachmentUUID uuid.UUID) (api.Attachment, string, error) {
+	return m.GetAttachmentByUUIDFunc(ctx, attachmentUUID)
+}
+
+func (m *MockRepository) GetReceptionStats(ctx context.Context, filter models.ReceptionStatsFilter) ([]api.ReceptionStatsRow, error) {
+	return m.GetReceptionStatsFunc(ctx, filter)
+}
+
+func (m *MockRepository) ExportPVZs(ctx context.Context, startDate, endDate *time.Time, fn func(row models.PvzExportRowDB) error) error {
+	return m.ExportPVZsFunc(ctx, startDate, endDate, fn)
+}
+
+func (m *MockRepository) ExportReceptions(ctx context.Context, filter models.ReceptionFilter, fn func(row models.ReceptionExportRowDB) error) error {
+	return m.ExportReceptionsFunc(ctx, filter, fn)
+}
+
+func (m *MockRepository) GetReceptionTimings(ctx context.Context, filter models.ProductivityFilter) ([]models.ReceptionTiming, error) {
+	return m.GetReceptionTimingsFunc(ctx, filter)
+}
+
+func (m *MockRepository) SaveProductivityReport(ctx context.Context, day time.Time, report api.ProductivityReport) error {
+	return m.SaveProductivityReportFunc(ctx, day, report)
+}
+
+func (m *MockRepository) GetProductivityReportByDate(ctx context.Context, day time.Time) (api.ProductivityReport, error) {
+	return m.GetProductivityReportByDateFunc(ctx, day)
 }
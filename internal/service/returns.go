@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/devWaylander/pvz_store/api"
+	internalErrors "github.com/devWaylander/pvz_store/pkg/errors"
+	"github.com/devWaylander/pvz_store/pkg/models"
+	"github.com/google/uuid"
+)
+
+/*
+Return shipment
+*/
+func (s *service) CreateReturnShipment(ctx context.Context, data api.PostReturnShipmentsJSONBody) (api.ReturnShipment, error) {
+	actor, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.ReturnShipment{}, err
+	}
+
+	isPVZExist, err := s.repo.IsPVZExist(ctx, data.PvzId)
+	if err != nil {
+		return api.ReturnShipment{}, err
+	}
+	if !isPVZExist {
+		return api.ReturnShipment{}, errors.New(internalErrors.ErrPVZDoesntExist)
+	}
+
+	return s.repo.CreateReturnShipment(ctx, data.PvzId, actor.UserUUID)
+}
+
+func (s *service) GetReturnShipment(ctx context.Context, shipmentUUID uuid.UUID) (api.ReturnShipment, error) {
+	shipment, err := s.repo.GetReturnShipmentByUUID(ctx, shipmentUUID)
+	if err != nil {
+		return api.ReturnShipment{}, err
+	}
+	if shipment.Id == nil {
+		return api.ReturnShipment{}, errors.New(internalErrors.ErrReturnShipmentDoesntExist)
+	}
+
+	return shipment, nil
+}
+
+// CloseReturnShipment закрывает открытую отправку возвратов ПВЗ, товары отправки возвращаются отправителю
+func (s *service) CloseReturnShipment(ctx context.Context, pvzUUID uuid.UUID) (api.ReturnShipment, error) {
+	actor, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.ReturnShipment{}, err
+	}
+
+	var shipment api.ReturnShipment
+	err = s.repo.WithTx(ctx, func(ctx context.Context) error {
+		open, err := s.getOpenReturnShipment(ctx, pvzUUID)
+		if err != nil {
+			return err
+		}
+
+		shipment, err = s.repo.CloseReturnShipment(ctx, *open.Id, *actor)
+		return err
+	})
+	if err != nil {
+		return api.ReturnShipment{}, err
+	}
+
+	return shipment, nil
+}
+
+/*
+Return
+*/
+// CreateReturn оформляет возврат выданного товара или отказ от товара на хранении
+// и добавляет его в открытую отправку возвратов ПВЗ. Вернуть можно только товар этого ПВЗ
+func (s *service) CreateReturn(ctx context.Context, data api.PostReturnsJSONBody) (api.Return, error) {
+	comment := trimmedOrNil(data.Comment)
+	reason := string(data.ReasonCode)
+	if comment != nil {
+		reason += ": " + *comment
+	}
+
+	var ret api.Return
+	err := s.repo.WithTx(ctx, func(ctx context.Context) error {
+		shipment, err := s.getOpenReturnShipment(ctx, data.PvzId)
+		if err != nil {
+			return err
+		}
+
+		location, err := s.getProductLocation(ctx, data.ProductId)
+		if err != nil {
+			return err
+		}
+		if location.PvzId != data.PvzId {
+			return errors.New(internalErrors.ErrReturnWrongPvz)
+		}
+
+		transition, err := s.newProductTransition(ctx, data.ProductId, api.ProductStatusReturned, reason)
+		if err != nil {
+			return err
+		}
+
+		// от товаров заказа на хранении получатель отказывается только целым заказом
+		if transition.From == string(api.ProductStatusStored) {
+			inOrder, err := s.repo.IsProductInActiveOrder(ctx, data.ProductId)
+			if err != nil {
+				return err
+			}
+			if inOrder {
+				return errors.New(internalErrors.ErrProductInOrder)
+			}
+		}
+
+		if err := s.repo.UpdateProductStatus(ctx, transition); err != nil {
+			return err
+		}
+
+		ret, err = s.repo.CreateReturn(ctx, *shipment.Id, data.ProductId, string(data.ReasonCode), string(data.Condition), comment, transition.ActorID)
+		return err
+	})
+	if err != nil {
+		return api.Return{}, err
+	}
+
+	return ret, nil
+}
+
+// getOpenReturnShipment возвращает открытую отправку возвратов ПВЗ, блокируя её до конца транзакции
+func (s *service) getOpenReturnShipment(ctx context.Context, pvzUUID uuid.UUID) (api.ReturnShipment, error) {
+	isPVZExist, err := s.repo.IsPVZExist(ctx, pvzUUID)
+	if err != nil {
+		return api.ReturnShipment{}, err
+	}
+	if !isPVZExist {
+		return api.ReturnShipment{}, errors.New(internalErrors.ErrPVZDoesntExist)
+	}
+
+	shipment, err := s.repo.GetOpenReturnShipmentByPvzUUID(ctx, pvzUUID)
+	if err != nil {
+		return api.ReturnShipment{}, err
+	}
+	if shipment.Id == nil {
+		return api.ReturnShipment{}, errors.New(internalErrors.ErrNoOpenReturnShipment)
+	}
+
+	return shipment, nil
+}
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/devWaylander/pvz_store/api"
+	internalErrors "github.com/devWaylander/pvz_store/pkg/errors"
+	"github.com/devWaylander/pvz_store/pkg/models"
+	"github.com/google/uuid"
+)
+
+func Test_service_CreateReturn(t *testing.T) {
+	pvzUuid := uuid.New()
+	shipmentUuid := uuid.New()
+	productUuid := uuid.New()
+
+	tests := []struct {
+		name         string
+		status       api.ProductStatus
+		openShipment bool
+		inOrder      bool
+		otherPvz     bool
+		wantErr      string
+	}{
+		{
+			name:         "Return issued product",
+			status:       api.ProductStatusIssued,
+			openShipment: true,
+		},
+		{
+			name:         "Refuse stored product",
+			status:       api.ProductStatusStored,
+			openShipment: true,
+		},
+		{
+			name:         "No open return shipment",
+			status:       api.ProductStatusIssued,
+			openShipment: false,
+			wantErr:      internalErrors.ErrNoOpenReturnShipment,
+		},
+		{
+			name:         "Product is not received by customer yet",
+			status:       api.ProductStatusReceived,
+			openShipment: true,
+			wantErr:      internalErrors.ErrProductTransition,
+		},
+		{
+			name:         "Product already returned",
+			status:       api.ProductStatusReturned,
+			openShipment: true,
+			wantErr:      internalErrors.ErrProductTransition,
+		},
+		{
+			name:         "Stored product of active order",
+			status:       api.ProductStatusStored,
+			openShipment: true,
+			inOrder:      true,
+			wantErr:      internalErrors.ErrProductInOrder,
+		},
+		{
+			name:         "Product of another PVZ",
+			status:       api.ProductStatusIssued,
+			openShipment: true,
+			otherPvz:     true,
+			wantErr:      internalErrors.ErrReturnWrongPvz,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := tt.status
+			var saved models.ProductTransition
+			repo := &MockRepository{
+				IsPVZExistFunc: func(ctx context.Context, id uuid.UUID) (bool, error) {
+					return true, nil
+				},
+				GetOpenReturnShipmentByPvzUUIDFunc: func(ctx context.Context, id uuid.UUID) (api.ReturnShipment, error) {
+					if !tt.openShipment {
+						return api.ReturnShipment{}, nil
+					}
+					return api.ReturnShipment{Id: &shipmentUuid, PvzId: id, Status: api.ReturnShipmentStatusInProgress}, nil
+				},
+				GetProductByUUIDFunc: func(ctx context.Context, id uuid.UUID) (api.Product, error) {
+					return api.Product{Id: &productUuid, Status: &status}, nil
+				},
+				GetProductLocationFunc: func(ctx context.Context, id uuid.UUID) (api.ProductLocation, error) {
+					location := api.ProductLocation{ProductId: id, PvzId: pvzUuid, Status: status}
+					if tt.otherPvz {
+						location.PvzId = uuid.New()
+					}
+					return location, nil
+				},
+				IsProductInActiveOrderFunc: func(ctx context.Context, id uuid.UUID) (bool, error) {
+					return tt.inOrder, nil
+				},
+				UpdateProductStatusFunc: func(ctx context.Context, transition models.ProductTransition) error {
+					saved = transition
+					return nil
+				},
+				CreateReturnFunc: func(ctx context.Context, shipmentUUID, productUUID uuid.UUID, reasonCode, condition string, comment *string, createdBy uuid.UUID) (api.Return, error) {
+					return api.Return{
+						ProductId:  productUUID,
+						ShipmentId: shipmentUUID,
+						ReasonCode: api.ReturnReasonCode(reasonCode),
+						Condition:  api.ReturnCondition(condition),
+						Comment:    comment,
+					}, nil
+				},
+			}
+			s := New(repo, nil, nil, nil, nil)
+
+			comment := " упаковка вскрыта "
+			got, err := s.CreateReturn(employeeCtx(), api.PostReturnsJSONBody{
+				PvzId:      pvzUuid,
+				ProductId:  productUuid,
+				ReasonCode: api.Refused,
+				Condition:  api.Opened,
+				Comment:    &comment,
+			})
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("CreateReturn() unexpected error = %v", err)
+				}
+				if got.ShipmentId != shipmentUuid || got.Comment == nil || *got.Comment != "упаковка вскрыта" {
+					t.Errorf("CreateReturn() = %+v", got)
+				}
+				if saved.From != string(tt.status) || saved.To != string(api.ProductStatusReturned) || saved.Reason != "refused: упаковка вскрыта" {
+					t.Errorf("CreateReturn() transition = %+v", saved)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("CreateReturn() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_service_CloseReturnShipment(t *testing.T) {
+	pvzUuid := uuid.New()
+	shipmentUuid := uuid.New()
+
+	open := true
+	var closedBy uuid.UUID
+	repo := &MockRepository{
+		IsPVZExistFunc: func(ctx context.Context, id uuid.UUID) (bool, error) {
+			return true, nil
+		},
+		GetOpenReturnShipmentByPvzUUIDFunc: func(ctx context.Context, id uuid.UUID) (api.ReturnShipment, error) {
+			if !open {
+				return api.ReturnShipment{}, nil
+			}
+			return api.ReturnShipment{Id: &shipmentUuid, PvzId: id, Status: api.ReturnShipmentStatusInProgress}, nil
+		},
+		CloseReturnShipmentFunc: func(ctx context.Context, id uuid.UUID, actor models.AuthPrincipal) (api.ReturnShipment, error) {
+			open = false
+			closedBy = actor.UserUUID
+			return api.ReturnShipment{Id: &id, PvzId: pvzUuid, Status: api.ReturnShipmentStatusClosed, ClosedBy: &actor.UserUUID}, nil
+		},
+	}
+	s := New(repo, nil, nil, nil, nil)
+
+	got, err := s.CloseReturnShipment(employeeCtx(), pvzUuid)
+	if err != nil {
+		t.Fatalf("CloseReturnShipment() unexpected error = %v", err)
+	}
+	if got.Status != api.ReturnShipmentStatusClosed || got.ClosedBy == nil || *got.ClosedBy != closedBy {
+		t.Errorf("CloseReturnShipment() = %+v", got)
+	}
+
+	_, err = s.CloseReturnShipment(employeeCtx(), pvzUuid)
+	if err == nil || err.Error() != internalErrors.ErrNoOpenReturnShipment {
+		t.Errorf("CloseReturnShipment() error = %v, want %v", err, internalErrors.ErrNoOpenReturnShipment)
+	}
+}
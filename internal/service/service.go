@@ -3,38 +3,167 @@ package service
 import (
 	"context"
 	"errors"
+	"io"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/devWaylander/pvz_store/api"
 	internalErrors "github.com/devWaylander/pvz_store/pkg/errors"
 	"github.com/devWaylander/pvz_store/pkg/models"
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/google/uuid"
 )
 
+// maxProductsBatchSize максимальное число позиций в пакетном добавлении товаров, совпадает с maxItems в swagger
+const maxProductsBatchSize = 100
+
+// pvzStreamBatchSize число ПВЗ потокового списка, для которых количества товаров и отправки возвратов дочитываются одним запросом
+const pvzStreamBatchSize = 100
+
 type Repository interface {
+	// Transaction
+	WithTx(ctx context.Context, fn func(ctx context.Context) error) error
 	// PVZ
 	CreatePVZ(ctx context.Context, id uuid.UUID, city string, registrationDate time.Time) (api.PVZ, error)
 	IsPVZExist(ctx context.Context, id uuid.UUID) (bool, error)
+	LockPVZ(ctx context.Context, id uuid.UUID) (bool, error)
 	GetPVZsWithPagination(ctx context.Context, page, limit int) ([]api.PVZ, error)
+	GetPVZsInfo(ctx context.Context, filter models.PvzInfoFilter) ([]models.PvzInfo, error)
+	StreamPVZsInfo(ctx context.Context, filter models.PvzInfoFilter, fn func(info models.PvzInfo) error) error
 	// Reception
-	CreateReception(ctx context.Context, pvzUUID uuid.UUID, status string) (api.Reception, error)
+	CreateReception(ctx context.Context, pvzUUID uuid.UUID, status string, actor models.AuthPrincipal) (api.Reception, error)
+	GetReceptionByUUID(ctx context.Context, recUUID uuid.UUID) (api.Reception, error)
+	GetReceptionByUUIDForUpdate(ctx context.Context, recUUID uuid.UUID) (api.Reception, error)
 	GetReceptionByPvzUUID(ctx context.Context, pvzUUID uuid.UUID) (api.Reception, error)
+	GetReceptionByPvzUUIDForUpdate(ctx context.Context, pvzUUID uuid.UUID) (api.Reception, error)
 	GetReceptionsByPvzUUIDsFiltered(ctx context.Context, pvzUUIDs []uuid.UUID, startDate, endDate *time.Time) ([]api.Reception, error)
 	GetReceptionStatusByPvzUUID(ctx context.Context, pvzUUID uuid.UUID) (string, error)
-	UpdateReceptionStatus(ctx context.Context, recUUID uuid.UUID, status string) error
+	UpdateReceptionStatus(ctx context.Context, transition models.ReceptionTransition) error
+	CancelReception(ctx context.Context, transition models.ReceptionTransition) error
+	GetReceptionStatusHistory(ctx context.Context, recUUID uuid.UUID) ([]api.ReceptionStatusChange, error)
+	GetReceptionsFiltered(ctx context.Context, filter models.ReceptionFilter) ([]api.Reception, error)
+	GetStaleReceptions(ctx context.Context, idleSince time.Time) ([]api.Reception, error)
+	MarkReceptionStale(ctx context.Context, recUUID uuid.UUID) error
 	// Product
-	CreateProduct(ctx context.Context, receptionUUID uuid.UUID, prType string) (api.Product, error)
+	CreateProduct(ctx context.Context, receptionUUID uuid.UUID, prType string, attributes api.ProductAttributes, barcode *string, weight *int, dimensions *api.ProductDimensions, createdBy uuid.UUID) (api.Product, error)
+	CreateProducts(ctx context.Context, receptionUUID uuid.UUID, items []api.ProductBatchItem, createdBy uuid.UUID) ([]api.Product, error)
 	GetProductsByRecsUUIDs(ctx context.Context, recsUUIDs []uuid.UUID) ([]api.Product, error)
-	DeleteLastProductByReceptionUUID(ctx context.Context, receptionUUID uuid.UUID) error
+	GetProductsByUUIDs(ctx context.Context, productUUIDs []uuid.UUID) ([]api.Product, error)
+	GetDeletedProductsByReceptionUUID(ctx context.Context, receptionUUID uuid.UUID) ([]api.Product, error)
+	GetInStockProductsByBarcodes(ctx context.Context, barcodes []string) ([]api.Product, error)
+	DeleteLastProductByReceptionUUID(ctx context.Context, receptionUUID uuid.UUID, deletedBy uuid.UUID) error
+	GetProductByUUID(ctx context.Context, productUUID uuid.UUID) (api.Product, error)
+	DeleteProduct(ctx context.Context, productUUID uuid.UUID, deletedBy uuid.UUID, reason string) error
+	UpdateProduct(ctx context.Context, productUUID uuid.UUID, prType string, attributes api.ProductAttributes) (api.Product, error)
+	UpdateProductStatus(ctx context.Context, transition models.ProductTransition) error
+	GetProductPickupCodeHashForUpdate(ctx context.Context, productUUID uuid.UUID) (string, int, error)
+	SetProductPickupCode(ctx context.Context, productUUID uuid.UUID, codeHash string) error
+	RecordProductPickupAttempt(ctx context.Context, productUUID uuid.UUID) error
+	GetStockByPvzUUID(ctx context.Context, pvzUUID uuid.UUID, page, limit int) ([]api.Product, int, error)
+	GetStockCountsByPvzUUID(ctx context.Context, pvzUUID uuid.UUID) (map[string]int, error)
+	GetProductsByReceptionUUIDWithPagination(ctx context.Context, recUUID uuid.UUID, page, limit int) ([]api.Product, int, error)
+	GetProductCountsByReceptionUUID(ctx context.Context, recUUID uuid.UUID) (map[string]int, error)
+	GetProductTypesByReceptionBarcodes(ctx context.Context, recUUID uuid.UUID, barcodes []string) (map[string]string, error)
+	GetProductCountsByRecsUUIDs(ctx context.Context, recsUUIDs []uuid.UUID) (map[uuid.UUID]map[string]int, error)
+	// Product type
+	GetProductTypes(ctx context.Context) ([]api.ProductType, error)
+	GetProductTypeByName(ctx context.Context, name string) (api.ProductType, error)
+	CreateProductType(ctx context.Context, name string, attributesSchema map[string]interface{}) (api.ProductType, error)
+	UpdateProductTypeSchema(ctx context.Context, name string, attributesSchema map[string]interface{}) (api.ProductType, error)
+	// Manifest
+	CreateManifest(ctx context.Context, pvzUUID uuid.UUID, supplier string, strict bool, items []api.ManifestItem, createdBy uuid.UUID) (api.Manifest, error)
+	GetManifestByUUID(ctx context.Context, manifestUUID uuid.UUID) (api.Manifest, error)
+	GetManifestsToReconcile(ctx context.Context, pvzUUID, recUUID uuid.UUID) ([]api.Manifest, error)
+	MarkManifestsReceived(ctx context.Context, manifestUUIDs []uuid.UUID, recUUID uuid.UUID) error
+	SaveDiscrepancyReport(ctx context.Context, report api.DiscrepancyReport) (api.DiscrepancyReport, error)
+	GetDiscrepancyReportByReceptionUUID(ctx context.Context, recUUID uuid.UUID) (api.DiscrepancyReport, error)
+	// Order
+	CreateOrder(ctx context.Context, pvzUUID uuid.UUID, number string, recipientPhone, recipientEmail *string, barcodes []string, createdBy uuid.UUID) (api.Order, error)
+	GetOrderByUUID(ctx context.Context, orderUUID uuid.UUID) (api.Order, error)
+	GetOrderByUUIDForUpdate(ctx context.Context, orderUUID uuid.UUID) (api.Order, error)
+	GetOrderPickupCodeHash(ctx context.Context, orderUUID uuid.UUID) (string, error)
+	MatchOrderItems(ctx context.Context, productUUIDs []uuid.UUID) error
+	GetOrdersReadyForPickup(ctx context.Context, pvzUUID uuid.UUID) ([]api.Order, error)
+	SetOrderPickupCode(ctx context.Context, orderUUID uuid.UUID, codeHash string) (api.Order, error)
+	RecordPickupAttempt(ctx context.Context, orderUUID uuid.UUID) error
+	IssueOrder(ctx context.Context, orderUUID uuid.UUID, issuedBy uuid.UUID) (api.Order, error)
+	IsProductInActiveOrder(ctx context.Context, productUUID uuid.UUID) (bool, error)
+	// Return
+	CreateReturnShipment(ctx context.Context, pvzUUID uuid.UUID, createdBy uuid.UUID) (api.ReturnShipment, error)
+	GetReturnShipmentByUUID(ctx context.Context, shipmentUUID uuid.UUID) (api.ReturnShipment, error)
+	GetOpenReturnShipmentByPvzUUID(ctx context.Context, pvzUUID uuid.UUID) (api.ReturnShipment, error)
+	CloseReturnShipment(ctx context.Context, shipmentUUID uuid.UUID, actor models.AuthPrincipal) (api.ReturnShipment, error)
+	GetReturnShipmentsByPvzUUIDsFiltered(ctx context.Context, pvzUUIDs []uuid.UUID, startDate, endDate *time.Time) ([]api.ReturnShipment, error)
+	CreateReturn(ctx context.Context, shipmentUUID, productUUID uuid.UUID, reasonCode, condition string, comment *string, createdBy uuid.UUID) (api.Return, error)
+	// Transfer
+	CreateTransfer(ctx context.Context, sourcePvzUUID, destinationPvzUUID uuid.UUID, productUUIDs []uuid.UUID, createdBy uuid.UUID) (api.Transfer, error)
+	GetTransferByUUID(ctx context.Context, transferUUID uuid.UUID) (api.Transfer, error)
+	GetTransferByUUIDForUpdate(ctx context.Context, transferUUID uuid.UUID) (api.Transfer, error)
+	GetTransfersInTransit(ctx context.Context, pvzUUID *uuid.UUID) ([]api.Transfer, error)
+	DispatchTransfer(ctx context.Context, transferUUID uuid.UUID, actor models.AuthPrincipal) (api.Transfer, error)
+	ReceiveTransfer(ctx context.Context, transferUUID, recUUID uuid.UUID, actor models.AuthPrincipal) (api.Transfer, error)
+	CreateTransferReception(ctx context.Context, pvzUUID uuid.UUID, actor models.AuthPrincipal) (api.Reception, error)
+	// Storage cell
+	CreateStorageCell(ctx context.Context, pvzUUID uuid.UUID, rack, shelf, cell, code string, capacity int) (api.StorageCell, error)
+	GetStorageCellsByPvzUUID(ctx context.Context, pvzUUID uuid.UUID) ([]api.StorageCell, error)
+	GetStorageCellsByPvzUUIDForUpdate(ctx context.Context, pvzUUID uuid.UUID) ([]api.StorageCell, error)
+	GetStorageCellByUUID(ctx context.Context, cellUUID uuid.UUID) (api.StorageCell, error)
+	SetProductCell(ctx context.Context, productUUID, cellUUID uuid.UUID) error
+	GetProductLocation(ctx context.Context, productUUID uuid.UUID) (api.ProductLocation, error)
+	// Capacity
+	GetPvzLimits(ctx context.Context, pvzUUID uuid.UUID) (api.PvzLimits, error)
+	SetPvzLimits(ctx context.Context, pvzUUID uuid.UUID, limits api.PvzLimits, updatedBy uuid.UUID) (api.PvzLimits, error)
+	GetPvzCapacityUsage(ctx context.Context, pvzUUID uuid.UUID) (api.CapacityUsage, error)
+	GetCellsCapacityUsage(ctx context.Context, pvzUUID uuid.UUID) ([]api.CellCapacity, error)
+	// Attachment
+	CreateAttachment(ctx context.Context, attachment models.NewAttachment) (api.Attachment, error)
+	GetAttachmentsByOwner(ctx context.Context, owner models.AttachmentOwner) ([]api.Attachment, error)
+	GetAttachmentByUUID(ctx context.Context, attachmentUUID uuid.UUID) (api.Attachment, string, error)
+	// Stats
+	GetReceptionStats(ctx context.Context, filter models.ReceptionStatsFilter) ([]api.ReceptionStatsRow, error)
+	// Export
+	ExportPVZs(ctx context.Context, startDate, endDate *time.Time, fn func(row models.PvzExportRowDB) error) error
+	ExportReceptions(ctx context.Context, filter models.ReceptionFilter, fn func(row models.ReceptionExportRowDB) error) error
+	// Productivity report
+	GetReceptionTimings(ctx context.Context, filter models.ProductivityFilter) ([]models.ReceptionTiming, error)
+	SaveProductivityReport(ctx context.Context, day time.Time, report api.ProductivityReport) error
+	GetProductivityReportByDate(ctx context.Context, day time.Time) (api.ProductivityReport, error)
+}
+
+// Notifier доставляет получателю код выдачи заказа
+type Notifier interface {
+	SendPickupCode(ctx context.Context, order api.Order, code string) error
+}
+
+// BlobStore хранит содержимое вложений по ключу (локальный диск или S3-совместимое хранилище)
+type BlobStore interface {
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
 }
 
 type service struct {
-	repo Repository
+	repo      Repository
+	notifier  Notifier
+	placement PlacementStrategy
+	blobs     BlobStore
+	// pickupSecret ключ HMAC кодов выдачи
+	pickupSecret []byte
 }
 
-func New(repo Repository) *service {
+// New создает сервис, без стратегии размещения товары раскладываются по первой свободной ячейке
+func New(repo Repository, notifier Notifier, placement PlacementStrategy, blobs BlobStore, pickupSecret []byte) *service {
+	if placement == nil {
+		placement = firstFitPlacement{}
+	}
+
 	return &service{
-		repo: repo,
+		repo:         repo,
+		notifier:     notifier,
+		placement:    placement,
+		blobs:        blobs,
+		pickupSecret: pickupSecret,
 	}
 }
 
@@ -54,30 +183,350 @@ func (s *service) CreatePVZ(ctx context.Context, data api.PVZ) (api.PVZ, error)
 	return pvz, nil
 }
 
+// GetPVZsInfo читает страницу ПВЗ с разделами, запрошенными через include и fields.
+// Приемки и товары читаются одним агрегирующим запросом, количества товаров и отправки возвратов
+// дочитываются отдельно. Не запрошенные таблицы не читаются
 func (s *service) GetPVZsInfo(ctx context.Context, data api.GetPvzParams) ([]models.PvzInfo, error) {
-	if data.Page == nil || *data.Page < 1 {
-		page := 1
-		data.Page = &page
+	page, limit := pvzPageParams(data)
+	projection := models.NewPvzInfoProjection(data.Include, data.Fields)
+
+	var pvzsInfo []models.PvzInfo
+	if projection.Receptions {
+		var err error
+		pvzsInfo, err = s.repo.GetPVZsInfo(ctx, models.PvzInfoFilter{
+			Page:           page,
+			Limit:          limit,
+			StartDate:      data.StartDate,
+			EndDate:        data.EndDate,
+			WithReceptions: true,
+			WithProducts:   projection.Products,
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		pvzs, err := s.repo.GetPVZsWithPagination(ctx, page, limit)
+		if err != nil {
+			return nil, err
+		}
+		for _, pvz := range pvzs {
+			pvzsInfo = append(pvzsInfo, models.PvzInfo{Pvz: pvz})
+		}
+	}
+
+	if err := s.fillPVZsInfo(ctx, pvzsInfo, projection, data.StartDate, data.EndDate); err != nil {
+		return nil, err
+	}
+
+	return pvzsInfo, nil
+}
+
+// StreamPVZsInfo возвращает функцию, которая читает страницу ПВЗ одним курсором по агрегирующему запросу
+// и передает ПВЗ с разделами, запрошенными через include и fields, в fn по мере чтения.
+// Количества товаров и отправки возвратов дочитываются пачками не больше pvzStreamBatchSize ПВЗ,
+// поэтому память ограничена пачкой независимо от размера страницы
+func (s *service) StreamPVZsInfo(ctx context.Context, data api.GetPvzParams) (func(fn func(info models.PvzInfo) error) error, error) {
+	page, limit := pvzPageParams(data)
+	projection := models.NewPvzInfoProjection(data.Include, data.Fields)
+	filter := models.PvzInfoFilter{
+		Page:           page,
+		Limit:          limit,
+		StartDate:      data.StartDate,
+		EndDate:        data.EndDate,
+		WithReceptions: projection.Receptions,
+		WithProducts:   projection.Products,
 	}
-	if data.Limit == nil || *data.Limit < 1 {
-		limit := 10
-		data.Limit = &limit
+
+	// без дочитываемых разделов ПВЗ отдаются сразу после чтения
+	batchSize := 1
+	if projection.ProductCounts || projection.ReturnShipments {
+		batchSize = pvzStreamBatchSize
 	}
 
-	pvzs, err := s.repo.GetPVZsWithPagination(ctx, *data.Page, *data.Limit)
+	return func(fn func(info models.PvzInfo) error) error {
+		batch := make([]models.PvzInfo, 0, batchSize)
+		flush := func() error {
+			if err := s.fillPVZsInfo(ctx, batch, projection, data.StartDate, data.EndDate); err != nil {
+				return err
+			}
+			for _, info := range batch {
+				if err := fn(info); err != nil {
+					return err
+				}
+			}
+			batch = batch[:0]
+
+			return nil
+		}
+
+		err := s.repo.StreamPVZsInfo(ctx, filter, func(info models.PvzInfo) error {
+			batch = append(batch, info)
+			if len(batch) < batchSize {
+				return nil
+			}
+			return flush()
+		})
+		if err != nil {
+			return err
+		}
+
+		return flush()
+	}, nil
+}
+
+// pvzPageParams страница и размер страницы списка ПВЗ, по умолчанию первая страница из 10 ПВЗ
+func pvzPageParams(data api.GetPvzParams) (int, int) {
+	page, limit := 1, 10
+	if data.Page != nil && *data.Page > 0 {
+		page = *data.Page
+	}
+	if data.Limit != nil && *data.Limit > 0 {
+		limit = *data.Limit
+	}
+
+	return page, limit
+}
+
+// fillPVZsInfo дополняет прочитанные ПВЗ количествами товаров приемок и отправками возвратов, если их просит проекция
+func (s *service) fillPVZsInfo(ctx context.Context, pvzsInfo []models.PvzInfo, projection models.PvzInfoProjection, startDate, endDate *time.Time) error {
+	if projection.ProductCounts {
+		var recsUUIDs []uuid.UUID
+		for _, info := range pvzsInfo {
+			for _, rwp := range info.Receptions {
+				if rwp.Reception.Id == nil {
+					return errors.New(internalErrors.ErrReceptionDoesntExist)
+				}
+				recsUUIDs = append(recsUUIDs, *rwp.Reception.Id)
+			}
+		}
+
+		if len(recsUUIDs) > 0 {
+			counts, err := s.repo.GetProductCountsByRecsUUIDs(ctx, recsUUIDs)
+			if err != nil {
+				return err
+			}
+			for i := range pvzsInfo {
+				for j := range pvzsInfo[i].Receptions {
+					// проверено на nil ранее
+					pvzsInfo[i].Receptions[j].ProductCounts = counts[*pvzsInfo[i].Receptions[j].Reception.Id]
+				}
+			}
+		}
+	}
+
+	if projection.ReturnShipments {
+		pvzsUUIDs := make([]uuid.UUID, 0, len(pvzsInfo))
+		for _, info := range pvzsInfo {
+			if info.Pvz.Id == nil {
+				return errors.New(internalErrors.ErrPVZDoesntExist)
+			}
+			pvzsUUIDs = append(pvzsUUIDs, *info.Pvz.Id)
+		}
+
+		shipmentsByPvz, err := s.getReturnShipmentsByPvz(ctx, pvzsUUIDs, startDate, endDate)
+		if err != nil {
+			return err
+		}
+		for i := range pvzsInfo {
+			// проверено на nil ранее
+			pvzsInfo[i].ReturnShipments = shipmentsByPvz[*pvzsInfo[i].Pvz.Id]
+		}
+	}
+
+	return nil
+}
+
+// getReturnShipmentsByPvz возвращает отправки возвратов ПВЗ за период, сгруппированные по pvz_id
+func (s *service) getReturnShipmentsByPvz(ctx context.Context, pvzsUUIDs []uuid.UUID, startDate, endDate *time.Time) (map[uuid.UUID][]api.ReturnShipment, error) {
+	shipments, err := s.repo.GetReturnShipmentsByPvzUUIDsFiltered(ctx, pvzsUUIDs, startDate, endDate)
 	if err != nil {
 		return nil, err
 	}
 
-	pvzsUUIDs := make([]uuid.UUID, 0, len(pvzs))
-	for _, pvz := range pvzs {
-		if pvz.Id == nil {
-			return nil, errors.New(internalErrors.ErrPVZDoesntExist)
+	shipmentsByPvz := make(map[uuid.UUID][]api.ReturnShipment)
+	for _, shipment := range shipments {
+		shipmentsByPvz[shipment.PvzId] = append(shipmentsByPvz[shipment.PvzId], shipment)
+	}
+
+	return shipmentsByPvz, nil
+}
+
+/*
+Reception
+*/
+func (s *service) CreateReception(ctx context.Context, data api.PostReceptionsJSONBody) (api.Reception, error) {
+	status := api.ReceptionStatusInProgress
+	if data.Status != nil {
+		status = api.ReceptionStatus(*data.Status)
+	}
+
+	actor, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.Reception{}, err
+	}
+
+	var reception api.Reception
+	err = s.repo.WithTx(ctx, func(ctx context.Context) error {
+		// блокировка ПВЗ сериализует параллельное открытие приемок
+		isPVZExist, err := s.repo.LockPVZ(ctx, data.PvzId)
+		if err != nil {
+			return err
+		}
+		if !isPVZExist {
+			return errors.New(internalErrors.ErrPVZDoesntExist)
+		}
+
+		pvzStatus, err := s.repo.GetReceptionStatusByPvzUUID(ctx, data.PvzId)
+		if err != nil {
+			return err
+		}
+		if isOpenReception(api.ReceptionStatus(pvzStatus)) {
+			return errors.New(internalErrors.ErrReceptionExist)
+		}
+
+		reception, err = s.repo.CreateReception(ctx, data.PvzId, string(status), *actor)
+		return err
+	})
+	if err != nil {
+		return api.Reception{}, err
+	}
+
+	return reception, nil
+}
+
+func (s *service) CloseReception(ctx context.Context, pvzUUID uuid.UUID) (api.Reception, error) {
+	var reception api.Reception
+	err := s.repo.WithTx(ctx, func(ctx context.Context) error {
+		rec, err := s.getReceptionByPvzUUID(ctx, pvzUUID)
+		if err != nil {
+			return err
+		}
+
+		reception, err = s.closeReception(ctx, rec, false, "")
+		return err
+	})
+	if err != nil {
+		return api.Reception{}, err
+	}
+
+	return reception, nil
+}
+
+func (s *service) CloseReceptionWithOverride(ctx context.Context, recUUID uuid.UUID, reason string) (api.Reception, error) {
+	if strings.TrimSpace(reason) == "" {
+		return api.Reception{}, errors.New(internalErrors.ErrReasonRequired)
+	}
+
+	var reception api.Reception
+	err := s.repo.WithTx(ctx, func(ctx context.Context) error {
+		rec, err := s.lockReceptionByUUID(ctx, recUUID)
+		if err != nil {
+			return err
 		}
-		pvzsUUIDs = append(pvzsUUIDs, *pvz.Id)
+
+		reception, err = s.closeReception(ctx, rec, true, reason)
+		return err
+	})
+	if err != nil {
+		return api.Reception{}, err
+	}
+
+	return reception, nil
+}
+
+func (s *service) StartReception(ctx context.Context, recUUID uuid.UUID) (api.Reception, error) {
+	return s.transitionReceptionByUUID(ctx, recUUID, api.ReceptionStatusInProgress, "")
+}
+
+func (s *service) VerifyReception(ctx context.Context, recUUID uuid.UUID, reason string) (api.Reception, error) {
+	return s.transitionReceptionByUUID(ctx, recUUID, api.ReceptionStatusVerified, reason)
+}
+
+func (s *service) ReopenReception(ctx context.Context, recUUID uuid.UUID, reason string) (api.Reception, error) {
+	if strings.TrimSpace(reason) == "" {
+		return api.Reception{}, errors.New(internalErrors.ErrReasonRequired)
 	}
 
-	receptions, err := s.repo.GetReceptionsByPvzUUIDsFiltered(ctx, pvzsUUIDs, data.StartDate, data.EndDate)
+	var reception api.Reception
+	err := s.repo.WithTx(ctx, func(ctx context.Context) error {
+		rec, err := s.lockReceptionByUUID(ctx, recUUID)
+		if err != nil {
+			return err
+		}
+
+		// повторно открыть можно только последнюю приемку ПВЗ
+		latest, err := s.repo.GetReceptionByPvzUUIDForUpdate(ctx, rec.PvzId)
+		if err != nil {
+			return err
+		}
+		if latest.Id == nil || *latest.Id != *rec.Id {
+			return errors.New(internalErrors.ErrNewerReceptionExist)
+		}
+
+		reception, err = s.transitionReception(ctx, rec, api.ReceptionStatusInProgress, reason)
+		return err
+	})
+	if err != nil {
+		return api.Reception{}, err
+	}
+
+	return reception, nil
+}
+
+func (s *service) CancelReception(ctx context.Context, recUUID uuid.UUID, reason string) (api.Reception, error) {
+	if strings.TrimSpace(reason) == "" {
+		return api.Reception{}, errors.New(internalErrors.ErrReasonRequired)
+	}
+
+	var reception api.Reception
+	err := s.repo.WithTx(ctx, func(ctx context.Context) error {
+		rec, err := s.lockReceptionByUUID(ctx, recUUID)
+		if err != nil {
+			return err
+		}
+
+		transition, err := newReceptionTransition(ctx, rec, api.ReceptionStatusCancelled, reason)
+		if err != nil {
+			return err
+		}
+
+		err = s.repo.CancelReception(ctx, transition)
+		if err != nil {
+			return err
+		}
+		rec.Status = api.ReceptionStatusCancelled
+		reception = rec
+
+		return nil
+	})
+	if err != nil {
+		return api.Reception{}, err
+	}
+
+	return reception, nil
+}
+
+func (s *service) GetReceptions(ctx context.Context, data api.GetReceptionsParams) ([]api.ReceptionSummary, error) {
+	filter := models.ReceptionFilter{
+		PvzID:     data.PvzId,
+		CreatedBy: data.CreatedBy,
+		StartDate: data.StartDate,
+		EndDate:   data.EndDate,
+		Page:      1,
+		Limit:     10,
+	}
+	if data.Status != nil {
+		status := string(*data.Status)
+		filter.Status = &status
+	}
+	if data.Page != nil && *data.Page > 0 {
+		filter.Page = *data.Page
+	}
+	if data.Limit != nil && *data.Limit > 0 {
+		filter.Limit = *data.Limit
+	}
+
+	receptions, err := s.repo.GetReceptionsFiltered(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -90,112 +539,367 @@ func (s *service) GetPVZsInfo(ctx context.Context, data api.GetPvzParams) ([]mod
 		recsUUIDs = append(recsUUIDs, *rec.Id)
 	}
 
-	products, err := s.repo.GetProductsByRecsUUIDs(ctx, recsUUIDs)
+	counts, err := s.repo.GetProductCountsByRecsUUIDs(ctx, recsUUIDs)
 	if err != nil {
 		return nil, err
 	}
 
-	// группируем продукты по reception_id
-	productsByRec := make(map[uuid.UUID][]api.Product)
-	for _, product := range products {
-		productsByRec[product.ReceptionId] = append(productsByRec[product.ReceptionId], product)
-	}
-
-	// группируем приёмки по pvz_id
-	recsByPvz := make(map[uuid.UUID][]models.ReceptionWithProducts)
+	result := make([]api.ReceptionSummary, 0, len(receptions))
 	for _, rec := range receptions {
-		recsByPvz[rec.PvzId] = append(recsByPvz[rec.PvzId], models.ReceptionWithProducts{
+		result = append(result, api.ReceptionSummary{
 			Reception: rec,
 			// проверено на nil ранее
-			Products: productsByRec[*rec.Id],
-		})
-	}
-
-	// собираем финальный список PvzInfo
-	var result []models.PvzInfo
-	for _, pvz := range pvzs {
-		if pvz.Id == nil {
-			continue
-		}
-		result = append(result, models.PvzInfo{
-			Pvz: pvz,
-			// проверено на nil ранее
-			Receptions: recsByPvz[*pvz.Id],
+			ProductCounts: productCounts(counts[*rec.Id]),
 		})
 	}
 
 	return result, nil
 }
 
-/*
-Reception
-*/
-func (s *service) CreateReception(ctx context.Context, data api.PostReceptionsJSONBody) (api.Reception, error) {
-	isPVZExist, err := s.repo.IsPVZExist(ctx, data.PvzId)
-	if err != nil {
-		return api.Reception{}, err
+func (s *service) GetReception(ctx context.Context, recUUID uuid.UUID, data api.GetReceptionsReceptionIdParams) (api.ReceptionDetail, error) {
+	page, limit := 1, 30
+	if data.Page != nil && *data.Page > 0 {
+		page = *data.Page
 	}
-	if !isPVZExist {
-		return api.Reception{}, errors.New(internalErrors.ErrPVZDoesntExist)
+	if data.Limit != nil && *data.Limit > 0 {
+		limit = *data.Limit
 	}
 
-	pvzStatus, err := s.repo.GetReceptionStatusByPvzUUID(ctx, data.PvzId)
+	rec, err := s.getReceptionByUUID(ctx, recUUID)
 	if err != nil {
-		return api.Reception{}, err
+		return api.ReceptionDetail{}, err
 	}
-	if pvzStatus == string(api.InProgress) {
-		return api.Reception{}, errors.New(internalErrors.ErrReceptionExist)
+
+	products, total, err := s.repo.GetProductsByReceptionUUIDWithPagination(ctx, recUUID, page, limit)
+	if err != nil {
+		return api.ReceptionDetail{}, err
 	}
 
-	reception, err := s.repo.CreateReception(ctx, data.PvzId, string(api.InProgress))
+	counts, err := s.repo.GetProductCountsByReceptionUUID(ctx, recUUID)
 	if err != nil {
-		return api.Reception{}, err
+		return api.ReceptionDetail{}, err
 	}
 
-	return reception, nil
+	return api.ReceptionDetail{
+		Reception:     rec,
+		ProductCounts: productCounts(counts),
+		Products:      products,
+		TotalProducts: total,
+		Page:          page,
+		Limit:         limit,
+	}, nil
 }
 
-func (s *service) CloseReception(ctx context.Context, pvzUUID uuid.UUID) (api.Reception, error) {
-	rec, err := s.getReceptionByPvzUUID(ctx, pvzUUID)
-	if err != nil {
-		return api.Reception{}, err
+func (s *service) GetReceptionHistory(ctx context.Context, recUUID uuid.UUID) ([]api.ReceptionStatusChange, error) {
+	if _, err := s.getReceptionByUUID(ctx, recUUID); err != nil {
+		return nil, err
 	}
 
-	err = s.repo.UpdateReceptionStatus(ctx, *rec.Id, string(api.Close))
+	return s.repo.GetReceptionStatusHistory(ctx, recUUID)
+}
+
+// GetDeletedProducts возвращает удаленные из приемки товары с автором и причиной удаления
+func (s *service) GetDeletedProducts(ctx context.Context, recUUID uuid.UUID) ([]api.Product, error) {
+	if _, err := s.getReceptionByUUID(ctx, recUUID); err != nil {
+		return nil, err
+	}
+
+	products, err := s.repo.GetDeletedProductsByReceptionUUID(ctx, recUUID)
 	if err != nil {
-		return api.Reception{}, err
+		return nil, err
+	}
+	if products == nil {
+		products = []api.Product{}
 	}
-	rec.Status = api.Close
 
-	return rec, nil
+	return products, nil
 }
 
 /*
 Product
 */
 func (s *service) CreateProduct(ctx context.Context, data api.PostProductsJSONBody) (api.Product, error) {
-	rec, err := s.getReceptionByPvzUUID(ctx, data.PvzId)
+	actor, err := models.GetAuthPrincipal(ctx)
 	if err != nil {
 		return api.Product{}, err
 	}
 
-	product, err := s.repo.CreateProduct(ctx, *rec.Id, string(data.Type))
-	if err != nil {
+	attributes := api.ProductAttributes{}
+	if data.Attributes != nil {
+		attributes = *data.Attributes
+	}
+	if err := s.validateProductAttributes(ctx, data.Type, attributes); err != nil {
 		return api.Product{}, err
 	}
 
+	if !isValidProductPhysical(data.Weight, data.Dimensions) {
+		return api.Product{}, errors.New(internalErrors.ErrWrongProductPhysical)
+	}
+
+	var barcodes []string
+	if data.Barcode != nil {
+		if !isValidBarcode(*data.Barcode) {
+			return api.Product{}, errors.New(internalErrors.ErrWrongBarcode)
+		}
+		barcodes = append(barcodes, *data.Barcode)
+	}
+
+	var product api.Product
+	err = s.repo.WithTx(ctx, func(ctx context.Context) error {
+		// строка приемки блокируется до конца транзакции, закрытие приемки дождётся вставки
+		rec, err := s.getReceptionByPvzUUID(ctx, data.PvzId)
+		if err != nil {
+			return err
+		}
+
+		if err := s.checkBarcodesInStock(ctx, barcodes); err != nil {
+			return err
+		}
+
+		warnings, err := s.checkPvzLimits(ctx, data.PvzId, []api.ProductBatchItem{{Weight: data.Weight, Dimensions: data.Dimensions}})
+		if err != nil {
+			return err
+		}
+
+		product, err = s.repo.CreateProduct(ctx, *rec.Id, data.Type, attributes, data.Barcode, data.Weight, data.Dimensions, actor.UserUUID)
+		if err != nil {
+			return err
+		}
+
+		products := []api.Product{product}
+		if err := s.assignStorageCells(ctx, data.PvzId, products); err != nil {
+			return err
+		}
+		attachLimitWarnings(products, warnings)
+		product = products[0]
+
+		return s.matchOrderItems(ctx, products)
+	})
+	if err != nil {
+		return api.Product{}, s.resolveDuplicateBarcode(ctx, err, barcodes)
+	}
+
 	return product, nil
 }
 
+// CreateProducts проверяет все позиции пакета заранее и добавляет их в текущую приемку одной вставкой
+func (s *service) CreateProducts(ctx context.Context, data api.PostProductsBatchJSONBody) ([]api.Product, error) {
+	actor, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data.Items) == 0 || len(data.Items) > maxProductsBatchSize {
+		return nil, errors.New(internalErrors.ErrWrongProductsBatch)
+	}
+
+	items := make([]api.ProductBatchItem, len(data.Items))
+	schemas := make(map[string]*openapi3.Schema)
+	var barcodes []string
+	for i, item := range data.Items {
+		schema, ok := schemas[item.Type]
+		if !ok {
+			schema, err = s.getAttributesSchema(ctx, item.Type)
+			if err != nil {
+				return nil, err
+			}
+			schemas[item.Type] = schema
+		}
+
+		attributes := api.ProductAttributes{}
+		if item.Attributes != nil {
+			attributes = *item.Attributes
+		}
+		if err := checkProductAttributes(schema, item.Type, attributes); err != nil {
+			return nil, err
+		}
+
+		if !isValidProductPhysical(item.Weight, item.Dimensions) {
+			return nil, errors.New(internalErrors.ErrWrongProductPhysical)
+		}
+
+		if item.Barcode != nil {
+			if !isValidBarcode(*item.Barcode) {
+				return nil, errors.New(internalErrors.ErrWrongBarcode)
+			}
+			if slices.Contains(barcodes, *item.Barcode) {
+				return nil, errors.New(internalErrors.ErrDuplicateBarcodeInBatch)
+			}
+			barcodes = append(barcodes, *item.Barcode)
+		}
+
+		items[i] = api.ProductBatchItem{Type: item.Type, Attributes: &attributes, Barcode: item.Barcode, Weight: item.Weight, Dimensions: item.Dimensions}
+	}
+
+	var products []api.Product
+	err = s.repo.WithTx(ctx, func(ctx context.Context) error {
+		rec, err := s.getReceptionByPvzUUID(ctx, data.PvzId)
+		if err != nil {
+			return err
+		}
+
+		if err := s.checkBarcodesInStock(ctx, barcodes); err != nil {
+			return err
+		}
+
+		warnings, err := s.checkPvzLimits(ctx, data.PvzId, items)
+		if err != nil {
+			return err
+		}
+
+		products, err = s.repo.CreateProducts(ctx, *rec.Id, items, actor.UserUUID)
+		if err != nil {
+			return err
+		}
+
+		if err := s.assignStorageCells(ctx, data.PvzId, products); err != nil {
+			return err
+		}
+		attachLimitWarnings(products, warnings)
+
+		return s.matchOrderItems(ctx, products)
+	})
+	if err != nil {
+		return nil, s.resolveDuplicateBarcode(ctx, err, barcodes)
+	}
+
+	return products, nil
+}
+
+// checkBarcodesInStock возвращает DuplicateBarcodeError, если товар с одним из штрихкодов уже на складе
+func (s *service) checkBarcodesInStock(ctx context.Context, barcodes []string) error {
+	if len(barcodes) == 0 {
+		return nil
+	}
+
+	products, err := s.repo.GetInStockProductsByBarcodes(ctx, barcodes)
+	if err != nil {
+		return err
+	}
+	if len(products) > 0 {
+		return &models.DuplicateBarcodeError{Product: products[0]}
+	}
+
+	return nil
+}
+
+// resolveDuplicateBarcode дополняет ошибку уникального индекса штрихкода ссылкой на товар на складе.
+// Индекс срабатывает при параллельном сканировании, когда предварительная проверка уже пройдена
+func (s *service) resolveDuplicateBarcode(ctx context.Context, err error, barcodes []string) error {
+	var duplicate *models.DuplicateBarcodeError
+	if errors.As(err, &duplicate) || err.Error() != internalErrors.ErrProductBarcodeExist {
+		return err
+	}
+
+	if checkErr := s.checkBarcodesInStock(ctx, barcodes); checkErr != nil {
+		return checkErr
+	}
+
+	return err
+}
+
 func (s *service) DeleteLastProduct(ctx context.Context, pvzUUID uuid.UUID) error {
-	rec, err := s.getReceptionByPvzUUID(ctx, pvzUUID)
+	actor, err := models.GetAuthPrincipal(ctx)
 	if err != nil {
 		return err
 	}
 
-	return s.repo.DeleteLastProductByReceptionUUID(ctx, *rec.Id)
+	return s.repo.WithTx(ctx, func(ctx context.Context) error {
+		rec, err := s.getReceptionByPvzUUID(ctx, pvzUUID)
+		if err != nil {
+			return err
+		}
+
+		return s.repo.DeleteLastProductByReceptionUUID(ctx, *rec.Id, actor.UserUUID)
+	})
+}
+
+// DeleteProduct мягко удаляет любой товар приемки в работе с указанием автора и причины
+func (s *service) DeleteProduct(ctx context.Context, productUUID uuid.UUID, reason string) error {
+	actor, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return err
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return errors.New(internalErrors.ErrReasonRequired)
+	}
+
+	return s.repo.WithTx(ctx, func(ctx context.Context) error {
+		product, err := s.getProductInOpenReception(ctx, productUUID)
+		if err != nil {
+			return err
+		}
+
+		return s.repo.DeleteProduct(ctx, *product.Id, actor.UserUUID, reason)
+	})
+}
+
+// UpdateProduct исправляет тип и атрибуты товара приемки в работе, атрибуты проверяются по схеме итогового типа
+func (s *service) UpdateProduct(ctx context.Context, productUUID uuid.UUID, data api.ProductPatch) (api.Product, error) {
+	if data.Type == nil && data.Attributes == nil {
+		return api.Product{}, errors.New(internalErrors.ErrEmptyProductPatch)
+	}
+
+	var updated api.Product
+	err := s.repo.WithTx(ctx, func(ctx context.Context) error {
+		product, err := s.getProductInOpenReception(ctx, productUUID)
+		if err != nil {
+			return err
+		}
+
+		prType := product.Type
+		if data.Type != nil {
+			prType = *data.Type
+		}
+		attributes := api.ProductAttributes{}
+		if data.Attributes != nil {
+			attributes = *data.Attributes
+		} else if product.Attributes != nil {
+			attributes = *product.Attributes
+		}
+
+		if err := s.validateProductAttributes(ctx, prType, attributes); err != nil {
+			return err
+		}
+
+		updated, err = s.repo.UpdateProduct(ctx, productUUID, prType, attributes)
+		return err
+	})
+	if err != nil {
+		return api.Product{}, err
+	}
+
+	return updated, nil
+}
+
+// getProductInOpenReception возвращает товар и блокирует его приемку, приемка должна быть в работе
+func (s *service) getProductInOpenReception(ctx context.Context, productUUID uuid.UUID) (api.Product, error) {
+	product, err := s.repo.GetProductByUUID(ctx, productUUID)
+	if err != nil {
+		return api.Product{}, err
+	}
+	if product.Id == nil {
+		return api.Product{}, errors.New(internalErrors.ErrProductDoesntExist)
+	}
+
+	rec, err := s.lockReceptionByUUID(ctx, product.ReceptionId)
+	if err != nil {
+		return api.Product{}, err
+	}
+	if rec.Status != api.ReceptionStatusInProgress {
+		return api.Product{}, errors.New(internalErrors.ErrWrongReceptionStatus)
+	}
+	// выданные из повторно открытой приемки товары исправлять нельзя
+	if product.Status != nil && *product.Status != api.ProductStatusReceived {
+		return api.Product{}, errors.New(internalErrors.ErrProductTransition)
+	}
+
+	return product, nil
 }
 
+// getReceptionByPvzUUID возвращает приемку ПВЗ в работе и блокирует её до конца транзакции
 func (s *service) getReceptionByPvzUUID(ctx context.Context, pvzUUID uuid.UUID) (api.Reception, error) {
 	isPVZExist, err := s.repo.IsPVZExist(ctx, pvzUUID)
 	if err != nil {
@@ -205,16 +909,50 @@ func (s *service) getReceptionByPvzUUID(ctx context.Context, pvzUUID uuid.UUID)
 		return api.Reception{}, errors.New(internalErrors.ErrPVZDoesntExist)
 	}
 
-	reception, err := s.repo.GetReceptionByPvzUUID(ctx, pvzUUID)
+	reception, err := s.repo.GetReceptionByPvzUUIDForUpdate(ctx, pvzUUID)
 	if err != nil {
 		return api.Reception{}, err
 	}
 	if reception.Id == nil {
 		return api.Reception{}, errors.New(internalErrors.ErrReceptionDoesntExist)
 	}
-	if reception.Status != api.InProgress {
+	if reception.Status != api.ReceptionStatusInProgress {
 		return api.Reception{}, errors.New(internalErrors.ErrWrongReceptionStatus)
 	}
 
 	return reception, nil
 }
+
+func (s *service) getReceptionByUUID(ctx context.Context, recUUID uuid.UUID) (api.Reception, error) {
+	reception, err := s.repo.GetReceptionByUUID(ctx, recUUID)
+	if err != nil {
+		return api.Reception{}, err
+	}
+	if reception.Id == nil {
+		return api.Reception{}, errors.New(internalErrors.ErrReceptionDoesntExist)
+	}
+
+	return reception, nil
+}
+
+// lockReceptionByUUID возвращает приемку и блокирует её до конца транзакции, вызывается только внутри WithTx
+func (s *service) lockReceptionByUUID(ctx context.Context, recUUID uuid.UUID) (api.Reception, error) {
+	reception, err := s.repo.GetReceptionByUUIDForUpdate(ctx, recUUID)
+	if err != nil {
+		return api.Reception{}, err
+	}
+	if reception.Id == nil {
+		return api.Reception{}, errors.New(internalErrors.ErrReceptionDoesntExist)
+	}
+
+	return reception, nil
+}
+
+// productCounts гарантирует, что приемка без товаров отдаётся с пустым объектом, а не null
+func productCounts(counts map[string]int) api.ProductCounts {
+	if counts == nil {
+		return api.ProductCounts{}
+	}
+
+	return api.ProductCounts(counts)
+}
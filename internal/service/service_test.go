@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"reflect"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -83,6 +85,37 @@ func Test_service_GetPVZsInfo(t *testing.T) {
 	newUuid := uuid.New()
 	newTime := time.Now()
 
+	pvzInfo := models.PvzInfo{
+		Pvz: api.PVZ{
+			Id:               &newUuid,
+			City:             "Test City",
+			RegistrationDate: &newTime,
+		},
+		Receptions: []models.ReceptionWithProducts{
+			{
+				Reception: api.Reception{
+					Id:     &newUuid,
+					PvzId:  newUuid,
+					Status: api.ReceptionStatusInProgress,
+				},
+				Products: []api.Product{
+					{
+						Id:          &newUuid,
+						ReceptionId: newUuid,
+						Type:        "ProductType",
+					},
+				},
+			},
+		},
+	}
+	shipment := api.ReturnShipment{
+		Id:    &newUuid,
+		PvzId: newUuid,
+	}
+	withShipments := pvzInfo
+	withShipments.ReturnShipments = []api.ReturnShipment{shipment}
+	includeNone, includeCounts := api.PvzIncludeNone, api.PvzIncludeCounts
+
 	type fields struct {
 		repo Repository
 	}
@@ -101,35 +134,12 @@ func Test_service_GetPVZsInfo(t *testing.T) {
 			name: "Get PVZ info",
 			fields: fields{
 				repo: &MockRepository{
-					// Мок для получения списка PVZ
-					GetPVZsWithPaginationFunc: func(ctx context.Context, page, limit int) ([]api.PVZ, error) {
-						return []api.PVZ{
-							{
-								Id:               &newUuid,
-								City:             "Test City",
-								RegistrationDate: &newTime,
-							},
-						}, nil
-					},
-					// Мок для получения приемок по UUID PVZ
-					GetReceptionsByPvzUUIDsFilteredFunc: func(ctx context.Context, pvzUUIDs []uuid.UUID, startDate, endDate *time.Time) ([]api.Reception, error) {
-						return []api.Reception{
-							{
-								Id:     &newUuid,
-								PvzId:  newUuid,
-								Status: api.InProgress,
-							},
-						}, nil
-					},
-					// Мок для получения продуктов по UUID приемок
-					GetProductsByRecsUUIDsFunc: func(ctx context.Context, recsUUIDs []uuid.UUID) ([]api.Product, error) {
-						return []api.Product{
-							{
-								Id:          &newUuid,
-								ReceptionId: newUuid,
-								Type:        "ProductType",
-							},
-						}, nil
+					// Мок агрегирующего запроса ПВЗ с приемками и товарами
+					GetPVZsInfoFunc: func(ctx context.Context, filter models.PvzInfoFilter) ([]models.PvzInfo, error) {
+						if filter.Page != page || filter.Limit != limit || !filter.WithProducts {
+							return nil, errors.New("unexpected filter")
+						}
+						return []models.PvzInfo{pvzInfo}, nil
 					},
 				},
 			},
@@ -140,33 +150,100 @@ func Test_service_GetPVZsInfo(t *testing.T) {
 					Limit: &limit,
 				},
 			},
-			want: []models.PvzInfo{
-				{
-					Pvz: api.PVZ{
-						Id:               &newUuid,
-						City:             "Test City",
-						RegistrationDate: &newTime,
-					},
-					Receptions: []models.ReceptionWithProducts{
-						{
-							Reception: api.Reception{
-								Id:     &newUuid,
-								PvzId:  newUuid,
-								Status: api.InProgress,
-							},
-							Products: []api.Product{
-								{
-									Id:          &newUuid,
-									ReceptionId: newUuid,
-									Type:        "ProductType",
-								},
-							},
-						},
+			want:    []models.PvzInfo{pvzInfo},
+			wantErr: false,
+		},
+		{
+			name: "Get PVZ info with return shipments",
+			fields: fields{
+				repo: &MockRepository{
+					GetPVZsInfoFunc: func(ctx context.Context, filter models.PvzInfoFilter) ([]models.PvzInfo, error) {
+						return []models.PvzInfo{pvzInfo}, nil
+					},
+					GetReturnShipmentsByPvzUUIDsFilteredFunc: func(ctx context.Context, pvzUUIDs []uuid.UUID, startDate, endDate *time.Time) ([]api.ReturnShipment, error) {
+						return []api.ReturnShipment{shipment}, nil
+					},
+				},
+			},
+			args: args{
+				ctx:  context.Background(),
+				data: api.GetPvzParams{},
+			},
+			want:    []models.PvzInfo{withShipments},
+			wantErr: false,
+		},
+		{
+			name: "Include none reads only PVZ page",
+			fields: fields{
+				repo: &MockRepository{
+					GetPVZsWithPaginationFunc: func(ctx context.Context, page, limit int) ([]api.PVZ, error) {
+						return []api.PVZ{pvzInfo.Pvz}, nil
 					},
 				},
 			},
+			args: args{
+				ctx: context.Background(),
+				data: api.GetPvzParams{
+					Include: &includeNone,
+					Fields:  &[]api.PvzField{api.PvzFieldPvz, api.PvzFieldReceptions},
+				},
+			},
+			want:    []models.PvzInfo{{Pvz: pvzInfo.Pvz}},
 			wantErr: false,
 		},
+		{
+			name: "Include counts reads receptions without products",
+			fields: fields{
+				repo: &MockRepository{
+					GetPVZsInfoFunc: func(ctx context.Context, filter models.PvzInfoFilter) ([]models.PvzInfo, error) {
+						if filter.WithProducts {
+							return nil, errors.New("products must not be read")
+						}
+						return []models.PvzInfo{{
+							Pvz:        pvzInfo.Pvz,
+							Receptions: []models.ReceptionWithProducts{{Reception: pvzInfo.Receptions[0].Reception}},
+						}}, nil
+					},
+					GetProductCountsByRecsUUIDsFunc: func(ctx context.Context, recsUUIDs []uuid.UUID) (map[uuid.UUID]map[string]int, error) {
+						return map[uuid.UUID]map[string]int{newUuid: {"обувь": 2}}, nil
+					},
+					GetReturnShipmentsByPvzUUIDsFilteredFunc: func(ctx context.Context, pvzUUIDs []uuid.UUID, startDate, endDate *time.Time) ([]api.ReturnShipment, error) {
+						return nil, errors.New("return shipments must not be read")
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				data: api.GetPvzParams{
+					Include: &includeCounts,
+					Fields:  &[]api.PvzField{api.PvzFieldPvz, api.PvzFieldReceptions},
+				},
+			},
+			want: []models.PvzInfo{{
+				Pvz: pvzInfo.Pvz,
+				Receptions: []models.ReceptionWithProducts{{
+					Reception:     pvzInfo.Receptions[0].Reception,
+					ProductCounts: map[string]int{"обувь": 2},
+				}},
+			}},
+			wantErr: false,
+		},
+		{
+			name: "Repository error",
+			fields: fields{
+				repo: &MockRepository{
+					GetPVZsInfoFunc: func(ctx context.Context, filter models.PvzInfoFilter) ([]models.PvzInfo, error) {
+						return nil, errors.New("could not get pvzs info")
+					},
+				},
+			},
+			args: args{
+				ctx:  context.Background(),
+				data: api.GetPvzParams{},
+			},
+			want:    nil,
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -186,6 +263,131 @@ func Test_service_GetPVZsInfo(t *testing.T) {
 	}
 }
 
+func Test_service_StreamPVZsInfo(t *testing.T) {
+	firstUuid, secondUuid := uuid.New(), uuid.New()
+	firstRecUuid, secondRecUuid := uuid.New(), uuid.New()
+
+	var (
+		streamed      bool
+		filter        models.PvzInfoFilter
+		shipmentsPvzs [][]uuid.UUID
+	)
+	repo := &MockRepository{
+		StreamPVZsInfoFunc: func(ctx context.Context, f models.PvzInfoFilter, fn func(info models.PvzInfo) error) error {
+			streamed, filter = true, f
+			for _, info := range []models.PvzInfo{
+				{
+					Pvz:        api.PVZ{Id: &firstUuid, City: "Москва"},
+					Receptions: []models.ReceptionWithProducts{{Reception: api.Reception{Id: &firstRecUuid, PvzId: firstUuid}}},
+				},
+				{
+					Pvz:        api.PVZ{Id: &secondUuid, City: "Казань"},
+					Receptions: []models.ReceptionWithProducts{{Reception: api.Reception{Id: &secondRecUuid, PvzId: secondUuid}}},
+				},
+			} {
+				if err := fn(info); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		GetReturnShipmentsByPvzUUIDsFilteredFunc: func(ctx context.Context, pvzUUIDs []uuid.UUID, startDate, endDate *time.Time) ([]api.ReturnShipment, error) {
+			shipmentsPvzs = append(shipmentsPvzs, pvzUUIDs)
+			return []api.ReturnShipment{{PvzId: secondUuid}}, nil
+		},
+	}
+	s := New(repo, nil, nil, nil, nil)
+
+	stream, err := s.StreamPVZsInfo(employeeCtx(), api.GetPvzParams{})
+	if err != nil {
+		t.Fatalf("StreamPVZsInfo() unexpected error = %v", err)
+	}
+	// до вызова stream ничего не читается
+	if streamed {
+		t.Fatalf("StreamPVZsInfo() read PVZs before streaming")
+	}
+
+	var got []models.PvzInfo
+	err = stream(func(info models.PvzInfo) error {
+		got = append(got, info)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("stream() unexpected error = %v", err)
+	}
+
+	// страница читается одним курсором с приемками и товарами
+	if !filter.WithReceptions || !filter.WithProducts || filter.Page != 1 || filter.Limit != 10 {
+		t.Errorf("stream() filter = %+v, want first page of 10 PVZs with receptions and products", filter)
+	}
+	// отправки возвратов дочитываются одним запросом на пачку, а не на каждый ПВЗ
+	wantShipmentsPvzs := [][]uuid.UUID{{firstUuid, secondUuid}}
+	if !reflect.DeepEqual(shipmentsPvzs, wantShipmentsPvzs) {
+		t.Errorf("return shipments requested for %v, want %v", shipmentsPvzs, wantShipmentsPvzs)
+	}
+	if len(got) != 2 || *got[0].Pvz.Id != firstUuid || *got[1].Pvz.Id != secondUuid {
+		t.Fatalf("stream() = %+v, want PVZs in page order", got)
+	}
+	if len(got[0].ReturnShipments) != 0 || len(got[1].ReturnShipments) != 1 {
+		t.Errorf("stream() return shipments = %+v, %+v", got[0].ReturnShipments, got[1].ReturnShipments)
+	}
+}
+
+func Test_service_StreamPVZsInfo_Projection(t *testing.T) {
+	pvzUuid, recUuid := uuid.New(), uuid.New()
+	include := api.PvzIncludeReceptions
+
+	// товары и отправки возвратов не запрошены, приемки без товаров несут количество товаров по типам
+	var filter models.PvzInfoFilter
+	repo := &MockRepository{
+		GetProductCountsByRecsUUIDsFunc: func(ctx context.Context, recsUUIDs []uuid.UUID) (map[uuid.UUID]map[string]int, error) {
+			return map[uuid.UUID]map[string]int{recUuid: {"электроника": 3}}, nil
+		},
+		StreamPVZsInfoFunc: func(ctx context.Context, f models.PvzInfoFilter, fn func(info models.PvzInfo) error) error {
+			filter = f
+			return fn(models.PvzInfo{
+				Pvz:        api.PVZ{Id: &pvzUuid, City: "Москва"},
+				Receptions: []models.ReceptionWithProducts{{Reception: api.Reception{Id: &recUuid, PvzId: pvzUuid}}},
+			})
+		},
+		GetReturnShipmentsByPvzUUIDsFilteredFunc: func(ctx context.Context, pvzUUIDs []uuid.UUID, startDate, endDate *time.Time) ([]api.ReturnShipment, error) {
+			return nil, errors.New("return shipments must not be read")
+		},
+	}
+	s := New(repo, nil, nil, nil, nil)
+
+	stream, err := s.StreamPVZsInfo(employeeCtx(), api.GetPvzParams{
+		Include: &include,
+		Fields:  &[]api.PvzField{api.PvzFieldPvz, api.PvzFieldReceptions},
+	})
+	if err != nil {
+		t.Fatalf("StreamPVZsInfo() unexpected error = %v", err)
+	}
+
+	var got []models.PvzInfo
+	err = stream(func(info models.PvzInfo) error {
+		got = append(got, info)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("stream() unexpected error = %v", err)
+	}
+
+	if !filter.WithReceptions || filter.WithProducts {
+		t.Errorf("stream() filter = %+v, want receptions without products", filter)
+	}
+	want := []models.PvzInfo{{
+		Pvz: api.PVZ{Id: &pvzUuid, City: "Москва"},
+		Receptions: []models.ReceptionWithProducts{{
+			Reception:     api.Reception{Id: &recUuid, PvzId: pvzUuid},
+			ProductCounts: map[string]int{"электроника": 3},
+		}},
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("stream() = %+v, want %+v", got, want)
+	}
+}
+
 func Test_service_CreateReception(t *testing.T) {
 	newUuid := uuid.New()
 
@@ -207,7 +409,7 @@ func Test_service_CreateReception(t *testing.T) {
 			name: "Create Reception",
 			fields: fields{
 				repo: &MockRepository{
-					IsPVZExistFunc: func(ctx context.Context, id uuid.UUID) (bool, error) {
+					LockPVZFunc: func(ctx context.Context, id uuid.UUID) (bool, error) {
 						// Имитация того, что PVZ существует
 						return true, nil
 					},
@@ -215,7 +417,7 @@ func Test_service_CreateReception(t *testing.T) {
 						// Мок статуса приема
 						return "opened", nil
 					},
-					CreateReceptionFunc: func(ctx context.Context, pvzUUID uuid.UUID, status string) (api.Reception, error) {
+					CreateReceptionFunc: func(ctx context.Context, pvzUUID uuid.UUID, status string, actor models.AuthPrincipal) (api.Reception, error) {
 						// Возвращаем ожидаемое значение
 						return api.Reception{
 							Id:     &newUuid,
@@ -226,7 +428,7 @@ func Test_service_CreateReception(t *testing.T) {
 				},
 			},
 			args: args{
-				ctx: context.Background(),
+				ctx: employeeCtx(),
 				data: api.PostReceptionsJSONBody{
 					PvzId: newUuid,
 				},
@@ -238,6 +440,28 @@ func Test_service_CreateReception(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Draft Reception Blocks New One",
+			fields: fields{
+				repo: &MockRepository{
+					LockPVZFunc: func(ctx context.Context, id uuid.UUID) (bool, error) {
+						return true, nil
+					},
+					GetReceptionStatusByPvzUUIDFunc: func(ctx context.Context, pvzUUID uuid.UUID) (string, error) {
+						// Черновик считается незакрытой приемкой
+						return string(api.ReceptionStatusDraft), nil
+					},
+				},
+			},
+			args: args{
+				ctx: employeeCtx(),
+				data: api.PostReceptionsJSONBody{
+					PvzId: newUuid,
+				},
+			},
+			want:    api.Reception{},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -282,12 +506,12 @@ func Test_service_CloseReception(t *testing.T) {
 						return api.Reception{
 							Id:     &newUuid,
 							PvzId:  newUuid,
-							Status: api.InProgress,
+							Status: api.ReceptionStatusInProgress,
 						}, nil
 					},
 					// Успешно обновляем статус на "closed"
-					UpdateReceptionStatusFunc: func(ctx context.Context, recUUID uuid.UUID, status string) error {
-						if status == string(api.Close) {
+					UpdateReceptionStatusFunc: func(ctx context.Context, transition models.ReceptionTransition) error {
+						if transition.To == string(api.ReceptionStatusClosed) {
 							return nil
 						}
 						return errors.New("status update failed")
@@ -299,13 +523,13 @@ func Test_service_CloseReception(t *testing.T) {
 				},
 			},
 			args: args{
-				ctx:     context.Background(),
+				ctx:     employeeCtx(),
 				pvzUUID: newUuid,
 			},
 			want: api.Reception{
 				Id:     &newUuid,
 				PvzId:  newUuid,
-				Status: api.Close,
+				Status: api.ReceptionStatusClosed,
 			},
 			wantErr: false,
 		},
@@ -357,11 +581,11 @@ func Test_service_CreateProduct(t *testing.T) {
 						return api.Reception{
 							Id:     &newUuid,
 							PvzId:  newUuid,
-							Status: api.InProgress, // Ожидаемый статус
+							Status: api.ReceptionStatusInProgress, // Ожидаемый статус
 						}, nil
 					},
 					// Мок для создания продукта
-					CreateProductFunc: func(ctx context.Context, receptionUUID uuid.UUID, prType string) (api.Product, error) {
+					CreateProductFunc: func(ctx context.Context, receptionUUID uuid.UUID, prType string, attributes api.ProductAttributes, barcode *string, weight *int, dimensions *api.ProductDimensions, createdBy uuid.UUID) (api.Product, error) {
 						return api.Product{
 							Id: &newUuid, // Продукт с новым UUID
 						}, nil
@@ -369,10 +593,10 @@ func Test_service_CreateProduct(t *testing.T) {
 				},
 			},
 			args: args{
-				ctx: context.Background(),
+				ctx: employeeCtx(),
 				data: api.PostProductsJSONBody{
 					PvzId: newUuid,
-					Type:  api.PostProductsJSONBodyType("product"),
+					Type:  "product",
 				},
 			},
 			want: api.Product{
@@ -385,7 +609,7 @@ func Test_service_CreateProduct(t *testing.T) {
 			fields: fields{
 				repo: &MockRepository{
 					// Мокируем ошибку при создании продукта
-					CreateProductFunc: func(ctx context.Context, receptionUUID uuid.UUID, prType string) (api.Product, error) {
+					CreateProductFunc: func(ctx context.Context, receptionUUID uuid.UUID, prType string, attributes api.ProductAttributes, barcode *string, weight *int, dimensions *api.ProductDimensions, createdBy uuid.UUID) (api.Product, error) {
 						return api.Product{}, errors.New("create product failed")
 					},
 					// Мок для проверки существования PVZ
@@ -397,16 +621,16 @@ func Test_service_CreateProduct(t *testing.T) {
 						return api.Reception{
 							Id:     &newUuid,
 							PvzId:  newUuid,
-							Status: api.InProgress, // Ожидаемый статус
+							Status: api.ReceptionStatusInProgress, // Ожидаемый статус
 						}, nil
 					},
 				},
 			},
 			args: args{
-				ctx: context.Background(),
+				ctx: employeeCtx(),
 				data: api.PostProductsJSONBody{
 					PvzId: newUuid,
-					Type:  api.PostProductsJSONBodyType("product"),
+					Type:  "product",
 				},
 			},
 			want:    api.Product{}, // Ожидаем пустой продукт
@@ -459,7 +683,7 @@ func Test_service_getReceptionByPvzUUID(t *testing.T) {
 						return api.Reception{
 							Id:     &newUuid,
 							PvzId:  newUuid,
-							Status: api.InProgress, // Ожидаемый статус
+							Status: api.ReceptionStatusInProgress, // Ожидаемый статус
 						}, nil
 					},
 				},
@@ -471,7 +695,7 @@ func Test_service_getReceptionByPvzUUID(t *testing.T) {
 			want: api.Reception{
 				Id:     &newUuid,
 				PvzId:  newUuid,
-				Status: api.InProgress,
+				Status: api.ReceptionStatusInProgress,
 			},
 			wantErr: false,
 		},
@@ -522,17 +746,17 @@ func Test_service_DeleteLastProduct(t *testing.T) {
 						return api.Reception{
 							Id:     &newUuid,
 							PvzId:  newUuid,
-							Status: api.InProgress, // Статус приемки в процессе
+							Status: api.ReceptionStatusInProgress, // Статус приемки в процессе
 						}, nil
 					},
 					// Мок для удаления последнего продукта
-					DeleteLastProductByReceptionUUIDFunc: func(ctx context.Context, receptionUUID uuid.UUID) error {
+					DeleteLastProductByReceptionUUIDFunc: func(ctx context.Context, receptionUUID uuid.UUID, deletedBy uuid.UUID) error {
 						return nil // Успешное удаление
 					},
 				},
 			},
 			args: args{
-				ctx:     context.Background(),
+				ctx:     employeeCtx(),
 				pvzUUID: newUuid,
 			},
 			wantErr: false,
@@ -548,7 +772,7 @@ func Test_service_DeleteLastProduct(t *testing.T) {
 				},
 			},
 			args: args{
-				ctx:     context.Background(),
+				ctx:     employeeCtx(),
 				pvzUUID: newUuid,
 			},
 			wantErr: true, // Ожидаем ошибку, так как PVZ не существует
@@ -568,7 +792,7 @@ func Test_service_DeleteLastProduct(t *testing.T) {
 				},
 			},
 			args: args{
-				ctx:     context.Background(),
+				ctx:     employeeCtx(),
 				pvzUUID: newUuid,
 			},
 			wantErr: true, // Ожидаем ошибку, так как приемка не существует
@@ -592,7 +816,7 @@ func Test_service_DeleteLastProduct(t *testing.T) {
 				},
 			},
 			args: args{
-				ctx:     context.Background(),
+				ctx:     employeeCtx(),
 				pvzUUID: newUuid,
 			},
 			wantErr: true, // Ожидаем ошибку, так как статус приемки "Completed"
@@ -610,3 +834,654 @@ func Test_service_DeleteLastProduct(t *testing.T) {
 		})
 	}
 }
+
+func employeeCtx() context.Context {
+	return models.SetAuthPrincipal(context.Background(), models.AuthPrincipal{
+		UserUUID: uuid.New(),
+		Email:    models.TestEmail,
+		Role:     string(api.Employee),
+	})
+}
+
+func moderatorCtx() context.Context {
+	return models.SetAuthPrincipal(context.Background(), models.AuthPrincipal{
+		UserUUID: uuid.New(),
+		Email:    models.TestEmail,
+		Role:     string(api.Moderator),
+	})
+}
+
+func Test_canTransition(t *testing.T) {
+	tests := []struct {
+		name string
+		from api.ReceptionStatus
+		to   api.ReceptionStatus
+		role string
+		want bool
+	}{
+		{name: "draft -> in_progress by employee", from: api.ReceptionStatusDraft, to: api.ReceptionStatusInProgress, role: string(api.Employee), want: true},
+		{name: "draft -> cancelled by moderator", from: api.ReceptionStatusDraft, to: api.ReceptionStatusCancelled, role: string(api.Moderator), want: true},
+		{name: "draft -> closed", from: api.ReceptionStatusDraft, to: api.ReceptionStatusClosed, role: string(api.Employee), want: false},
+		{name: "in_progress -> closed by employee", from: api.ReceptionStatusInProgress, to: api.ReceptionStatusClosed, role: string(api.Employee), want: true},
+		{name: "in_progress -> closed by moderator", from: api.ReceptionStatusInProgress, to: api.ReceptionStatusClosed, role: string(api.Moderator), want: true},
+		{name: "in_progress -> closed by system", from: api.ReceptionStatusInProgress, to: api.ReceptionStatusClosed, role: models.SystemRole, want: true},
+		{name: "in_progress -> cancelled by system", from: api.ReceptionStatusInProgress, to: api.ReceptionStatusCancelled, role: models.SystemRole, want: false},
+		{name: "in_progress -> verified", from: api.ReceptionStatusInProgress, to: api.ReceptionStatusVerified, role: string(api.Moderator), want: false},
+		{name: "closed -> verified by moderator", from: api.ReceptionStatusClosed, to: api.ReceptionStatusVerified, role: string(api.Moderator), want: true},
+		{name: "closed -> in_progress by moderator", from: api.ReceptionStatusClosed, to: api.ReceptionStatusInProgress, role: string(api.Moderator), want: true},
+		{name: "closed -> in_progress by employee", from: api.ReceptionStatusClosed, to: api.ReceptionStatusInProgress, role: string(api.Employee), want: false},
+		{name: "closed -> cancelled by employee", from: api.ReceptionStatusClosed, to: api.ReceptionStatusCancelled, role: string(api.Employee), want: false},
+		{name: "verified -> closed", from: api.ReceptionStatusVerified, to: api.ReceptionStatusClosed, role: string(api.Moderator), want: false},
+		{name: "cancelled -> in_progress", from: api.ReceptionStatusCancelled, to: api.ReceptionStatusInProgress, role: string(api.Moderator), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := canTransition(tt.from, tt.to, tt.role); got != tt.want {
+				t.Errorf("canTransition(%s, %s, %s) = %v, want %v", tt.from, tt.to, tt.role, got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_service_VerifyReception(t *testing.T) {
+	newUuid := uuid.New()
+
+	type fields struct {
+		repo Repository
+	}
+	type args struct {
+		ctx     context.Context
+		recUUID uuid.UUID
+		reason  string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    api.Reception
+		wantErr bool
+	}{
+		{
+			name: "Verify closed reception",
+			fields: fields{
+				repo: &MockRepository{
+					GetReceptionByUUIDFunc: func(ctx context.Context, recUUID uuid.UUID) (api.Reception, error) {
+						return api.Reception{
+							Id:     &newUuid,
+							PvzId:  newUuid,
+							Status: api.ReceptionStatusClosed,
+						}, nil
+					},
+					// Проверяем, что в историю уходит корректный переход
+					UpdateReceptionStatusFunc: func(ctx context.Context, transition models.ReceptionTransition) error {
+						if transition.From != string(api.ReceptionStatusClosed) ||
+							transition.To != string(api.ReceptionStatusVerified) ||
+							transition.ActorRole != string(api.Moderator) ||
+							transition.Reason != "checked" {
+							return errors.New("unexpected transition")
+						}
+						return nil
+					},
+				},
+			},
+			args: args{
+				ctx:     moderatorCtx(),
+				recUUID: newUuid,
+				reason:  "checked",
+			},
+			want: api.Reception{
+				Id:     &newUuid,
+				PvzId:  newUuid,
+				Status: api.ReceptionStatusVerified,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Verify in progress reception",
+			fields: fields{
+				repo: &MockRepository{
+					GetReceptionByUUIDFunc: func(ctx context.Context, recUUID uuid.UUID) (api.Reception, error) {
+						return api.Reception{
+							Id:     &newUuid,
+							PvzId:  newUuid,
+							Status: api.ReceptionStatusInProgress,
+						}, nil
+					},
+				},
+			},
+			args: args{
+				ctx:     moderatorCtx(),
+				recUUID: newUuid,
+			},
+			want:    api.Reception{},
+			wantErr: true, // переход in_progress -> verified запрещён
+		},
+		{
+			name: "Reception Does Not Exist",
+			fields: fields{
+				repo: &MockRepository{
+					GetReceptionByUUIDFunc: func(ctx context.Context, recUUID uuid.UUID) (api.Reception, error) {
+						return api.Reception{}, nil
+					},
+				},
+			},
+			args: args{
+				ctx:     moderatorCtx(),
+				recUUID: newUuid,
+			},
+			want:    api.Reception{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &service{
+				repo: tt.fields.repo,
+			}
+			got, err := s.VerifyReception(tt.args.ctx, tt.args.recUUID, tt.args.reason)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.VerifyReception() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("service.VerifyReception() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_service_ReopenReception(t *testing.T) {
+	recUuid := uuid.New()
+	newerUuid := uuid.New()
+	pvzUuid := uuid.New()
+
+	closedReception := func(ctx context.Context, recUUID uuid.UUID) (api.Reception, error) {
+		return api.Reception{
+			Id:     &recUuid,
+			PvzId:  pvzUuid,
+			Status: api.ReceptionStatusClosed,
+		}, nil
+	}
+
+	type fields struct {
+		repo Repository
+	}
+	type args struct {
+		ctx    context.Context
+		reason string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    api.Reception
+		wantErr bool
+	}{
+		{
+			name: "Reopen last closed reception",
+			fields: fields{
+				repo: &MockRepository{
+					GetReceptionByUUIDFunc: closedReception,
+					GetReceptionByPvzUUIDFunc: func(ctx context.Context, pvzUUID uuid.UUID) (api.Reception, error) {
+						return closedReception(ctx, recUuid)
+					},
+					UpdateReceptionStatusFunc: func(ctx context.Context, transition models.ReceptionTransition) error {
+						return nil
+					},
+				},
+			},
+			args: args{
+				ctx:    moderatorCtx(),
+				reason: "wrong count",
+			},
+			want: api.Reception{
+				Id:     &recUuid,
+				PvzId:  pvzUuid,
+				Status: api.ReceptionStatusInProgress,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Newer reception exists",
+			fields: fields{
+				repo: &MockRepository{
+					GetReceptionByUUIDFunc: closedReception,
+					GetReceptionByPvzUUIDFunc: func(ctx context.Context, pvzUUID uuid.UUID) (api.Reception, error) {
+						return api.Reception{
+							Id:     &newerUuid,
+							PvzId:  pvzUuid,
+							Status: api.ReceptionStatusInProgress,
+						}, nil
+					},
+				},
+			},
+			args: args{
+				ctx:    moderatorCtx(),
+				reason: "wrong count",
+			},
+			want:    api.Reception{},
+			wantErr: true,
+		},
+		{
+			name: "Empty reason",
+			fields: fields{
+				repo: &MockRepository{},
+			},
+			args: args{
+				ctx:    moderatorCtx(),
+				reason: "  ",
+			},
+			want:    api.Reception{},
+			wantErr: true,
+		},
+		{
+			name: "Employee cannot reopen",
+			fields: fields{
+				repo: &MockRepository{
+					GetReceptionByUUIDFunc: closedReception,
+					GetReceptionByPvzUUIDFunc: func(ctx context.Context, pvzUUID uuid.UUID) (api.Reception, error) {
+						return closedReception(ctx, recUuid)
+					},
+				},
+			},
+			args: args{
+				ctx:    employeeCtx(),
+				reason: "wrong count",
+			},
+			want:    api.Reception{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &service{
+				repo: tt.fields.repo,
+			}
+			got, err := s.ReopenReception(tt.args.ctx, recUuid, tt.args.reason)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.ReopenReception() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("service.ReopenReception() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_service_CancelReception(t *testing.T) {
+	newUuid := uuid.New()
+
+	type fields struct {
+		repo Repository
+	}
+	type args struct {
+		ctx    context.Context
+		reason string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    api.Reception
+		wantErr bool
+	}{
+		{
+			name: "Cancel closed reception",
+			fields: fields{
+				repo: &MockRepository{
+					GetReceptionByUUIDFunc: func(ctx context.Context, recUUID uuid.UUID) (api.Reception, error) {
+						return api.Reception{
+							Id:     &newUuid,
+							PvzId:  newUuid,
+							Status: api.ReceptionStatusClosed,
+						}, nil
+					},
+					// Отмена должна идти через CancelReception, чтобы аннулировать товары
+					CancelReceptionFunc: func(ctx context.Context, transition models.ReceptionTransition) error {
+						if transition.To != string(api.ReceptionStatusCancelled) || transition.Reason != "duplicate" {
+							return errors.New("unexpected transition")
+						}
+						return nil
+					},
+				},
+			},
+			args: args{
+				ctx:    moderatorCtx(),
+				reason: "duplicate",
+			},
+			want: api.Reception{
+				Id:     &newUuid,
+				PvzId:  newUuid,
+				Status: api.ReceptionStatusCancelled,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Cancel verified reception",
+			fields: fields{
+				repo: &MockRepository{
+					GetReceptionByUUIDFunc: func(ctx context.Context, recUUID uuid.UUID) (api.Reception, error) {
+						return api.Reception{
+							Id:     &newUuid,
+							PvzId:  newUuid,
+							Status: api.ReceptionStatusVerified,
+						}, nil
+					},
+				},
+			},
+			args: args{
+				ctx:    moderatorCtx(),
+				reason: "duplicate",
+			},
+			want:    api.Reception{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &service{
+				repo: tt.fields.repo,
+			}
+			got, err := s.CancelReception(tt.args.ctx, newUuid, tt.args.reason)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.CancelReception() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("service.CancelReception() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// txLockingRepository имитирует блокировку строк: транзакции выполняются строго по очереди,
+// поэтому проверка и запись внутри WithTx не могут перемешаться между горутинами
+func txLockingRepository(mu *sync.Mutex, repo *MockRepository) *MockRepository {
+	repo.WithTxFunc = func(ctx context.Context, fn func(ctx context.Context) error) error {
+		mu.Lock()
+		defer mu.Unlock()
+		return fn(ctx)
+	}
+	return repo
+}
+
+func Test_service_CreateReception_Concurrent(t *testing.T) {
+	const workers = 20
+	pvzUuid := uuid.New()
+
+	var (
+		mu     sync.Mutex
+		status string
+		opened int
+	)
+	repo := txLockingRepository(&mu, &MockRepository{
+		LockPVZFunc: func(ctx context.Context, id uuid.UUID) (bool, error) {
+			return true, nil
+		},
+		GetReceptionStatusByPvzUUIDFunc: func(ctx context.Context, pvzUUID uuid.UUID) (string, error) {
+			return status, nil
+		},
+		CreateReceptionFunc: func(ctx context.Context, pvzUUID uuid.UUID, st string, actor models.AuthPrincipal) (api.Reception, error) {
+			// даём другим горутинам шанс вклиниться между проверкой и записью
+			time.Sleep(time.Millisecond)
+			status = st
+			opened++
+			id := uuid.New()
+			return api.Reception{Id: &id, PvzId: pvzUUID, Status: api.ReceptionStatus(st)}, nil
+		},
+	})
+	s := &service{repo: repo}
+
+	var (
+		wg       sync.WaitGroup
+		errCount atomic.Int32
+	)
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := s.CreateReception(employeeCtx(), api.PostReceptionsJSONBody{PvzId: pvzUuid})
+			if err != nil {
+				if err.Error() != internalErrors.ErrReceptionExist {
+					t.Errorf("service.CreateReception() unexpected error = %v", err)
+				}
+				errCount.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if opened != 1 {
+		t.Errorf("service.CreateReception() opened %d receptions, want 1", opened)
+	}
+	if errCount.Load() != workers-1 {
+		t.Errorf("service.CreateReception() rejected %d requests, want %d", errCount.Load(), workers-1)
+	}
+}
+
+func Test_service_CreateProduct_ConcurrentWithClose(t *testing.T) {
+	const workers = 20
+	recUuid := uuid.New()
+	pvzUuid := uuid.New()
+
+	var (
+		mu       sync.Mutex
+		status   = api.ReceptionStatusInProgress
+		products int
+		closedAt = -1
+	)
+	repo := txLockingRepository(&mu, &MockRepository{
+		IsPVZExistFunc: func(ctx context.Context, id uuid.UUID) (bool, error) {
+			return true, nil
+		},
+		GetReceptionByPvzUUIDFunc: func(ctx context.Context, pvzUUID uuid.UUID) (api.Reception, error) {
+			return api.Reception{Id: &recUuid, PvzId: pvzUuid, Status: status}, nil
+		},
+		CreateProductFunc: func(ctx context.Context, receptionUUID uuid.UUID, prType string, attributes api.ProductAttributes, barcode *string, weight *int, dimensions *api.ProductDimensions, createdBy uuid.UUID) (api.Product, error) {
+			time.Sleep(time.Millisecond)
+			if status != api.ReceptionStatusInProgress {
+				return api.Product{}, errors.New("product added to closed reception")
+			}
+			products++
+			return api.Product{ReceptionId: receptionUUID}, nil
+		},
+		UpdateReceptionStatusFunc: func(ctx context.Context, transition models.ReceptionTransition) error {
+			status = api.ReceptionStatus(transition.To)
+			closedAt = products
+			return nil
+		},
+	})
+	s := &service{repo: repo}
+
+	var wg sync.WaitGroup
+	for i := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if i == workers/2 {
+				if _, err := s.CloseReception(employeeCtx(), pvzUuid); err != nil {
+					t.Errorf("service.CloseReception() error = %v", err)
+				}
+				return
+			}
+
+			_, err := s.CreateProduct(employeeCtx(), api.PostProductsJSONBody{PvzId: pvzUuid, Type: "обувь"})
+			if err != nil && err.Error() != internalErrors.ErrWrongReceptionStatus {
+				t.Errorf("service.CreateProduct() unexpected error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if closedAt != products {
+		t.Errorf("products added after close: closed with %d, have %d", closedAt, products)
+	}
+}
+
+func Test_service_GetReceptions(t *testing.T) {
+	recUuid := uuid.New()
+	emptyRecUuid := uuid.New()
+	pvzUuid := uuid.New()
+	status := api.ReceptionStatusClosed
+
+	var gotFilter models.ReceptionFilter
+	repo := &MockRepository{
+		GetReceptionsFilteredFunc: func(ctx context.Context, filter models.ReceptionFilter) ([]api.Reception, error) {
+			gotFilter = filter
+			return []api.Reception{
+				{Id: &recUuid, PvzId: pvzUuid, Status: api.ReceptionStatusClosed},
+				{Id: &emptyRecUuid, PvzId: pvzUuid, Status: api.ReceptionStatusClosed},
+			}, nil
+		},
+		GetProductCountsByRecsUUIDsFunc: func(ctx context.Context, recsUUIDs []uuid.UUID) (map[uuid.UUID]map[string]int, error) {
+			return map[uuid.UUID]map[string]int{recUuid: {"обувь": 2}}, nil
+		},
+	}
+	s := &service{repo: repo}
+
+	got, err := s.GetReceptions(employeeCtx(), api.GetReceptionsParams{PvzId: &pvzUuid, Status: &status})
+	if err != nil {
+		t.Fatalf("service.GetReceptions() error = %v", err)
+	}
+
+	wantFilter := models.ReceptionFilter{PvzID: &pvzUuid, Status: (*string)(&status), Page: 1, Limit: 10}
+	if !reflect.DeepEqual(gotFilter, wantFilter) {
+		t.Errorf("filter = %+v, want %+v", gotFilter, wantFilter)
+	}
+	want := []api.ReceptionSummary{
+		{Reception: api.Reception{Id: &recUuid, PvzId: pvzUuid, Status: api.ReceptionStatusClosed}, ProductCounts: api.ProductCounts{"обувь": 2}},
+		{Reception: api.Reception{Id: &emptyRecUuid, PvzId: pvzUuid, Status: api.ReceptionStatusClosed}, ProductCounts: api.ProductCounts{}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("service.GetReceptions() = %v, want %v", got, want)
+	}
+}
+
+func Test_service_GetReception(t *testing.T) {
+	recUuid := uuid.New()
+	pvzUuid := uuid.New()
+	page, limit := 2, 1
+
+	products := []api.Product{{ReceptionId: recUuid, Type: "обувь"}}
+	repo := &MockRepository{
+		GetReceptionByUUIDFunc: func(ctx context.Context, id uuid.UUID) (api.Reception, error) {
+			if id != recUuid {
+				return api.Reception{}, nil
+			}
+			return api.Reception{Id: &recUuid, PvzId: pvzUuid, Status: api.ReceptionStatusInProgress}, nil
+		},
+		GetProductsByReceptionUUIDWithPaginationFunc: func(ctx context.Context, id uuid.UUID, p, l int) ([]api.Product, int, error) {
+			if p != page || l != limit {
+				return nil, 0, errors.New("unexpected pagination")
+			}
+			return products, 3, nil
+		},
+		GetProductCountsByReceptionUUIDFunc: func(ctx context.Context, id uuid.UUID) (map[string]int, error) {
+			return map[string]int{"обувь": 2, "одежда": 1}, nil
+		},
+	}
+	s := &service{repo: repo}
+
+	t.Run("Get Reception", func(t *testing.T) {
+		got, err := s.GetReception(employeeCtx(), recUuid, api.GetReceptionsReceptionIdParams{Page: &page, Limit: &limit})
+		if err != nil {
+			t.Fatalf("service.GetReception() error = %v", err)
+		}
+		want := api.ReceptionDetail{
+			Reception:     api.Reception{Id: &recUuid, PvzId: pvzUuid, Status: api.ReceptionStatusInProgress},
+			ProductCounts: api.ProductCounts{"обувь": 2, "одежда": 1},
+			Products:      products,
+			TotalProducts: 3,
+			Page:          page,
+			Limit:         limit,
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("service.GetReception() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("Reception doesn't exist", func(t *testing.T) {
+		_, err := s.GetReception(employeeCtx(), uuid.New(), api.GetReceptionsReceptionIdParams{})
+		if err == nil || err.Error() != internalErrors.ErrReceptionDoesntExist {
+			t.Errorf("service.GetReception() error = %v, want %v", err, internalErrors.ErrReceptionDoesntExist)
+		}
+	})
+}
+
+// Test_service_ReceptionLocking проверяет, что приемка блокируется только при изменении, а чтение обходится без блокировки
+func Test_service_ReceptionLocking(t *testing.T) {
+	recUuid := uuid.New()
+	var reads, locks int
+	repo := &MockRepository{
+		GetReceptionByUUIDFunc: func(ctx context.Context, id uuid.UUID) (api.Reception, error) {
+			reads++
+			return api.Reception{Id: &recUuid, PvzId: recUuid, Status: api.ReceptionStatusClosed}, nil
+		},
+		GetReceptionByUUIDForUpdateFunc: func(ctx context.Context, id uuid.UUID) (api.Reception, error) {
+			locks++
+			return api.Reception{Id: &recUuid, PvzId: recUuid, Status: api.ReceptionStatusClosed}, nil
+		},
+		GetReceptionStatusHistoryFunc: func(ctx context.Context, id uuid.UUID) ([]api.ReceptionStatusChange, error) {
+			return nil, nil
+		},
+		UpdateReceptionStatusFunc: func(ctx context.Context, transition models.ReceptionTransition) error {
+			return nil
+		},
+	}
+	s := &service{repo: repo}
+
+	if _, err := s.GetReceptionHistory(moderatorCtx(), recUuid); err != nil {
+		t.Fatalf("service.GetReceptionHistory() error = %v", err)
+	}
+	if reads != 1 || locks != 0 {
+		t.Errorf("GetReceptionHistory() reads/locks = %d/%d, want 1/0", reads, locks)
+	}
+
+	if _, err := s.VerifyReception(moderatorCtx(), recUuid, ""); err != nil {
+		t.Fatalf("service.VerifyReception() error = %v", err)
+	}
+	if reads != 1 || locks != 1 {
+		t.Errorf("VerifyReception() reads/locks = %d/%d, want 1/1", reads, locks)
+	}
+}
+
+func Test_service_ProductActors(t *testing.T) {
+	recUuid := uuid.New()
+	pvzUuid := uuid.New()
+	ctx := employeeCtx()
+	actor, _ := models.GetAuthPrincipal(ctx)
+
+	var createdBy, deletedBy uuid.UUID
+	repo := &MockRepository{
+		IsPVZExistFunc: func(ctx context.Context, id uuid.UUID) (bool, error) {
+			return true, nil
+		},
+		GetReceptionByPvzUUIDFunc: func(ctx context.Context, pvzUUID uuid.UUID) (api.Reception, error) {
+			return api.Reception{Id: &recUuid, PvzId: pvzUuid, Status: api.ReceptionStatusInProgress}, nil
+		},
+		CreateProductFunc: func(ctx context.Context, receptionUUID uuid.UUID, prType string, attributes api.ProductAttributes, barcode *string, weight *int, dimensions *api.ProductDimensions, actorUUID uuid.UUID) (api.Product, error) {
+			createdBy = actorUUID
+			return api.Product{ReceptionId: receptionUUID, CreatedBy: &actorUUID}, nil
+		},
+		DeleteLastProductByReceptionUUIDFunc: func(ctx context.Context, receptionUUID uuid.UUID, actorUUID uuid.UUID) error {
+			deletedBy = actorUUID
+			return nil
+		},
+	}
+	s := &service{repo: repo}
+
+	if _, err := s.CreateProduct(ctx, api.PostProductsJSONBody{PvzId: pvzUuid, Type: "обувь"}); err != nil {
+		t.Fatalf("service.CreateProduct() error = %v", err)
+	}
+	if err := s.DeleteLastProduct(ctx, pvzUuid); err != nil {
+		t.Fatalf("service.DeleteLastProduct() error = %v", err)
+	}
+
+	if createdBy != actor.UserUUID {
+		t.Errorf("createdBy = %v, want %v", createdBy, actor.UserUUID)
+	}
+	if deletedBy != actor.UserUUID {
+		t.Errorf("deletedBy = %v, want %v", deletedBy, actor.UserUUID)
+	}
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/devWaylander/pvz_store/api"
+	internalErrors "github.com/devWaylander/pvz_store/pkg/errors"
+	"github.com/devWaylander/pvz_store/pkg/log"
+	"github.com/devWaylander/pvz_store/pkg/models"
+	"github.com/google/uuid"
+)
+
+// staleReceptionReason причина, фиксируемая в истории при автоматическом закрытии
+const staleReceptionReason = "автоматическое закрытие зависшей приемки"
+
+// HandleStaleReceptions закрывает приемки в работе, по которым не было активности с момента idleSince,
+// от имени системного пользователя. При flagOnly приемки только помечаются как зависшие.
+// Приемки, которые нельзя закрыть из-за расхождений со строгим манифестом, также только помечаются
+func (s *service) HandleStaleReceptions(ctx context.Context, idleSince time.Time, flagOnly bool) (models.StaleReceptionsResult, error) {
+	receptions, err := s.repo.GetStaleReceptions(ctx, idleSince)
+	if err != nil {
+		return models.StaleReceptionsResult{}, err
+	}
+
+	ctx = models.SetAuthPrincipal(ctx, models.SystemPrincipal)
+
+	var result models.StaleReceptionsResult
+	for _, rec := range receptions {
+		if flagOnly && rec.StaleAt != nil {
+			continue
+		}
+
+		closed, err := s.handleStaleReception(ctx, *rec.Id, flagOnly)
+		if err != nil {
+			result.Failed++
+			log.Logger.Err(err).Str("reception_id", rec.Id.String()).Msg("method HandleStaleReceptions")
+			continue
+		}
+		if closed {
+			result.Closed++
+		} else {
+			result.Flagged++
+		}
+	}
+
+	return result, nil
+}
+
+// handleStaleReception закрывает или помечает одну приемку в собственной транзакции
+func (s *service) handleStaleReception(ctx context.Context, recUUID uuid.UUID, flagOnly bool) (bool, error) {
+	var closed bool
+	err := s.repo.WithTx(ctx, func(ctx context.Context) error {
+		rec, err := s.lockReceptionByUUID(ctx, recUUID)
+		if err != nil {
+			return err
+		}
+		// приемку успели закрыть или отменить между выборкой и блокировкой
+		if rec.Status != api.ReceptionStatusInProgress {
+			return errors.New(internalErrors.ErrWrongReceptionStatus)
+		}
+
+		if !flagOnly {
+			_, err = s.closeReception(ctx, rec, false, staleReceptionReason)
+			if err == nil {
+				closed = true
+				return nil
+			}
+			if err.Error() != internalErrors.ErrReceptionDiscrepancy {
+				return err
+			}
+		}
+
+		return s.repo.MarkReceptionStale(ctx, recUUID)
+	})
+
+	return closed, err
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/devWaylander/pvz_store/api"
+	"github.com/devWaylander/pvz_store/pkg/models"
+	"github.com/google/uuid"
+)
+
+func Test_service_HandleStaleReceptions(t *testing.T) {
+	freshUuid := uuid.New()
+	strictUuid := uuid.New()
+	brokenUuid := uuid.New()
+	pvzUuid := uuid.New()
+
+	receptions := map[uuid.UUID]api.Reception{
+		freshUuid:  {Id: &freshUuid, PvzId: pvzUuid, Status: api.ReceptionStatusInProgress},
+		strictUuid: {Id: &strictUuid, PvzId: pvzUuid, Status: api.ReceptionStatusInProgress},
+		brokenUuid: {Id: &brokenUuid, PvzId: pvzUuid, Status: api.ReceptionStatusInProgress},
+	}
+
+	newRepo := func(closedBy *[]models.ReceptionTransition, flagged *[]uuid.UUID) *MockRepository {
+		return &MockRepository{
+			GetStaleReceptionsFunc: func(ctx context.Context, idleSince time.Time) ([]api.Reception, error) {
+				return []api.Reception{receptions[freshUuid], receptions[strictUuid], receptions[brokenUuid]}, nil
+			},
+			GetReceptionByUUIDFunc: func(ctx context.Context, recUUID uuid.UUID) (api.Reception, error) {
+				return receptions[recUUID], nil
+			},
+			GetManifestsToReconcileFunc: func(ctx context.Context, pvzUUID, recUUID uuid.UUID) ([]api.Manifest, error) {
+				if recUUID != strictUuid {
+					return nil, nil
+				}
+				manifestUuid := uuid.New()
+				return []api.Manifest{{Id: &manifestUuid, Strict: true, Items: []api.ManifestItem{{Type: "обувь", Count: 1}}}}, nil
+			},
+			GetProductCountsByReceptionUUIDFunc: func(ctx context.Context, recUUID uuid.UUID) (map[string]int, error) {
+				return map[string]int{}, nil
+			},
+			UpdateReceptionStatusFunc: func(ctx context.Context, transition models.ReceptionTransition) error {
+				if transition.ReceptionID == brokenUuid {
+					return errors.New("could not update reception status")
+				}
+				*closedBy = append(*closedBy, transition)
+				return nil
+			},
+			MarkReceptionStaleFunc: func(ctx context.Context, recUUID uuid.UUID) error {
+				*flagged = append(*flagged, recUUID)
+				return nil
+			},
+		}
+	}
+
+	t.Run("Close stale receptions", func(t *testing.T) {
+		var (
+			closedBy []models.ReceptionTransition
+			flagged  []uuid.UUID
+		)
+		s := &service{repo: newRepo(&closedBy, &flagged)}
+
+		got, err := s.HandleStaleReceptions(context.Background(), time.Now(), false)
+		if err != nil {
+			t.Fatalf("service.HandleStaleReceptions() error = %v", err)
+		}
+		want := models.StaleReceptionsResult{Closed: 1, Flagged: 1, Failed: 1}
+		if got != want {
+			t.Errorf("service.HandleStaleReceptions() = %+v, want %+v", got, want)
+		}
+		if len(closedBy) != 1 || closedBy[0].ActorRole != models.SystemRole || closedBy[0].ActorID != uuid.Nil {
+			t.Errorf("closed transitions = %+v, want one by system actor", closedBy)
+		}
+		// строгий манифест с расхождениями не даёт закрыть приемку, она только помечается
+		if len(flagged) != 1 || flagged[0] != strictUuid {
+			t.Errorf("flagged = %v, want [%v]", flagged, strictUuid)
+		}
+	})
+
+	t.Run("Flag only", func(t *testing.T) {
+		var (
+			closedBy []models.ReceptionTransition
+			flagged  []uuid.UUID
+		)
+		s := &service{repo: newRepo(&closedBy, &flagged)}
+
+		got, err := s.HandleStaleReceptions(context.Background(), time.Now(), true)
+		if err != nil {
+			t.Fatalf("service.HandleStaleReceptions() error = %v", err)
+		}
+		want := models.StaleReceptionsResult{Flagged: 3}
+		if got != want {
+			t.Errorf("service.HandleStaleReceptions() = %+v, want %+v", got, want)
+		}
+		if len(closedBy) != 0 {
+			t.Errorf("closed transitions = %+v, want none", closedBy)
+		}
+	})
+}
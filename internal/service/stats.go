@@ -0,0 +1,33 @@
+package service
+
+import (
+	"context"
+
+	"github.com/devWaylander/pvz_store/api"
+	"github.com/devWaylander/pvz_store/pkg/models"
+)
+
+/*
+Stats
+*/
+// GetReceptionStats возвращает статистику приемок, агрегированную в БД по периоду и измерениям группировки
+func (s *service) GetReceptionStats(ctx context.Context, params api.GetStatsReceptionsParams) ([]api.ReceptionStatsRow, error) {
+	if err := validateDateRange(params.StartDate, params.EndDate); err != nil {
+		return nil, err
+	}
+
+	filter := models.ReceptionStatsFilter{
+		StartDate: params.StartDate,
+		EndDate:   params.EndDate,
+	}
+	if params.Period != nil {
+		filter.Period = string(*params.Period)
+	}
+	if params.GroupBy != nil {
+		for _, dimension := range *params.GroupBy {
+			filter.GroupBy = append(filter.GroupBy, string(dimension))
+		}
+	}
+
+	return s.repo.GetReceptionStats(ctx, filter)
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/devWaylander/pvz_store/api"
+	internalErrors "github.com/devWaylander/pvz_store/pkg/errors"
+	"github.com/devWaylander/pvz_store/pkg/models"
+)
+
+func Test_service_GetReceptionStats(t *testing.T) {
+	start := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 4, 30, 0, 0, 0, 0, time.UTC)
+	groupBy := []api.StatsDimension{api.StatsDimensionPvz, api.StatsDimensionProductType}
+	period := api.Day
+
+	tests := []struct {
+		name       string
+		params     api.GetStatsReceptionsParams
+		wantFilter models.ReceptionStatsFilter
+		wantErr    string
+	}{
+		{
+			name:   "Dimensions and period are passed to the repository",
+			params: api.GetStatsReceptionsParams{GroupBy: &groupBy, Period: &period, StartDate: &start, EndDate: &end},
+			wantFilter: models.ReceptionStatsFilter{
+				GroupBy:   []string{"pvz", "productType"},
+				Period:    "day",
+				StartDate: &start,
+				EndDate:   &end,
+			},
+		},
+		{
+			name:       "Without grouping",
+			params:     api.GetStatsReceptionsParams{},
+			wantFilter: models.ReceptionStatsFilter{},
+		},
+		{
+			name:    "Start date after end date",
+			params:  api.GetStatsReceptionsParams{StartDate: &end, EndDate: &start},
+			wantErr: internalErrors.ErrWrongDateRange,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotFilter models.ReceptionStatsFilter
+			repo := &MockRepository{
+				GetReceptionStatsFunc: func(ctx context.Context, filter models.ReceptionStatsFilter) ([]api.ReceptionStatsRow, error) {
+					gotFilter = filter
+					return []api.ReceptionStatsRow{}, nil
+				},
+			}
+			s := New(repo, nil, nil, nil, nil)
+
+			_, err := s.GetReceptionStats(moderatorCtx(), tt.params)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("GetReceptionStats() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetReceptionStats() unexpected error = %v", err)
+			}
+			if !reflect.DeepEqual(gotFilter, tt.wantFilter) {
+				t.Errorf("GetReceptionStats() filter = %+v, want %+v", gotFilter, tt.wantFilter)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/devWaylander/pvz_store/api"
+	internalErrors "github.com/devWaylander/pvz_store/pkg/errors"
+	"github.com/google/uuid"
+)
+
+/*
+Storage cell
+*/
+func (s *service) CreateStorageCell(ctx context.Context, pvzUUID uuid.UUID, data api.PostPvzPvzIdCellsJSONBody) (api.StorageCell, error) {
+	isPVZExist, err := s.repo.IsPVZExist(ctx, pvzUUID)
+	if err != nil {
+		return api.StorageCell{}, err
+	}
+	if !isPVZExist {
+		return api.StorageCell{}, errors.New(internalErrors.ErrPVZDoesntExist)
+	}
+
+	rack, shelf, cell := strings.ToUpper(data.Rack), strings.ToUpper(data.Shelf), strings.ToUpper(data.Cell)
+
+	return s.repo.CreateStorageCell(ctx, pvzUUID, rack, shelf, cell, storageCellCode(rack, shelf, cell), data.Capacity)
+}
+
+func (s *service) GetStorageCells(ctx context.Context, pvzUUID uuid.UUID) ([]api.StorageCell, error) {
+	isPVZExist, err := s.repo.IsPVZExist(ctx, pvzUUID)
+	if err != nil {
+		return nil, err
+	}
+	if !isPVZExist {
+		return nil, errors.New(internalErrors.ErrPVZDoesntExist)
+	}
+
+	return s.repo.GetStorageCellsByPvzUUID(ctx, pvzUUID)
+}
+
+// MoveProduct перекладывает товар на руках ПВЗ в другую ячейку того же ПВЗ
+func (s *service) MoveProduct(ctx context.Context, productUUID, cellUUID uuid.UUID) (api.Product, error) {
+	var product api.Product
+	err := s.repo.WithTx(ctx, func(ctx context.Context) error {
+		location, err := s.getProductLocation(ctx, productUUID)
+		if err != nil {
+			return err
+		}
+		if !isOnHand(location.Status) {
+			return errors.New(internalErrors.ErrProductNotInStock)
+		}
+
+		// ячейка блокируется до конца транзакции, одновременные перемещения не переполнят её
+		cell, err := s.repo.GetStorageCellByUUID(ctx, cellUUID)
+		if err != nil {
+			return err
+		}
+		if cell.Id == nil || cell.PvzId != location.PvzId {
+			return errors.New(internalErrors.ErrStorageCellDoesntExist)
+		}
+
+		product, err = s.getProduct(ctx, productUUID)
+		if err != nil {
+			return err
+		}
+		if product.CellId != nil && *product.CellId == cellUUID {
+			return nil
+		}
+		if cellFreeSpace(cell) <= 0 {
+			return errors.New(internalErrors.ErrStorageCellFull)
+		}
+
+		if err := s.repo.SetProductCell(ctx, productUUID, cellUUID); err != nil {
+			return err
+		}
+		product.CellId = &cellUUID
+
+		return nil
+	})
+	if err != nil {
+		return api.Product{}, err
+	}
+
+	return product, nil
+}
+
+func (s *service) GetProductLocation(ctx context.Context, productUUID uuid.UUID) (api.ProductLocation, error) {
+	return s.getProductLocation(ctx, productUUID)
+}
+
+func (s *service) getProductLocation(ctx context.Context, productUUID uuid.UUID) (api.ProductLocation, error) {
+	location, err := s.repo.GetProductLocation(ctx, productUUID)
+	if err != nil {
+		return api.ProductLocation{}, err
+	}
+	if location.Status == "" {
+		return api.ProductLocation{}, errors.New(internalErrors.ErrProductDoesntExist)
+	}
+
+	return location, nil
+}
+
+// assignStorageCells размещает новые товары ПВЗ по ячейкам согласно стратегии размещения.
+// Товары, для которых не нашлось свободной ячейки, остаются без ячейки.
+// Ячейки ПВЗ блокируются до конца транзакции, одновременные размещения и перемещения не переполнят их
+func (s *service) assignStorageCells(ctx context.Context, pvzUUID uuid.UUID, products []api.Product) error {
+	cells, err := s.repo.GetStorageCellsByPvzUUIDForUpdate(ctx, pvzUUID)
+	if err != nil {
+		return err
+	}
+	if len(cells) == 0 {
+		return nil
+	}
+
+	for i := range products {
+		idx, ok := s.placement.SuggestCell(products[i], cells)
+		if !ok {
+			return nil
+		}
+
+		if err := s.repo.SetProductCell(ctx, *products[i].Id, *cells[idx].Id); err != nil {
+			return err
+		}
+		products[i].CellId = cells[idx].Id
+
+		occupied := cellOccupied(cells[idx]) + 1
+		cells[idx].Occupied = &occupied
+	}
+
+	return nil
+}
+
+// storageCellCode собирает код ячейки из стеллажа, полки и ячейки
+func storageCellCode(rack, shelf, cell string) string {
+	return rack + "-" + shelf + "-" + cell
+}
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/devWaylander/pvz_store/api"
+	internalErrors "github.com/devWaylander/pvz_store/pkg/errors"
+	"github.com/google/uuid"
+)
+
+func storageCell(capacity, occupied int) api.StorageCell {
+	id := uuid.New()
+	return api.StorageCell{Id: &id, Capacity: capacity, Occupied: &occupied}
+}
+
+func Test_PlacementStrategy_SuggestCell(t *testing.T) {
+	tests := []struct {
+		name     string
+		strategy string
+		cells    []api.StorageCell
+		want     int
+		wantOk   bool
+	}{
+		{
+			name:     "First fit skips full cells",
+			strategy: PlacementFirstFit,
+			cells:    []api.StorageCell{storageCell(2, 2), storageCell(5, 4), storageCell(5, 0)},
+			want:     1,
+			wantOk:   true,
+		},
+		{
+			name:     "Least loaded compares fill ratio",
+			strategy: PlacementLeastLoaded,
+			cells:    []api.StorageCell{storageCell(2, 1), storageCell(10, 3), storageCell(4, 2)},
+			want:     1,
+			wantOk:   true,
+		},
+		{
+			name:     "All cells are full",
+			strategy: PlacementLeastLoaded,
+			cells:    []api.StorageCell{storageCell(1, 1), storageCell(3, 3)},
+			wantOk:   false,
+		},
+		{
+			name:     "No cells",
+			strategy: PlacementFirstFit,
+			wantOk:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			strategy, err := NewPlacementStrategy(tt.strategy)
+			if err != nil {
+				t.Fatalf("NewPlacementStrategy() unexpected error = %v", err)
+			}
+
+			got, ok := strategy.SuggestCell(api.Product{}, tt.cells)
+			if ok != tt.wantOk || (ok && got != tt.want) {
+				t.Errorf("SuggestCell() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+
+	if _, err := NewPlacementStrategy("random"); err == nil {
+		t.Errorf("NewPlacementStrategy() expected error for unknown strategy")
+	}
+}
+
+func Test_service_assignStorageCells(t *testing.T) {
+	pvzUuid := uuid.New()
+	cells := []api.StorageCell{storageCell(1, 0), storageCell(2, 1)}
+
+	// ячейки читаются только с блокировкой, чтение без неё вернет пустой ПВЗ
+	assigned := map[uuid.UUID]uuid.UUID{}
+	repo := &MockRepository{
+		GetStorageCellsByPvzUUIDForUpdateFunc: func(ctx context.Context, id uuid.UUID) ([]api.StorageCell, error) {
+			return cells, nil
+		},
+		SetProductCellFunc: func(ctx context.Context, productUUID, cellUUID uuid.UUID) error {
+			assigned[productUUID] = cellUUID
+			return nil
+		},
+	}
+	s := New(repo, nil, nil, nil, nil)
+
+	products := make([]api.Product, 3)
+	for i := range products {
+		id := uuid.New()
+		products[i].Id = &id
+	}
+
+	if err := s.assignStorageCells(employeeCtx(), pvzUuid, products); err != nil {
+		t.Fatalf("assignStorageCells() unexpected error = %v", err)
+	}
+
+	// вместимости двух ячеек хватает только на два товара
+	if len(assigned) != 2 {
+		t.Fatalf("assignStorageCells() assigned %d products, want 2", len(assigned))
+	}
+	if *products[0].CellId != *cells[0].Id || *products[1].CellId != *cells[1].Id || products[2].CellId != nil {
+		t.Errorf("assignStorageCells() products = %+v", products)
+	}
+}
+
+func Test_service_MoveProduct(t *testing.T) {
+	pvzUuid := uuid.New()
+	productUuid := uuid.New()
+	cellUuid := uuid.New()
+
+	tests := []struct {
+		name     string
+		status   api.ProductStatus
+		cellPvz  uuid.UUID
+		occupied int
+		wantErr  string
+	}{
+		{
+			name:    "Move stored product",
+			status:  api.ProductStatusStored,
+			cellPvz: pvzUuid,
+		},
+		{
+			name:    "Product already issued",
+			status:  api.ProductStatusIssued,
+			cellPvz: pvzUuid,
+			wantErr: internalErrors.ErrProductNotInStock,
+		},
+		{
+			name:    "Cell of another PVZ",
+			status:  api.ProductStatusStored,
+			cellPvz: uuid.New(),
+			wantErr: internalErrors.ErrStorageCellDoesntExist,
+		},
+		{
+			name:     "Cell is full",
+			status:   api.ProductStatusReceived,
+			cellPvz:  pvzUuid,
+			occupied: 1,
+			wantErr:  internalErrors.ErrStorageCellFull,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var moved bool
+			repo := &MockRepository{
+				GetProductLocationFunc: func(ctx context.Context, id uuid.UUID) (api.ProductLocation, error) {
+					return api.ProductLocation{ProductId: id, PvzId: pvzUuid, Status: tt.status}, nil
+				},
+				GetStorageCellByUUIDFunc: func(ctx context.Context, id uuid.UUID) (api.StorageCell, error) {
+					occupied := tt.occupied
+					return api.StorageCell{Id: &cellUuid, PvzId: tt.cellPvz, Capacity: 1, Occupied: &occupied}, nil
+				},
+				GetProductByUUIDFunc: func(ctx context.Context, id uuid.UUID) (api.Product, error) {
+					status := tt.status
+					return api.Product{Id: &productUuid, Status: &status}, nil
+				},
+				SetProductCellFunc: func(ctx context.Context, productUUID, cellUUID uuid.UUID) error {
+					moved = true
+					return nil
+				},
+			}
+			s := New(repo, nil, nil, nil, nil)
+
+			got, err := s.MoveProduct(employeeCtx(), productUuid, cellUuid)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("MoveProduct() unexpected error = %v", err)
+				}
+				if !moved || got.CellId == nil || *got.CellId != cellUuid {
+					t.Errorf("MoveProduct() = %+v, moved = %v", got, moved)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("MoveProduct() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/devWaylander/pvz_store/api"
+	internalErrors "github.com/devWaylander/pvz_store/pkg/errors"
+	"github.com/devWaylander/pvz_store/pkg/models"
+	"github.com/google/uuid"
+)
+
+const maxTransferProducts = 100
+
+/*
+Transfer
+*/
+// CreateTransfer создает перемещение товаров на хранении из одного ПВЗ в другой
+func (s *service) CreateTransfer(ctx context.Context, data api.PostTransfersJSONBody) (api.Transfer, error) {
+	actor, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.Transfer{}, err
+	}
+
+	if data.SourcePvzId == data.DestinationPvzId {
+		return api.Transfer{}, errors.New(internalErrors.ErrWrongTransferPvz)
+	}
+	if !isValidTransferProducts(data.ProductIds) {
+		return api.Transfer{}, errors.New(internalErrors.ErrWrongTransferProducts)
+	}
+
+	for _, pvzUUID := range []uuid.UUID{data.SourcePvzId, data.DestinationPvzId} {
+		isPVZExist, err := s.repo.IsPVZExist(ctx, pvzUUID)
+		if err != nil {
+			return api.Transfer{}, err
+		}
+		if !isPVZExist {
+			return api.Transfer{}, errors.New(internalErrors.ErrPVZDoesntExist)
+		}
+	}
+
+	return s.repo.CreateTransfer(ctx, data.SourcePvzId, data.DestinationPvzId, data.ProductIds, actor.UserUUID)
+}
+
+func (s *service) GetTransfer(ctx context.Context, transferUUID uuid.UUID) (api.Transfer, error) {
+	transfer, err := s.repo.GetTransferByUUID(ctx, transferUUID)
+	if err != nil {
+		return api.Transfer{}, err
+	}
+	if transfer.Id == nil {
+		return api.Transfer{}, errors.New(internalErrors.ErrTransferDoesntExist)
+	}
+
+	return transfer, nil
+}
+
+// lockTransfer возвращает перемещение и блокирует его до конца транзакции, вызывается только внутри WithTx
+func (s *service) lockTransfer(ctx context.Context, transferUUID uuid.UUID) (api.Transfer, error) {
+	transfer, err := s.repo.GetTransferByUUIDForUpdate(ctx, transferUUID)
+	if err != nil {
+		return api.Transfer{}, err
+	}
+	if transfer.Id == nil {
+		return api.Transfer{}, errors.New(internalErrors.ErrTransferDoesntExist)
+	}
+
+	return transfer, nil
+}
+
+// GetTransfersInTransit возвращает отправленные, но еще не полученные перемещения
+func (s *service) GetTransfersInTransit(ctx context.Context, params api.GetTransfersInTransitParams) ([]api.Transfer, error) {
+	if params.PvzId != nil {
+		isPVZExist, err := s.repo.IsPVZExist(ctx, *params.PvzId)
+		if err != nil {
+			return nil, err
+		}
+		if !isPVZExist {
+			return nil, errors.New(internalErrors.ErrPVZDoesntExist)
+		}
+	}
+
+	return s.repo.GetTransfersInTransit(ctx, params.PvzId)
+}
+
+// DispatchTransfer отправляет созданное перемещение, товары уходят с хранения ПВЗ отправления
+func (s *service) DispatchTransfer(ctx context.Context, transferUUID uuid.UUID) (api.Transfer, error) {
+	actor, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.Transfer{}, err
+	}
+
+	var transfer api.Transfer
+	err = s.repo.WithTx(ctx, func(ctx context.Context) error {
+		created, err := s.lockTransfer(ctx, transferUUID)
+		if err != nil {
+			return err
+		}
+		if created.Status != api.Created {
+			return errors.New(internalErrors.ErrWrongTransferStatus)
+		}
+
+		transfer, err = s.repo.DispatchTransfer(ctx, transferUUID, *actor)
+		return err
+	})
+	if err != nil {
+		return api.Transfer{}, err
+	}
+
+	return transfer, nil
+}
+
+// ReceiveTransfer принимает отправленное перемещение в ПВЗ назначения через приемку перемещения:
+// товары переходят в ПВЗ назначения в статусе received и уходят на хранение при её закрытии
+func (s *service) ReceiveTransfer(ctx context.Context, transferUUID uuid.UUID) (api.Transfer, error) {
+	actor, err := models.GetAuthPrincipal(ctx)
+	if err != nil {
+		return api.Transfer{}, err
+	}
+
+	var transfer api.Transfer
+	err = s.repo.WithTx(ctx, func(ctx context.Context) error {
+		dispatched, err := s.lockTransfer(ctx, transferUUID)
+		if err != nil {
+			return err
+		}
+		if dispatched.Status != api.Dispatched {
+			return errors.New(internalErrors.ErrWrongTransferStatus)
+		}
+
+		rec, err := s.repo.CreateTransferReception(ctx, dispatched.DestinationPvzId, *actor)
+		if err != nil {
+			return err
+		}
+
+		transfer, err = s.repo.ReceiveTransfer(ctx, transferUUID, *rec.Id, *actor)
+		if err != nil {
+			return err
+		}
+
+		// приемка перемещения закрывается сразу, минуя сверку с манифестами поставок
+		err = s.repo.UpdateReceptionStatus(ctx, models.ReceptionTransition{
+			ReceptionID: *rec.Id,
+			From:        string(api.ReceptionStatusInProgress),
+			To:          string(api.ReceptionStatusClosed),
+			ActorID:     actor.UserUUID,
+			ActorRole:   actor.Role,
+			Reason:      "перемещение " + transferUUID.String(),
+		})
+		if err != nil {
+			return err
+		}
+
+		products, err := s.repo.GetProductsByUUIDs(ctx, dispatched.ProductIds)
+		if err != nil {
+			return err
+		}
+		if err := s.assignStorageCells(ctx, dispatched.DestinationPvzId, products); err != nil {
+			return err
+		}
+		if err := s.matchOrderItems(ctx, products); err != nil {
+			return err
+		}
+
+		return s.prepareReadyOrders(ctx, dispatched.DestinationPvzId)
+	})
+	if err != nil {
+		return api.Transfer{}, err
+	}
+
+	return transfer, nil
+}
+
+// isValidTransferProducts проверяет, что список товаров перемещения не пуст, не превышает лимит и не содержит повторов
+func isValidTransferProducts(productUUIDs []uuid.UUID) bool {
+	if len(productUUIDs) == 0 || len(productUUIDs) > maxTransferProducts {
+		return false
+	}
+
+	seen := make(map[uuid.UUID]struct{}, len(productUUIDs))
+	for _, productUUID := range productUUIDs {
+		if _, ok := seen[productUUID]; ok {
+			return false
+		}
+		seen[productUUID] = struct{}{}
+	}
+
+	return true
+}
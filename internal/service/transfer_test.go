@@ -0,0 +1,206 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/devWaylander/pvz_store/api"
+	internalErrors "github.com/devWaylander/pvz_store/pkg/errors"
+	"github.com/devWaylander/pvz_store/pkg/models"
+	"github.com/google/uuid"
+)
+
+func Test_service_CreateTransfer(t *testing.T) {
+	srcUuid := uuid.New()
+	dstUuid := uuid.New()
+	productUuid := uuid.New()
+
+	tests := []struct {
+		name    string
+		data    api.PostTransfersJSONBody
+		wantErr string
+	}{
+		{
+			name: "Create transfer",
+			data: api.PostTransfersJSONBody{SourcePvzId: srcUuid, DestinationPvzId: dstUuid, ProductIds: []uuid.UUID{productUuid}},
+		},
+		{
+			name:    "Same source and destination",
+			data:    api.PostTransfersJSONBody{SourcePvzId: srcUuid, DestinationPvzId: srcUuid, ProductIds: []uuid.UUID{productUuid}},
+			wantErr: internalErrors.ErrWrongTransferPvz,
+		},
+		{
+			name:    "Duplicate products",
+			data:    api.PostTransfersJSONBody{SourcePvzId: srcUuid, DestinationPvzId: dstUuid, ProductIds: []uuid.UUID{productUuid, productUuid}},
+			wantErr: internalErrors.ErrWrongTransferProducts,
+		},
+		{
+			name:    "Destination PVZ doesnt exist",
+			data:    api.PostTransfersJSONBody{SourcePvzId: srcUuid, DestinationPvzId: uuid.New(), ProductIds: []uuid.UUID{productUuid}},
+			wantErr: internalErrors.ErrPVZDoesntExist,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &MockRepository{
+				IsPVZExistFunc: func(ctx context.Context, id uuid.UUID) (bool, error) {
+					return id == srcUuid || id == dstUuid, nil
+				},
+				CreateTransferFunc: func(ctx context.Context, src, dst uuid.UUID, productUUIDs []uuid.UUID, createdBy uuid.UUID) (api.Transfer, error) {
+					id := uuid.New()
+					return api.Transfer{Id: &id, SourcePvzId: src, DestinationPvzId: dst, ProductIds: productUUIDs, Status: api.Created}, nil
+				},
+			}
+			s := New(repo, nil, nil, nil, nil)
+
+			got, err := s.CreateTransfer(employeeCtx(), tt.data)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("CreateTransfer() unexpected error = %v", err)
+				}
+				if got.Status != api.Created {
+					t.Errorf("CreateTransfer() status = %v, want %v", got.Status, api.Created)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("CreateTransfer() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_service_ReceiveTransfer(t *testing.T) {
+	transferUuid := uuid.New()
+	dstUuid := uuid.New()
+	recUuid := uuid.New()
+	productUuids := []uuid.UUID{uuid.New(), uuid.New()}
+
+	tests := []struct {
+		name    string
+		status  api.TransferStatus
+		exists  bool
+		wantErr string
+	}{
+		{
+			name:   "Receive dispatched transfer",
+			status: api.Dispatched,
+			exists: true,
+		},
+		{
+			name:    "Transfer is not dispatched yet",
+			status:  api.Created,
+			exists:  true,
+			wantErr: internalErrors.ErrWrongTransferStatus,
+		},
+		{
+			name:    "Transfer already received",
+			status:  api.Received,
+			exists:  true,
+			wantErr: internalErrors.ErrWrongTransferStatus,
+		},
+		{
+			name:    "Transfer doesnt exist",
+			wantErr: internalErrors.ErrTransferDoesntExist,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var closed models.ReceptionTransition
+			var readyPvz uuid.UUID
+			var placed []uuid.UUID
+			repo := &MockRepository{
+				GetTransferByUUIDFunc: func(ctx context.Context, id uuid.UUID) (api.Transfer, error) {
+					if !tt.exists {
+						return api.Transfer{}, nil
+					}
+					return api.Transfer{Id: &transferUuid, DestinationPvzId: dstUuid, Status: tt.status, ProductIds: productUuids}, nil
+				},
+				CreateTransferReceptionFunc: func(ctx context.Context, pvzUUID uuid.UUID, actor models.AuthPrincipal) (api.Reception, error) {
+					recType := api.ReceptionTypeTransfer
+					return api.Reception{Id: &recUuid, PvzId: pvzUUID, Status: api.ReceptionStatusInProgress, Type: &recType}, nil
+				},
+				ReceiveTransferFunc: func(ctx context.Context, id, rec uuid.UUID, actor models.AuthPrincipal) (api.Transfer, error) {
+					return api.Transfer{Id: &transferUuid, DestinationPvzId: dstUuid, Status: api.Received, ReceptionId: &rec}, nil
+				},
+				UpdateReceptionStatusFunc: func(ctx context.Context, transition models.ReceptionTransition) error {
+					closed = transition
+					return nil
+				},
+				GetProductsByUUIDsFunc: func(ctx context.Context, ids []uuid.UUID) ([]api.Product, error) {
+					placed = ids
+					return nil, nil
+				},
+				GetOrdersReadyForPickupFunc: func(ctx context.Context, pvzUUID uuid.UUID) ([]api.Order, error) {
+					readyPvz = pvzUUID
+					return nil, nil
+				},
+			}
+			s := New(repo, nil, nil, nil, nil)
+
+			got, err := s.ReceiveTransfer(employeeCtx(), transferUuid)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("ReceiveTransfer() unexpected error = %v", err)
+				}
+				if got.Status != api.Received || got.ReceptionId == nil || *got.ReceptionId != recUuid {
+					t.Errorf("ReceiveTransfer() = %+v", got)
+				}
+				if closed.ReceptionID != recUuid || closed.To != string(api.ReceptionStatusClosed) {
+					t.Errorf("ReceiveTransfer() reception transition = %+v", closed)
+				}
+				if readyPvz != dstUuid {
+					t.Errorf("ReceiveTransfer() ready orders pvz = %v, want %v", readyPvz, dstUuid)
+				}
+				// товары размещаются по id перемещения, их приемка поставки не меняется
+				if !reflect.DeepEqual(placed, productUuids) {
+					t.Errorf("ReceiveTransfer() placed products = %v, want %v", placed, productUuids)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("ReceiveTransfer() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_newReceptionTransition_TransferReception(t *testing.T) {
+	recUuid := uuid.New()
+	recType := api.ReceptionTypeTransfer
+	rec := api.Reception{Id: &recUuid, Status: api.ReceptionStatusClosed, Type: &recType}
+
+	_, err := newReceptionTransition(moderatorCtx(), rec, api.ReceptionStatusInProgress, "")
+	if err == nil || err.Error() != internalErrors.ErrReceptionTransition {
+		t.Errorf("newReceptionTransition() error = %v, want %v", err, internalErrors.ErrReceptionTransition)
+	}
+}
+
+func Test_service_GetTransfer(t *testing.T) {
+	transferUuid := uuid.New()
+	repo := &MockRepository{
+		GetTransferByUUIDFunc: func(ctx context.Context, id uuid.UUID) (api.Transfer, error) {
+			if id != transferUuid {
+				return api.Transfer{}, nil
+			}
+			return api.Transfer{Id: &transferUuid, Status: api.Dispatched}, nil
+		},
+		// чтение перемещения не должно блокировать его
+		GetTransferByUUIDForUpdateFunc: func(ctx context.Context, id uuid.UUID) (api.Transfer, error) {
+			return api.Transfer{}, errors.New("unexpected lock")
+		},
+	}
+	s := New(repo, nil, nil, nil, nil)
+
+	got, err := s.GetTransfer(employeeCtx(), transferUuid)
+	if err != nil || got.Id == nil || *got.Id != transferUuid {
+		t.Errorf("GetTransfer() = %v, %v, want transfer %v", got, err, transferUuid)
+	}
+
+	_, err = s.GetTransfer(employeeCtx(), uuid.New())
+	if err == nil || err.Error() != internalErrors.ErrTransferDoesntExist {
+		t.Errorf("GetTransfer() error = %v, want %v", err, internalErrors.ErrTransferDoesntExist)
+	}
+}
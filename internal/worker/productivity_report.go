@@ -0,0 +1,109 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/devWaylander/pvz_store/api"
+	"github.com/devWaylander/pvz_store/pkg/log"
+	"github.com/devWaylander/pvz_store/pkg/metrics"
+)
+
+// productivityReportLockKey ключ advisory-блокировки, под которой строится ежедневный отчет о скорости приемок
+const productivityReportLockKey int64 = 30_002
+
+type ReportService interface {
+	BuildDailyProductivityReport(ctx context.Context, day time.Time, sla, idleGap time.Duration) (api.ProductivityReport, error)
+}
+
+type ProductivityReportWorker struct {
+	service ReportService
+	locker  Locker
+	at      time.Duration
+	sla     time.Duration
+	idleGap time.Duration
+}
+
+// NewProductivityReportWorker создает задачу, которая раз в сутки, через at после полуночи UTC,
+// строит и сохраняет отчет о скорости приемок за предыдущие сутки
+func NewProductivityReportWorker(service ReportService, locker Locker, at, sla, idleGap time.Duration) *ProductivityReportWorker {
+	return &ProductivityReportWorker{
+		service: service,
+		locker:  locker,
+		at:      at,
+		sla:     sla,
+		idleGap: idleGap,
+	}
+}
+
+// Run запускает задачу по расписанию и блокирует до отмены контекста
+func (w *ProductivityReportWorker) Run(ctx context.Context) error {
+	log.Logger.Info().
+		Dur("at", w.at).
+		Dur("sla", w.sla).
+		Dur("idle_gap", w.idleGap).
+		Msg("задача ежедневного отчета о скорости приемок запущена")
+
+	for {
+		timer := time.NewTimer(time.Until(w.nextRun(time.Now())))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			log.Logger.Info().Msg("задача ежедневного отчета о скорости приемок завершает работу")
+			return nil
+		case fired := <-timer.C:
+			w.RunOnce(ctx, fired.UTC().AddDate(0, 0, -1))
+		}
+	}
+}
+
+// nextRun ближайший после now момент запуска: полночь UTC плюс at
+func (w *ProductivityReportWorker) nextRun(now time.Time) time.Time {
+	next := now.UTC().Truncate(24 * time.Hour).Add(w.at)
+	for !next.After(now) {
+		next = next.AddDate(0, 0, 1)
+	}
+
+	return next
+}
+
+// RunOnce строит отчет за сутки day, если advisory-блокировка не занята другой репликой
+func (w *ProductivityReportWorker) RunOnce(ctx context.Context, day time.Time) {
+	unlock, ok, err := w.locker.TryAdvisoryLock(ctx, productivityReportLockKey)
+	if err != nil {
+		metrics.ProductivityReportRunErrors.Add(1)
+		log.Logger.Err(err).Msg("method ProductivityReportWorker.RunOnce")
+		return
+	}
+	if !ok {
+		metrics.ProductivityReportRunsSkipped.Add(1)
+		log.Logger.Debug().Msg("отчет о скорости приемок уже строится на другой реплике")
+		return
+	}
+	defer unlock()
+
+	started := time.Now()
+	metrics.ProductivityReportRuns.Add(1)
+	metrics.ProductivityReportLastRun.Set(started.Unix())
+
+	report, err := w.service.BuildDailyProductivityReport(ctx, day, w.sla, w.idleGap)
+	if err != nil {
+		metrics.ProductivityReportRunErrors.Add(1)
+		log.Logger.Err(err).Msg("method ProductivityReportWorker.RunOnce")
+		return
+	}
+
+	var receptions, breaches int
+	for _, row := range report.Rows {
+		receptions += row.Receptions
+		breaches += row.SlaBreaches
+	}
+
+	log.Logger.Info().
+		Str("day", report.StartDate.Format(time.DateOnly)).
+		Int("rows", len(report.Rows)).
+		Int("receptions", receptions).
+		Int("sla_breaches", breaches).
+		Dur("duration", time.Since(started)).
+		Msg("ежедневный отчет о скорости приемок построен")
+}
@@ -0,0 +1,104 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/devWaylander/pvz_store/pkg/log"
+	"github.com/devWaylander/pvz_store/pkg/metrics"
+	"github.com/devWaylander/pvz_store/pkg/models"
+)
+
+// staleReceptionLockKey ключ advisory-блокировки, под которой работает обработчик зависших приемок
+const staleReceptionLockKey int64 = 30_001
+
+const (
+	StaleReceptionActionClose = "close"
+	StaleReceptionActionFlag  = "flag"
+)
+
+type Service interface {
+	HandleStaleReceptions(ctx context.Context, idleSince time.Time, flagOnly bool) (models.StaleReceptionsResult, error)
+}
+
+type Locker interface {
+	TryAdvisoryLock(ctx context.Context, key int64) (unlock func(), ok bool, err error)
+}
+
+type StaleReceptionWorker struct {
+	service   Service
+	locker    Locker
+	interval  time.Duration
+	threshold time.Duration
+	flagOnly  bool
+}
+
+func NewStaleReceptionWorker(service Service, locker Locker, interval, threshold time.Duration, action string) *StaleReceptionWorker {
+	return &StaleReceptionWorker{
+		service:   service,
+		locker:    locker,
+		interval:  interval,
+		threshold: threshold,
+		flagOnly:  action == StaleReceptionActionFlag,
+	}
+}
+
+// Run запускает обработчик по расписанию и блокирует до отмены контекста
+func (w *StaleReceptionWorker) Run(ctx context.Context) error {
+	log.Logger.Info().
+		Dur("interval", w.interval).
+		Dur("threshold", w.threshold).
+		Bool("flag_only", w.flagOnly).
+		Msg("обработчик зависших приемок запущен")
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Logger.Info().Msg("обработчик зависших приемок завершает работу")
+			return nil
+		case <-ticker.C:
+			w.RunOnce(ctx)
+		}
+	}
+}
+
+// RunOnce выполняет один прогон, если advisory-блокировка не занята другой репликой
+func (w *StaleReceptionWorker) RunOnce(ctx context.Context) {
+	unlock, ok, err := w.locker.TryAdvisoryLock(ctx, staleReceptionLockKey)
+	if err != nil {
+		metrics.StaleReceptionRunErrors.Add(1)
+		log.Logger.Err(err).Msg("method StaleReceptionWorker.RunOnce")
+		return
+	}
+	if !ok {
+		metrics.StaleReceptionRunsSkipped.Add(1)
+		log.Logger.Debug().Msg("обработчик зависших приемок уже выполняется на другой реплике")
+		return
+	}
+	defer unlock()
+
+	started := time.Now()
+	metrics.StaleReceptionRuns.Add(1)
+	metrics.StaleReceptionLastRun.Set(started.Unix())
+
+	result, err := w.service.HandleStaleReceptions(ctx, started.Add(-w.threshold), w.flagOnly)
+	if err != nil {
+		metrics.StaleReceptionRunErrors.Add(1)
+		log.Logger.Err(err).Msg("method StaleReceptionWorker.RunOnce")
+		return
+	}
+
+	metrics.StaleReceptionsClosed.Add(int64(result.Closed))
+	metrics.StaleReceptionsFlagged.Add(int64(result.Flagged))
+	metrics.StaleReceptionsFailed.Add(int64(result.Failed))
+
+	log.Logger.Info().
+		Int("closed", result.Closed).
+		Int("flagged", result.Flagged).
+		Int("failed", result.Failed).
+		Dur("duration", time.Since(started)).
+		Msg("прогон обработчика зависших приемок завершён")
+}
@@ -23,6 +23,64 @@ const (
 	ErrReceptionDoesntExist = "ERR_RECEPTION_DOESNT_EXIST"
 	ErrReceptionExist       = "ERR_RECEPTION_ALREADY_IN_PROGRESS_STATUS"
 	ErrWrongReceptionStatus = "ERR_RECEPTION_ALREADY_IS_CLOSED"
+	ErrReceptionTransition  = "ERR_RECEPTION_STATUS_TRANSITION_NOT_ALLOWED"
+	ErrNewerReceptionExist  = "ERR_NEWER_RECEPTION_EXIST_FOR_PVZ"
+	ErrReasonRequired       = "ERR_REASON_IS_REQUIRED"
+	ErrReceptionDiscrepancy = "ERR_RECEPTION_HAS_MANIFEST_DISCREPANCIES"
+	// ===================-  MANIFEST  -===================
+	ErrManifestDoesntExist          = "ERR_MANIFEST_DOESNT_EXIST"
+	ErrWrongManifestItems           = "ERR_MANIFEST_ITEMS_MUST_BE_UNIQUE_AND_POSITIVE"
+	ErrDiscrepancyReportDoesntExist = "ERR_DISCREPANCY_REPORT_DOESNT_EXIST"
 	// ===================-  PRODUCT  -===================
-	ErrNoProductsToDelete = "ERR_NO_PRODUCTS_TO_DELETE"
+	ErrProductTypeDoesntExist   = "ERR_PRODUCT_TYPE_DOESNT_EXIST"
+	ErrProductTypeExist         = "ERR_PRODUCT_TYPE_ALREADY_EXIST"
+	ErrInvalidAttributesSchema  = "ERR_INVALID_PRODUCT_ATTRIBUTES_SCHEMA"
+	ErrInvalidProductAttributes = "ERR_PRODUCT_ATTRIBUTES_DONT_MATCH_TYPE_SCHEMA"
+	ErrNoProductsToDelete       = "ERR_NO_PRODUCTS_TO_DELETE"
+	ErrProductDoesntExist       = "ERR_PRODUCT_DOESNT_EXIST"
+	ErrEmptyProductPatch        = "ERR_PRODUCT_PATCH_HAS_NO_CHANGES"
+	ErrProductTransition        = "ERR_PRODUCT_STATUS_TRANSITION_NOT_ALLOWED"
+	ErrWrongPickupCode          = "ERR_WRONG_PICKUP_CODE"
+	ErrWrongProductsBatch       = "ERR_PRODUCTS_BATCH_SIZE_OUT_OF_RANGE"
+	ErrWrongBarcode             = "ERR_PRODUCT_BARCODE_HAS_WRONG_FORMAT_OR_CHECK_DIGIT"
+	ErrProductBarcodeExist      = "ERR_PRODUCT_WITH_BARCODE_ALREADY_IN_STOCK"
+	ErrDuplicateBarcodeInBatch  = "ERR_PRODUCTS_BATCH_HAS_DUPLICATE_BARCODES"
+	ErrProductInOrder           = "ERR_PRODUCT_BELONGS_TO_ORDER_ISSUE_BY_ORDER"
+	// ===================-  ORDER  -===================
+	ErrOrderExist             = "ERR_ORDER_WITH_NUMBER_ALREADY_EXIST"
+	ErrOrderDoesntExist       = "ERR_ORDER_DOESNT_EXIST"
+	ErrWrongOrderRecipient    = "ERR_ORDER_RECIPIENT_PHONE_OR_EMAIL_REQUIRED"
+	ErrWrongOrderItems        = "ERR_ORDER_BARCODES_MUST_BE_UNIQUE_AND_VALID"
+	ErrWrongOrderStatus       = "ERR_ORDER_IS_NOT_READY_FOR_PICKUP"
+	ErrPickupAttemptsExceeded = "ERR_PICKUP_CODE_ATTEMPTS_EXCEEDED"
+	// ===================-  RETURN  -===================
+	ErrReturnShipmentExist       = "ERR_RETURN_SHIPMENT_ALREADY_IN_PROGRESS"
+	ErrReturnShipmentDoesntExist = "ERR_RETURN_SHIPMENT_DOESNT_EXIST"
+	ErrNoOpenReturnShipment      = "ERR_NO_RETURN_SHIPMENT_IN_PROGRESS_FOR_PVZ"
+	ErrReturnWrongPvz            = "ERR_RETURNED_PRODUCT_BELONGS_TO_ANOTHER_PVZ"
+	// ===================-  TRANSFER  -===================
+	ErrTransferDoesntExist   = "ERR_TRANSFER_DOESNT_EXIST"
+	ErrWrongTransferPvz      = "ERR_TRANSFER_SOURCE_AND_DESTINATION_PVZ_MUST_DIFFER"
+	ErrWrongTransferProducts = "ERR_TRANSFER_PRODUCTS_MUST_BE_UNIQUE_AND_STORED_AT_SOURCE_PVZ"
+	ErrWrongTransferStatus   = "ERR_TRANSFER_STATUS_TRANSITION_NOT_ALLOWED"
+	// ===================-  STORAGE CELL  -===================
+	ErrStorageCellExist       = "ERR_STORAGE_CELL_WITH_CODE_ALREADY_EXIST_IN_PVZ"
+	ErrStorageCellDoesntExist = "ERR_STORAGE_CELL_DOESNT_EXIST_IN_PRODUCT_PVZ"
+	ErrStorageCellFull        = "ERR_STORAGE_CELL_IS_FULL"
+	ErrProductNotInStock      = "ERR_PRODUCT_IS_NOT_IN_PVZ_STOCK"
+	// ===================-  CAPACITY  -===================
+	ErrItemWeightLimit      = "ERR_PRODUCT_WEIGHT_EXCEEDS_PVZ_ITEM_LIMIT"
+	ErrItemVolumeLimit      = "ERR_PRODUCT_VOLUME_EXCEEDS_PVZ_ITEM_LIMIT"
+	ErrTotalWeightLimit     = "ERR_PVZ_TOTAL_WEIGHT_LIMIT_EXCEEDED"
+	ErrTotalVolumeLimit     = "ERR_PVZ_TOTAL_VOLUME_LIMIT_EXCEEDED"
+	ErrWrongProductPhysical = "ERR_PRODUCT_WEIGHT_OR_DIMENSIONS_OUT_OF_RANGE"
+	// ===================-  ATTACHMENT  -===================
+	ErrAttachmentDoesntExist = "ERR_ATTACHMENT_DOESNT_EXIST"
+	ErrAttachmentRequired    = "ERR_ATTACHMENT_FILE_PART_REQUIRED"
+	ErrAttachmentTooLarge    = "ERR_ATTACHMENT_EXCEEDS_SIZE_LIMIT"
+	ErrWrongAttachmentType   = "ERR_ATTACHMENT_MUST_BE_JPEG_PNG_OR_PDF"
+	// ===================-  STATS  -===================
+	ErrWrongDateRange = "ERR_START_DATE_AFTER_END_DATE"
+	// ===================-  REPORT  -===================
+	ErrProductivityReportDoesntExist = "ERR_PRODUCTIVITY_REPORT_DOESNT_EXIST"
 )
@@ -0,0 +1,30 @@
+package metrics
+
+import (
+	"expvar"
+	"net/http"
+)
+
+// Метрики фонового обработчика зависших приемок
+var (
+	StaleReceptionRuns        = expvar.NewInt("stale_reception_runs_total")
+	StaleReceptionRunsSkipped = expvar.NewInt("stale_reception_runs_skipped_total")
+	StaleReceptionRunErrors   = expvar.NewInt("stale_reception_run_errors_total")
+	StaleReceptionsClosed     = expvar.NewInt("stale_receptions_closed_total")
+	StaleReceptionsFlagged    = expvar.NewInt("stale_receptions_flagged_total")
+	StaleReceptionsFailed     = expvar.NewInt("stale_receptions_failed_total")
+	StaleReceptionLastRun     = expvar.NewInt("stale_reception_last_run_unix")
+)
+
+// Метрики задачи ежедневного отчета о скорости приемок
+var (
+	ProductivityReportRuns        = expvar.NewInt("productivity_report_runs_total")
+	ProductivityReportRunsSkipped = expvar.NewInt("productivity_report_runs_skipped_total")
+	ProductivityReportRunErrors   = expvar.NewInt("productivity_report_run_errors_total")
+	ProductivityReportLastRun     = expvar.NewInt("productivity_report_last_run_unix")
+)
+
+// Handler отдаёт все зарегистрированные метрики в формате JSON
+func Handler() http.Handler {
+	return expvar.Handler()
+}
@@ -0,0 +1,54 @@
+package models
+
+import (
+	"time"
+
+	"github.com/devWaylander/pvz_store/api"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/oapi-codegen/runtime/types"
+)
+
+type AttachmentDB struct {
+	ID          uuid.UUID       `db:"id"`
+	ReceptionID *uuid.UUID      `db:"reception_id"`
+	ProductID   *uuid.UUID      `db:"product_id"`
+	FileName    string          `db:"file_name"`
+	ContentType string          `db:"content_type"`
+	SizeBytes   int64           `db:"size_bytes"`
+	StorageKey  string          `db:"storage_key"`
+	CreatedBy   uuid.UUID       `db:"created_by"`
+	CreatedAt   strfmt.DateTime `db:"created_at"`
+}
+
+// AttachmentOwner приемка или товар, к которым относится вложение, заполняется ровно одно поле
+type AttachmentOwner struct {
+	ReceptionID *uuid.UUID
+	ProductID   *uuid.UUID
+}
+
+// NewAttachment метаданные загружаемого вложения
+type NewAttachment struct {
+	ID          uuid.UUID
+	Owner       AttachmentOwner
+	FileName    string
+	ContentType string
+	Size        int64
+	StorageKey  string
+	CreatedBy   uuid.UUID
+}
+
+func (adb *AttachmentDB) ToModelAPIAttachment() api.Attachment {
+	id := types.UUID(adb.ID)
+	createdBy := types.UUID(adb.CreatedBy)
+	return api.Attachment{
+		Id:          &id,
+		ReceptionId: adb.ReceptionID,
+		ProductId:   adb.ProductID,
+		FileName:    adb.FileName,
+		ContentType: api.AttachmentContentType(adb.ContentType),
+		Size:        int(adb.SizeBytes),
+		CreatedBy:   &createdBy,
+		DateTime:    (*time.Time)(&adb.CreatedAt),
+	}
+}
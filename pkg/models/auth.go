@@ -16,12 +16,22 @@ type contextKey string
 const authPrincipalKey contextKey = "authPrincipal"
 const TestEmail = "test@test.com"
 
+// SystemRole роль фоновых обработчиков, действующих без участия пользователя
+const SystemRole = "system"
+
 type AuthPrincipal struct {
 	UserUUID uuid.UUID `json:"uUUID"`
 	Email    string    `json:"email"`
 	Role     string    `json:"role"`
 }
 
+// SystemPrincipal фиксируется в истории как автор действий фоновых обработчиков
+var SystemPrincipal = AuthPrincipal{
+	UserUUID: uuid.Nil,
+	Email:    "system",
+	Role:     SystemRole,
+}
+
 /*
   служат исключительно для передачи параметров из middlewares авторизации в имплементацию бизнес-хендлеров
   удовлетворяющих генерируемому api.StrictServerInterface
@@ -0,0 +1,63 @@
+package models
+
+import (
+	"database/sql"
+
+	"github.com/devWaylander/pvz_store/api"
+	"github.com/google/uuid"
+)
+
+type PvzLimitsDB struct {
+	PvzID          uuid.UUID     `db:"pvz_id"`
+	MaxItemWeight  sql.NullInt64 `db:"max_item_weight"`
+	MaxItemVolume  sql.NullInt64 `db:"max_item_volume"`
+	MaxTotalWeight sql.NullInt64 `db:"max_total_weight"`
+	MaxTotalVolume sql.NullInt64 `db:"max_total_volume"`
+	OnExceed       string        `db:"on_exceed"`
+}
+
+func (ldb *PvzLimitsDB) ToModelAPIPvzLimits() api.PvzLimits {
+	return api.PvzLimits{
+		MaxItemWeight:  nullIntToPtr(ldb.MaxItemWeight),
+		MaxItemVolume:  nullIntToPtr(ldb.MaxItemVolume),
+		MaxTotalWeight: nullIntToPtr(ldb.MaxTotalWeight),
+		MaxTotalVolume: nullIntToPtr(ldb.MaxTotalVolume),
+		OnExceed:       api.PvzLimitsOnExceed(ldb.OnExceed),
+	}
+}
+
+type CapacityUsageDB struct {
+	Products int `db:"products"`
+	Weight   int `db:"weight"`
+	Volume   int `db:"volume"`
+}
+
+func (udb *CapacityUsageDB) ToModelAPICapacityUsage() api.CapacityUsage {
+	return api.CapacityUsage{
+		Products: udb.Products,
+		Weight:   udb.Weight,
+		Volume:   udb.Volume,
+	}
+}
+
+type CellCapacityDB struct {
+	CellID uuid.UUID `db:"cell_id"`
+	Code   string    `db:"code"`
+	CapacityUsageDB
+}
+
+func (cdb *CellCapacityDB) ToModelAPICellCapacity() api.CellCapacity {
+	return api.CellCapacity{
+		CellId: cdb.CellID,
+		Code:   cdb.Code,
+		Used:   cdb.ToModelAPICapacityUsage(),
+	}
+}
+
+func nullIntToPtr(v sql.NullInt64) *int {
+	if !v.Valid {
+		return nil
+	}
+	i := int(v.Int64)
+	return &i
+}
@@ -0,0 +1,85 @@
+package models
+
+import (
+	"database/sql"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RowWriter принимает строки выгрузки по одной (CSV или XLSX)
+type RowWriter interface {
+	Write(record []string) error
+}
+
+// PvzExportHeader колонки выгрузки ПВЗ: строка на товар, ПВЗ без приемок и приемки без товаров дают строку с пустыми колонками
+var PvzExportHeader = []string{
+	"pvz_id", "city", "registration_date",
+	"reception_id", "reception_date", "reception_status", "reception_type",
+	"product_id", "product_date", "product_type", "barcode", "product_status",
+}
+
+type PvzExportRowDB struct {
+	PvzID              uuid.UUID
+	City               string
+	RegistrationDate   time.Time
+	ReceptionID        uuid.NullUUID
+	ReceptionCreatedAt sql.NullTime
+	ReceptionStatus    sql.NullString
+	ReceptionType      sql.NullString
+	ProductID          uuid.NullUUID
+	ProductCreatedAt   sql.NullTime
+	ProductType        sql.NullString
+	Barcode            sql.NullString
+	ProductStatus      sql.NullString
+}
+
+func (row *PvzExportRowDB) Record() []string {
+	return []string{
+		row.PvzID.String(), row.City, row.RegistrationDate.Format(time.RFC3339),
+		nullUUIDString(row.ReceptionID), nullTimeString(row.ReceptionCreatedAt), row.ReceptionStatus.String, row.ReceptionType.String,
+		nullUUIDString(row.ProductID), nullTimeString(row.ProductCreatedAt), row.ProductType.String, row.Barcode.String, row.ProductStatus.String,
+	}
+}
+
+// ReceptionExportHeader колонки выгрузки приемок, product_counts перечисляет неаннулированные товары по типам
+var ReceptionExportHeader = []string{
+	"reception_id", "pvz_id", "status", "type", "created_at", "created_by", "closed_by", "stale_at",
+	"products", "product_counts",
+}
+
+type ReceptionExportRowDB struct {
+	ID            uuid.UUID
+	PvzID         uuid.UUID
+	Status        string
+	Type          string
+	CreatedAt     time.Time
+	CreatedBy     uuid.NullUUID
+	ClosedBy      uuid.NullUUID
+	StaleAt       sql.NullTime
+	Products      int
+	ProductCounts string
+}
+
+func (row *ReceptionExportRowDB) Record() []string {
+	return []string{
+		row.ID.String(), row.PvzID.String(), row.Status, row.Type, row.CreatedAt.Format(time.RFC3339),
+		nullUUIDString(row.CreatedBy), nullUUIDString(row.ClosedBy), nullTimeString(row.StaleAt),
+		strconv.Itoa(row.Products), row.ProductCounts,
+	}
+}
+
+func nullUUIDString(value uuid.NullUUID) string {
+	if !value.Valid {
+		return ""
+	}
+	return value.UUID.String()
+}
+
+func nullTimeString(value sql.NullTime) string {
+	if !value.Valid {
+		return ""
+	}
+	return value.Time.Format(time.RFC3339)
+}
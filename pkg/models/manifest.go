@@ -0,0 +1,107 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/devWaylander/pvz_store/api"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/oapi-codegen/runtime/types"
+)
+
+type ManifestDB struct {
+	ID          uuid.UUID       `db:"id"`
+	PvzID       uuid.UUID       `db:"pvz_id"`
+	Supplier    string          `db:"supplier"`
+	Strict      bool            `db:"strict"`
+	Status      string          `db:"status"`
+	ReceptionID uuid.NullUUID   `db:"reception_id"`
+	CreatedBy   uuid.UUID       `db:"created_by"`
+	CreatedAt   strfmt.DateTime `db:"created_at"`
+}
+
+type ManifestItemDB struct {
+	ManifestID    uuid.UUID      `db:"manifest_id"`
+	ProductType   string         `db:"product_type"`
+	Sku           sql.NullString `db:"sku"`
+	ExpectedCount int            `db:"expected_count"`
+}
+
+func (mdb *ManifestDB) ToModelAPIManifest(items []ManifestItemDB) api.Manifest {
+	id := types.UUID(mdb.ID)
+	manifest := api.Manifest{
+		Id:       &id,
+		PvzId:    mdb.PvzID,
+		Supplier: mdb.Supplier,
+		Strict:   mdb.Strict,
+		Status:   api.ManifestStatus(mdb.Status),
+		Items:    make([]api.ManifestItem, 0, len(items)),
+		DateTime: (*time.Time)(&mdb.CreatedAt),
+	}
+	if mdb.ReceptionID.Valid {
+		manifest.ReceptionId = &mdb.ReceptionID.UUID
+	}
+	for _, item := range items {
+		manifestItem := api.ManifestItem{
+			Type:  item.ProductType,
+			Count: item.ExpectedCount,
+		}
+		if item.Sku.Valid {
+			manifestItem.Sku = &item.Sku.String
+		}
+		manifest.Items = append(manifest.Items, manifestItem)
+	}
+
+	return manifest
+}
+
+type DiscrepancyReportDB struct {
+	ID               uuid.UUID       `db:"id"`
+	ReceptionID      uuid.UUID       `db:"reception_id"`
+	HasDiscrepancies bool            `db:"has_discrepancies"`
+	OverriddenBy     uuid.NullUUID   `db:"overridden_by"`
+	OverrideReason   sql.NullString  `db:"override_reason"`
+	CreatedAt        strfmt.DateTime `db:"created_at"`
+}
+
+type DiscrepancyItemDB struct {
+	ReportID      uuid.UUID      `db:"report_id"`
+	ProductType   string         `db:"product_type"`
+	Sku           sql.NullString `db:"sku"`
+	ExpectedCount int            `db:"expected_count"`
+	ActualCount   int            `db:"actual_count"`
+	Kind          string         `db:"kind"`
+}
+
+func (rdb *DiscrepancyReportDB) ToModelAPIDiscrepancyReport(items []DiscrepancyItemDB) api.DiscrepancyReport {
+	id := types.UUID(rdb.ID)
+	report := api.DiscrepancyReport{
+		Id:               &id,
+		ReceptionId:      rdb.ReceptionID,
+		HasDiscrepancies: rdb.HasDiscrepancies,
+		Overridden:       rdb.OverriddenBy.Valid,
+		Items:            make([]api.DiscrepancyItem, 0, len(items)),
+		DateTime:         (*time.Time)(&rdb.CreatedAt),
+	}
+	if rdb.OverriddenBy.Valid {
+		report.OverriddenBy = &rdb.OverriddenBy.UUID
+	}
+	if rdb.OverrideReason.Valid {
+		report.OverrideReason = &rdb.OverrideReason.String
+	}
+	for _, item := range items {
+		reportItem := api.DiscrepancyItem{
+			Type:     item.ProductType,
+			Expected: item.ExpectedCount,
+			Actual:   item.ActualCount,
+			Kind:     api.DiscrepancyItemKind(item.Kind),
+		}
+		if item.Sku.Valid {
+			reportItem.Sku = &item.Sku.String
+		}
+		report.Items = append(report.Items, reportItem)
+	}
+
+	return report
+}
@@ -0,0 +1,66 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/devWaylander/pvz_store/api"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/oapi-codegen/runtime/types"
+)
+
+type OrderDB struct {
+	ID             uuid.UUID       `db:"id"`
+	Number         string          `db:"number"`
+	PvzID          uuid.UUID       `db:"pvz_id"`
+	RecipientPhone sql.NullString  `db:"recipient_phone"`
+	RecipientEmail sql.NullString  `db:"recipient_email"`
+	Status         string          `db:"status"`
+	PickupCodeHash sql.NullString  `db:"pickup_code_hash"`
+	PickupAttempts int             `db:"pickup_attempts"`
+	ReadyAt        sql.NullTime    `db:"ready_at"`
+	IssuedAt       sql.NullTime    `db:"issued_at"`
+	CreatedBy      uuid.UUID       `db:"created_by"`
+	CreatedAt      strfmt.DateTime `db:"created_at"`
+}
+
+type OrderItemDB struct {
+	OrderID   uuid.UUID     `db:"order_id"`
+	Barcode   string        `db:"barcode"`
+	ProductID uuid.NullUUID `db:"product_id"`
+}
+
+func (odb *OrderDB) ToModelAPIOrder(items []OrderItemDB) api.Order {
+	id := types.UUID(odb.ID)
+	order := api.Order{
+		Id:             &id,
+		Number:         odb.Number,
+		PvzId:          odb.PvzID,
+		Status:         api.OrderStatus(odb.Status),
+		PickupAttempts: odb.PickupAttempts,
+		Items:          make([]api.OrderItem, 0, len(items)),
+		DateTime:       (*time.Time)(&odb.CreatedAt),
+	}
+	if odb.RecipientPhone.Valid {
+		order.RecipientPhone = &odb.RecipientPhone.String
+	}
+	if odb.RecipientEmail.Valid {
+		order.RecipientEmail = &odb.RecipientEmail.String
+	}
+	if odb.ReadyAt.Valid {
+		order.ReadyAt = &odb.ReadyAt.Time
+	}
+	if odb.IssuedAt.Valid {
+		order.IssuedAt = &odb.IssuedAt.Time
+	}
+	for _, item := range items {
+		orderItem := api.OrderItem{Barcode: item.Barcode}
+		if item.ProductID.Valid {
+			orderItem.ProductId = &item.ProductID.UUID
+		}
+		order.Items = append(order.Items, orderItem)
+	}
+
+	return order
+}
@@ -1,9 +1,12 @@
 package models
 
 import (
+	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/devWaylander/pvz_store/api"
+	internalErrors "github.com/devWaylander/pvz_store/pkg/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime/types"
@@ -14,15 +17,174 @@ type ProductDB struct {
 	Type        string          `db:"type"`
 	ReceptionID uuid.UUID       `db:"reception_id"`
 	CreatedAt   strfmt.DateTime `db:"created_at"`
+	VoidedAt    sql.NullTime    `db:"voided_at"`
+	CreatedBy   uuid.NullUUID   `db:"created_by"`
+	Attributes  []byte          `db:"attributes"`
+	Barcode     sql.NullString  `db:"barcode"`
+	Status      string          `db:"status"`
+	CellID      uuid.NullUUID   `db:"cell_id"`
+	Weight      sql.NullInt64   `db:"weight"`
+	Length      sql.NullInt64   `db:"length"`
+	Width       sql.NullInt64   `db:"width"`
+	Height      sql.NullInt64   `db:"height"`
+	// DeletedAt, DeletedBy и DeleteReason заполняются только при чтении удаленных товаров
+	DeletedAt    sql.NullTime   `db:"deleted_at"`
+	DeletedBy    uuid.NullUUID  `db:"deleted_by"`
+	DeleteReason sql.NullString `db:"delete_reason"`
 }
 
 func (pdb *ProductDB) ToModelAPIProduct() api.Product {
 	id := types.UUID(pdb.ID)
 	receptionId := types.UUID(pdb.ReceptionID)
-	return api.Product{
+	status := api.ProductStatus(pdb.Status)
+	product := api.Product{
 		Id:          &id,
-		Type:        api.ProductType(pdb.Type),
+		Type:        pdb.Type,
+		Status:      &status,
 		ReceptionId: receptionId,
 		DateTime:    (*time.Time)(&pdb.CreatedAt),
 	}
+	if pdb.VoidedAt.Valid {
+		product.VoidedAt = &pdb.VoidedAt.Time
+	}
+	if pdb.CreatedBy.Valid {
+		product.CreatedBy = &pdb.CreatedBy.UUID
+	}
+	if pdb.Barcode.Valid {
+		product.Barcode = &pdb.Barcode.String
+	}
+	if pdb.CellID.Valid {
+		product.CellId = &pdb.CellID.UUID
+	}
+	if pdb.Weight.Valid {
+		weight := int(pdb.Weight.Int64)
+		product.Weight = &weight
+	}
+	if pdb.Length.Valid && pdb.Width.Valid && pdb.Height.Valid {
+		product.Dimensions = &api.ProductDimensions{
+			Length: int(pdb.Length.Int64),
+			Width:  int(pdb.Width.Int64),
+			Height: int(pdb.Height.Int64),
+		}
+	}
+	if len(pdb.Attributes) > 0 {
+		attributes := api.ProductAttributes{}
+		// колонка JSONB всегда содержит корректный JSON
+		_ = json.Unmarshal(pdb.Attributes, &attributes)
+		product.Attributes = &attributes
+	}
+	if pdb.DeletedAt.Valid {
+		product.DeletedAt = &pdb.DeletedAt.Time
+	}
+	if pdb.DeletedBy.Valid {
+		product.DeletedBy = &pdb.DeletedBy.UUID
+	}
+	if pdb.DeleteReason.Valid {
+		product.DeleteReason = &pdb.DeleteReason.String
+	}
+
+	return product
+}
+
+// ProductAggDB товар в составе JSON, собранного json_agg. Метки времени приходят строками RFC3339 в UTC
+type ProductAggDB struct {
+	ID          uuid.UUID       `json:"id"`
+	Type        string          `json:"type"`
+	ReceptionID uuid.UUID       `json:"reception_id"`
+	CreatedAt   time.Time       `json:"created_at"`
+	VoidedAt    *time.Time      `json:"voided_at"`
+	CreatedBy   *uuid.UUID      `json:"created_by"`
+	Attributes  json.RawMessage `json:"attributes"`
+	Barcode     *string         `json:"barcode"`
+	Status      string          `json:"status"`
+	CellID      *uuid.UUID      `json:"cell_id"`
+	Weight      *int64          `json:"weight"`
+	Length      *int64          `json:"length"`
+	Width       *int64          `json:"width"`
+	Height      *int64          `json:"height"`
+}
+
+func (padb *ProductAggDB) ToModelAPIProduct() api.Product {
+	pdb := ProductDB{
+		ID:          padb.ID,
+		Type:        padb.Type,
+		ReceptionID: padb.ReceptionID,
+		CreatedAt:   strfmt.DateTime(padb.CreatedAt),
+		Status:      padb.Status,
+	}
+	if padb.VoidedAt != nil {
+		pdb.VoidedAt = sql.NullTime{Time: *padb.VoidedAt, Valid: true}
+	}
+	if padb.CreatedBy != nil {
+		pdb.CreatedBy = uuid.NullUUID{UUID: *padb.CreatedBy, Valid: true}
+	}
+	if padb.Barcode != nil {
+		pdb.Barcode = sql.NullString{String: *padb.Barcode, Valid: true}
+	}
+	if padb.CellID != nil {
+		pdb.CellID = uuid.NullUUID{UUID: *padb.CellID, Valid: true}
+	}
+	if padb.Weight != nil {
+		pdb.Weight = sql.NullInt64{Int64: *padb.Weight, Valid: true}
+	}
+	if padb.Length != nil {
+		pdb.Length = sql.NullInt64{Int64: *padb.Length, Valid: true}
+	}
+	if padb.Width != nil {
+		pdb.Width = sql.NullInt64{Int64: *padb.Width, Valid: true}
+	}
+	if padb.Height != nil {
+		pdb.Height = sql.NullInt64{Int64: *padb.Height, Valid: true}
+	}
+	if string(padb.Attributes) != "null" {
+		pdb.Attributes = padb.Attributes
+	}
+
+	return pdb.ToModelAPIProduct()
+}
+
+// ProductTransition переход товара между статусами жизненного цикла
+type ProductTransition struct {
+	ProductID uuid.UUID
+	From      string
+	To        string
+	ActorID   uuid.UUID
+	ActorRole string
+	Reason    string
+}
+
+// DuplicateBarcodeError возвращается при повторном сканировании штрихкода товара, который уже на складе
+type DuplicateBarcodeError struct {
+	Product api.Product
+}
+
+func (e *DuplicateBarcodeError) Error() string {
+	return internalErrors.ErrProductBarcodeExist
+}
+
+func (e *DuplicateBarcodeError) ToModelAPIDuplicateProductError() api.DuplicateProductError {
+	return api.DuplicateProductError{
+		Message:     e.Error(),
+		Barcode:     *e.Product.Barcode,
+		ProductId:   *e.Product.Id,
+		ReceptionId: e.Product.ReceptionId,
+	}
+}
+
+type ProductTypeDB struct {
+	Name             string          `db:"name"`
+	AttributesSchema []byte          `db:"attributes_schema"`
+	CreatedAt        strfmt.DateTime `db:"created_at"`
+}
+
+func (tdb *ProductTypeDB) ToModelAPIProductType() api.ProductType {
+	productType := api.ProductType{
+		Name:             tdb.Name,
+		AttributesSchema: map[string]interface{}{},
+		DateTime:         (*time.Time)(&tdb.CreatedAt),
+	}
+	// колонка JSONB всегда содержит корректный JSON
+	_ = json.Unmarshal(tdb.AttributesSchema, &productType.AttributesSchema)
+
+	return productType
 }
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/devWaylander/pvz_store/api"
+	"github.com/google/uuid"
+)
+
+// ProductivityFilter диапазон времени закрытия приемок [StartDate, EndDate) для отчета о скорости приемок
+type ProductivityFilter struct {
+	StartDate time.Time
+	EndDate   time.Time
+	PvzID     *uuid.UUID
+}
+
+// ReceptionTiming открытие, закрытие и время сканирования товаров одной закрытой приемки.
+// ScanTimes упорядочены по возрастанию
+type ReceptionTiming struct {
+	ReceptionID uuid.UUID
+	PvzID       uuid.UUID
+	EmployeeID  uuid.NullUUID
+	OpenedAt    time.Time
+	ClosedAt    time.Time
+	ScanTimes   []time.Time
+}
+
+// ProductivityReportDB сохраненный ежедневный отчет, строки хранятся в JSONB в формате API
+type ProductivityReportDB struct {
+	ReportDate     time.Time `db:"report_date"`
+	StartDate      time.Time `db:"start_date"`
+	EndDate        time.Time `db:"end_date"`
+	SlaMinutes     int       `db:"sla_minutes"`
+	IdleGapMinutes int       `db:"idle_gap_minutes"`
+	Rows           []byte    `db:"report_rows"`
+	GeneratedAt    time.Time `db:"generated_at"`
+}
+
+func (prdb *ProductivityReportDB) ToModelAPIProductivityReport() api.ProductivityReport {
+	report := api.ProductivityReport{
+		StartDate:      prdb.StartDate,
+		EndDate:        prdb.EndDate,
+		SlaMinutes:     prdb.SlaMinutes,
+		IdleGapMinutes: prdb.IdleGapMinutes,
+		GeneratedAt:    prdb.GeneratedAt,
+		Rows:           []api.ProductivityReportRow{},
+	}
+	// колонка JSONB всегда содержит корректный JSON
+	_ = json.Unmarshal(prdb.Rows, &report.Rows)
+
+	return report
+}
@@ -35,44 +35,145 @@ type PvzInfoDB struct {
 }
 
 type PvzInfo struct {
-	Pvz        api.PVZ
-	Receptions []ReceptionWithProducts
+	Pvz             api.PVZ
+	Receptions      []ReceptionWithProducts
+	ReturnShipments []api.ReturnShipment
 }
 
 type ReceptionWithProducts struct {
 	Reception api.Reception
 	Products  []api.Product
+	// ProductCounts количество неаннулированных товаров по типам, заполняется при include=counts
+	ProductCounts map[string]int
 }
 
-func MapPvzInfoToAPIResponse(pvzsInfo []PvzInfo) api.GetPvz200JSONResponse {
+// PvzInfoFilter параметры выборки страницы ПВЗ с приемками за период.
+// Без WithReceptions приемки не читаются, без WithProducts не читаются товары приемок
+type PvzInfoFilter struct {
+	Page           int
+	Limit          int
+	StartDate      *time.Time
+	EndDate        *time.Time
+	WithReceptions bool
+	WithProducts   bool
+}
+
+// PvzInfoProjection разделы списка ПВЗ, которые нужно прочитать и отдать в ответе
+type PvzInfoProjection struct {
+	Pvz             bool
+	Receptions      bool
+	Products        bool
+	ProductCounts   bool
+	ReturnShipments bool
+}
+
+// NewPvzInfoProjection собирает проекцию из параметров include и fields.
+// Без параметров отдаются все разделы, а приемки вместе с товарами.
+// Приемки без товаров всегда несут количество товаров по типам
+func NewPvzInfoProjection(include *api.PvzInclude, fields *[]api.PvzField) PvzInfoProjection {
+	projection := PvzInfoProjection{
+		Pvz:             true,
+		Receptions:      true,
+		ReturnShipments: true,
+	}
+	if fields != nil {
+		projection = PvzInfoProjection{}
+		for _, field := range *fields {
+			switch field {
+			case api.PvzFieldPvz:
+				projection.Pvz = true
+			case api.PvzFieldReceptions:
+				projection.Receptions = true
+			case api.PvzFieldReturnShipments:
+				projection.ReturnShipments = true
+			}
+		}
+	}
+
+	level := api.PvzIncludeProducts
+	if include != nil {
+		level = *include
+	}
+	switch level {
+	case api.PvzIncludeNone:
+		projection.Receptions = false
+	case api.PvzIncludeProducts:
+		projection.Products = projection.Receptions
+	case api.PvzIncludeReceptions, api.PvzIncludeCounts:
+		projection.ProductCounts = projection.Receptions
+	}
+
+	return projection
+}
+
+// ReceptionWithProductsAggDB элемент JSON-массива приемок ПВЗ из агрегирующего запроса.
+// Products равен nil, если у приемки нет товаров, как и при сборке ответа из отдельных запросов
+type ReceptionWithProductsAggDB struct {
+	Reception ReceptionAggDB `json:"reception"`
+	Products  []ProductAggDB `json:"products"`
+}
+
+func (rwp *ReceptionWithProductsAggDB) ToModelReceptionWithProducts() ReceptionWithProducts {
+	result := ReceptionWithProducts{Reception: rwp.Reception.ToModelAPIReception()}
+	for _, product := range rwp.Products {
+		result.Products = append(result.Products, product.ToModelAPIProduct())
+	}
+
+	return result
+}
+
+// MapPvzInfoToAPIResponse собирает ответ GET /pvz, не запрошенные проекцией разделы опускаются
+func MapPvzInfoToAPIResponse(pvzsInfo []PvzInfo, projection PvzInfoProjection) api.GetPvz200JSONResponse {
 	var response api.GetPvz200JSONResponse
 	for _, pvzInfo := range pvzsInfo {
 		var receptions []struct {
-			Products  *[]api.Product `json:"products,omitempty"`
-			Reception *api.Reception `json:"reception,omitempty"`
+			ProductCounts *api.ProductCounts `json:"productCounts,omitempty"`
+			Products      *[]api.Product     `json:"products,omitempty"`
+			Reception     *api.Reception     `json:"reception,omitempty"`
 		}
 
 		for _, rwp := range pvzInfo.Receptions {
-			products := rwp.Products
-			receptions = append(receptions, struct {
-				Products  *[]api.Product `json:"products,omitempty"`
-				Reception *api.Reception `json:"reception,omitempty"`
+			item := struct {
+				ProductCounts *api.ProductCounts `json:"productCounts,omitempty"`
+				Products      *[]api.Product     `json:"products,omitempty"`
+				Reception     *api.Reception     `json:"reception,omitempty"`
 			}{
-				Products:  &products,
 				Reception: &rwp.Reception,
-			})
+			}
+			if projection.Products {
+				products := rwp.Products
+				item.Products = &products
+			}
+			if projection.ProductCounts {
+				counts := api.ProductCounts(rwp.ProductCounts)
+				if counts == nil {
+					counts = api.ProductCounts{}
+				}
+				item.ProductCounts = &counts
+			}
+			receptions = append(receptions, item)
 		}
 
-		response = append(response, struct {
+		returnShipments := pvzInfo.ReturnShipments
+		item := struct {
 			Pvz        *api.PVZ `json:"pvz,omitempty"`
 			Receptions *[]struct {
-				Products  *[]api.Product `json:"products,omitempty"`
-				Reception *api.Reception `json:"reception,omitempty"`
+				ProductCounts *api.ProductCounts `json:"productCounts,omitempty"`
+				Products      *[]api.Product     `json:"products,omitempty"`
+				Reception     *api.Reception     `json:"reception,omitempty"`
 			} `json:"receptions,omitempty"`
-		}{
-			Pvz:        &pvzInfo.Pvz,
-			Receptions: &receptions,
-		})
+			ReturnShipments *[]api.ReturnShipment `json:"returnShipments,omitempty"`
+		}{}
+		if projection.Pvz {
+			item.Pvz = &pvzInfo.Pvz
+		}
+		if projection.Receptions {
+			item.Receptions = &receptions
+		}
+		if projection.ReturnShipments {
+			item.ReturnShipments = &returnShipments
+		}
+		response = append(response, item)
 	}
 
 	return response
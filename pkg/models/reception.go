@@ -1,6 +1,7 @@
 package models
 
 import (
+	"database/sql"
 	"time"
 
 	"github.com/devWaylander/pvz_store/api"
@@ -13,16 +14,127 @@ type ReceptionDB struct {
 	ID        uuid.UUID       `db:"id"`
 	PvzID     uuid.UUID       `db:"pvz_id"`
 	Status    string          `db:"status"`
+	Type      string          `db:"type"`
 	CreatedAt strfmt.DateTime `db:"created_at"`
+	StaleAt   sql.NullTime    `db:"stale_at"`
+	CreatedBy uuid.NullUUID   `db:"created_by"`
+	ClosedBy  uuid.NullUUID   `db:"closed_by"`
 }
 
 func (rdb *ReceptionDB) ToModelAPIReception() api.Reception {
 	id := types.UUID(rdb.ID)
 	pvzId := types.UUID(rdb.PvzID)
-	return api.Reception{
+	recType := api.ReceptionType(rdb.Type)
+	reception := api.Reception{
 		Id:       &id,
 		PvzId:    pvzId,
 		Status:   api.ReceptionStatus(rdb.Status),
+		Type:     &recType,
 		DateTime: time.Time(rdb.CreatedAt),
 	}
+	if rdb.StaleAt.Valid {
+		reception.StaleAt = &rdb.StaleAt.Time
+	}
+	if rdb.CreatedBy.Valid {
+		reception.CreatedBy = &rdb.CreatedBy.UUID
+	}
+	if rdb.ClosedBy.Valid {
+		reception.ClosedBy = &rdb.ClosedBy.UUID
+	}
+
+	return reception
+}
+
+// ReceptionTransition описывает переход приемки из статуса From в статус To
+// From пустой для первой записи истории (создание приемки)
+type ReceptionTransition struct {
+	ReceptionID uuid.UUID
+	From        string
+	To          string
+	ActorID     uuid.UUID
+	ActorRole   string
+	Reason      string
+}
+
+type ReceptionStatusChangeDB struct {
+	ID          uuid.UUID       `db:"id"`
+	ReceptionID uuid.UUID       `db:"reception_id"`
+	FromStatus  sql.NullString  `db:"from_status"`
+	ToStatus    string          `db:"to_status"`
+	ActorID     uuid.UUID       `db:"actor_id"`
+	ActorRole   string          `db:"actor_role"`
+	Reason      sql.NullString  `db:"reason"`
+	CreatedAt   strfmt.DateTime `db:"created_at"`
+}
+
+func (sdb *ReceptionStatusChangeDB) ToModelAPIReceptionStatusChange() api.ReceptionStatusChange {
+	id := types.UUID(sdb.ID)
+	change := api.ReceptionStatusChange{
+		Id:          &id,
+		ReceptionId: sdb.ReceptionID,
+		ToStatus:    api.ReceptionStatus(sdb.ToStatus),
+		ActorId:     sdb.ActorID,
+		ActorRole:   sdb.ActorRole,
+		DateTime:    time.Time(sdb.CreatedAt),
+	}
+	if sdb.FromStatus.Valid {
+		from := api.ReceptionStatus(sdb.FromStatus.String)
+		change.FromStatus = &from
+	}
+	if sdb.Reason.Valid {
+		change.Reason = &sdb.Reason.String
+	}
+
+	return change
+}
+
+// StaleReceptionsResult итог одного прогона обработчика зависших приемок
+type StaleReceptionsResult struct {
+	Closed  int
+	Flagged int
+	Failed  int
+}
+
+// ReceptionFilter параметры выборки списка приемок, nil-поля не участвуют в фильтрации
+type ReceptionFilter struct {
+	PvzID     *uuid.UUID
+	Status    *string
+	CreatedBy *uuid.UUID
+	StartDate *time.Time
+	EndDate   *time.Time
+	Page      int
+	Limit     int
+}
+
+// ReceptionAggDB приемка в составе JSON, собранного json_agg. Метки времени приходят строками RFC3339 в UTC
+type ReceptionAggDB struct {
+	ID        uuid.UUID  `json:"id"`
+	PvzID     uuid.UUID  `json:"pvz_id"`
+	Status    string     `json:"status"`
+	Type      string     `json:"type"`
+	CreatedAt time.Time  `json:"created_at"`
+	StaleAt   *time.Time `json:"stale_at"`
+	CreatedBy *uuid.UUID `json:"created_by"`
+	ClosedBy  *uuid.UUID `json:"closed_by"`
+}
+
+func (radb *ReceptionAggDB) ToModelAPIReception() api.Reception {
+	rdb := ReceptionDB{
+		ID:        radb.ID,
+		PvzID:     radb.PvzID,
+		Status:    radb.Status,
+		Type:      radb.Type,
+		CreatedAt: strfmt.DateTime(radb.CreatedAt),
+	}
+	if radb.StaleAt != nil {
+		rdb.StaleAt = sql.NullTime{Time: *radb.StaleAt, Valid: true}
+	}
+	if radb.CreatedBy != nil {
+		rdb.CreatedBy = uuid.NullUUID{UUID: *radb.CreatedBy, Valid: true}
+	}
+	if radb.ClosedBy != nil {
+		rdb.ClosedBy = uuid.NullUUID{UUID: *radb.ClosedBy, Valid: true}
+	}
+
+	return rdb.ToModelAPIReception()
 }
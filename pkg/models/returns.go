@@ -0,0 +1,77 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/devWaylander/pvz_store/api"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/oapi-codegen/runtime/types"
+)
+
+type ReturnShipmentDB struct {
+	ID        uuid.UUID       `db:"id"`
+	PvzID     uuid.UUID       `db:"pvz_id"`
+	Status    string          `db:"status"`
+	CreatedBy uuid.UUID       `db:"created_by"`
+	CreatedAt strfmt.DateTime `db:"created_at"`
+	ClosedBy  uuid.NullUUID   `db:"closed_by"`
+	ClosedAt  sql.NullTime    `db:"closed_at"`
+}
+
+type ReturnDB struct {
+	ID         uuid.UUID       `db:"id"`
+	ProductID  uuid.UUID       `db:"product_id"`
+	ShipmentID uuid.UUID       `db:"shipment_id"`
+	ReasonCode string          `db:"reason_code"`
+	Condition  string          `db:"condition"`
+	Comment    sql.NullString  `db:"comment"`
+	CreatedBy  uuid.UUID       `db:"created_by"`
+	CreatedAt  strfmt.DateTime `db:"created_at"`
+}
+
+func (sdb *ReturnShipmentDB) ToModelAPIReturnShipment(returns []ReturnDB) api.ReturnShipment {
+	id := types.UUID(sdb.ID)
+	createdBy := types.UUID(sdb.CreatedBy)
+	shipmentReturns := make([]api.Return, 0, len(returns))
+	for _, ret := range returns {
+		shipmentReturns = append(shipmentReturns, ret.ToModelAPIReturn())
+	}
+
+	shipment := api.ReturnShipment{
+		Id:        &id,
+		PvzId:     sdb.PvzID,
+		Status:    api.ReturnShipmentStatus(sdb.Status),
+		CreatedBy: &createdBy,
+		DateTime:  (*time.Time)(&sdb.CreatedAt),
+		Returns:   &shipmentReturns,
+	}
+	if sdb.ClosedBy.Valid {
+		shipment.ClosedBy = &sdb.ClosedBy.UUID
+	}
+	if sdb.ClosedAt.Valid {
+		shipment.ClosedAt = &sdb.ClosedAt.Time
+	}
+
+	return shipment
+}
+
+func (rdb *ReturnDB) ToModelAPIReturn() api.Return {
+	id := types.UUID(rdb.ID)
+	createdBy := types.UUID(rdb.CreatedBy)
+	ret := api.Return{
+		Id:         &id,
+		ProductId:  rdb.ProductID,
+		ShipmentId: rdb.ShipmentID,
+		ReasonCode: api.ReturnReasonCode(rdb.ReasonCode),
+		Condition:  api.ReturnCondition(rdb.Condition),
+		CreatedBy:  &createdBy,
+		DateTime:   (*time.Time)(&rdb.CreatedAt),
+	}
+	if rdb.Comment.Valid {
+		ret.Comment = &rdb.Comment.String
+	}
+
+	return ret
+}
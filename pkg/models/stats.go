@@ -0,0 +1,48 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/devWaylander/pvz_store/api"
+	"github.com/google/uuid"
+)
+
+// ReceptionStatsFilter измерения группировки и диапазон дат статистики приемок.
+// Period пустой, если статистика не разбивается по периодам
+type ReceptionStatsFilter struct {
+	GroupBy   []string
+	Period    string
+	StartDate *time.Time
+	EndDate   *time.Time
+}
+
+type ReceptionStatsRowDB struct {
+	PeriodStart sql.NullTime   `db:"period_start"`
+	PvzID       uuid.NullUUID  `db:"pvz_id"`
+	City        sql.NullString `db:"city"`
+	ProductType sql.NullString `db:"product_type"`
+	Receptions  int            `db:"receptions"`
+	Products    int            `db:"products"`
+}
+
+func (rsdb *ReceptionStatsRowDB) ToModelAPIReceptionStatsRow() api.ReceptionStatsRow {
+	row := api.ReceptionStatsRow{
+		Receptions: rsdb.Receptions,
+		Products:   rsdb.Products,
+	}
+	if rsdb.PeriodStart.Valid {
+		row.PeriodStart = &rsdb.PeriodStart.Time
+	}
+	if rsdb.PvzID.Valid {
+		row.PvzId = &rsdb.PvzID.UUID
+	}
+	if rsdb.City.Valid {
+		row.City = &rsdb.City.String
+	}
+	if rsdb.ProductType.Valid {
+		row.ProductType = &rsdb.ProductType.String
+	}
+
+	return row
+}
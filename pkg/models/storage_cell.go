@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+
+	"github.com/devWaylander/pvz_store/api"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/oapi-codegen/runtime/types"
+)
+
+type StorageCellDB struct {
+	ID        uuid.UUID       `db:"id"`
+	PvzID     uuid.UUID       `db:"pvz_id"`
+	Rack      string          `db:"rack"`
+	Shelf     string          `db:"shelf"`
+	Cell      string          `db:"cell"`
+	Code      string          `db:"code"`
+	Capacity  int             `db:"capacity"`
+	Occupied  int             `db:"occupied"`
+	CreatedAt strfmt.DateTime `db:"created_at"`
+}
+
+func (scdb *StorageCellDB) ToModelAPIStorageCell() api.StorageCell {
+	id := types.UUID(scdb.ID)
+	code := scdb.Code
+	occupied := scdb.Occupied
+	return api.StorageCell{
+		Id:       &id,
+		PvzId:    scdb.PvzID,
+		Rack:     scdb.Rack,
+		Shelf:    scdb.Shelf,
+		Cell:     scdb.Cell,
+		Code:     &code,
+		Capacity: scdb.Capacity,
+		Occupied: &occupied,
+		DateTime: (*time.Time)(&scdb.CreatedAt),
+	}
+}
@@ -0,0 +1,57 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/devWaylander/pvz_store/api"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/oapi-codegen/runtime/types"
+)
+
+type TransferDB struct {
+	ID               uuid.UUID       `db:"id"`
+	SourcePvzID      uuid.UUID       `db:"source_pvz_id"`
+	DestinationPvzID uuid.UUID       `db:"destination_pvz_id"`
+	Status           string          `db:"status"`
+	ReceptionID      uuid.NullUUID   `db:"reception_id"`
+	CreatedBy        uuid.UUID       `db:"created_by"`
+	CreatedAt        strfmt.DateTime `db:"created_at"`
+	DispatchedAt     sql.NullTime    `db:"dispatched_at"`
+	ReceivedAt       sql.NullTime    `db:"received_at"`
+}
+
+type TransferItemDB struct {
+	TransferID        uuid.UUID `db:"transfer_id"`
+	ProductID         uuid.UUID `db:"product_id"`
+	SourceReceptionID uuid.UUID `db:"source_reception_id"`
+}
+
+func (tdb *TransferDB) ToModelAPITransfer(items []TransferItemDB) api.Transfer {
+	id := types.UUID(tdb.ID)
+	createdBy := types.UUID(tdb.CreatedBy)
+	transfer := api.Transfer{
+		Id:               &id,
+		SourcePvzId:      tdb.SourcePvzID,
+		DestinationPvzId: tdb.DestinationPvzID,
+		Status:           api.TransferStatus(tdb.Status),
+		ProductIds:       make([]types.UUID, 0, len(items)),
+		CreatedBy:        &createdBy,
+		DateTime:         (*time.Time)(&tdb.CreatedAt),
+	}
+	if tdb.ReceptionID.Valid {
+		transfer.ReceptionId = &tdb.ReceptionID.UUID
+	}
+	if tdb.DispatchedAt.Valid {
+		transfer.DispatchedAt = &tdb.DispatchedAt.Time
+	}
+	if tdb.ReceivedAt.Valid {
+		transfer.ReceivedAt = &tdb.ReceivedAt.Time
+	}
+	for _, item := range items {
+		transfer.ProductIds = append(transfer.ProductIds, item.ProductID)
+	}
+
+	return transfer
+}
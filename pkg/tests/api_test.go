@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -53,7 +55,7 @@ func (s *E2eIntegrationTestSuite) SetupSuite() {
 	// Подготовка зависимостей
 	repoInstance := repo.New(db)
 	authRepo := auth.NewRepo(db)
-	serviceInstance := service.New(repoInstance)
+	serviceInstance := service.New(repoInstance, nil, nil, nil, []byte(cfg.Common.PickupCodeSecret))
 	authMiddleware := auth.NewMiddleware(authRepo, cfg.Common.JWTSecret)
 
 	// chi router + middleware + handler
@@ -153,11 +155,19 @@ func (s *E2eIntegrationTestSuite) TestFullIntegrationFlow() {
 	err = json.Unmarshal(respBody, &reception)
 	require.NoError(t, err)
 
+	// Electronics without serial number is rejected
+	body, err = json.Marshal(api.PostProductsJSONRequestBody{PvzId: *createPVZBody.Id, Type: "электроника"})
+	require.NoError(t, err)
+	resp, _, err = client.SendJsonReq(employeeToken, http.MethodPost, BaseURL+"/products", body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
 	// Add 50 products
 	for i := 0; i < 30; i++ {
 		createProductBody := api.PostProductsJSONRequestBody{
-			PvzId: *createPVZBody.Id,
-			Type:  api.PostProductsJSONBodyType(api.ProductTypeЭлектроника),
+			PvzId:      *createPVZBody.Id,
+			Type:       "электроника",
+			Attributes: &api.ProductAttributes{"serialNumber": fmt.Sprintf("SN-%d", i)},
 		}
 		body, err := json.Marshal(createProductBody)
 		require.NoError(t, err)
@@ -169,7 +179,7 @@ func (s *E2eIntegrationTestSuite) TestFullIntegrationFlow() {
 	for i := 0; i < 10; i++ {
 		createProductBody := api.PostProductsJSONRequestBody{
 			PvzId: *createPVZBody.Id,
-			Type:  api.PostProductsJSONBodyType(api.ProductTypeОдежда),
+			Type:  "одежда",
 		}
 		body, err := json.Marshal(createProductBody)
 		require.NoError(t, err)
@@ -180,8 +190,9 @@ func (s *E2eIntegrationTestSuite) TestFullIntegrationFlow() {
 	}
 	for i := 0; i < 10; i++ {
 		createProductBody := api.PostProductsJSONRequestBody{
-			PvzId: *createPVZBody.Id,
-			Type:  api.PostProductsJSONBodyType(api.ProductTypeОбувь),
+			PvzId:      *createPVZBody.Id,
+			Type:       "обувь",
+			Attributes: &api.ProductAttributes{"size": 42},
 		}
 		body, err := json.Marshal(createProductBody)
 		require.NoError(t, err)
@@ -197,3 +208,311 @@ func (s *E2eIntegrationTestSuite) TestFullIntegrationFlow() {
 	require.NoError(t, err)
 	require.Equal(t, http.StatusOK, resp.StatusCode)
 }
+
+func (s *E2eIntegrationTestSuite) dummyLogin(client *HttpClient, role api.PostDummyLoginJSONBodyRole) api.Token {
+	t := s.T()
+
+	reqBody, err := json.Marshal(api.PostDummyLoginJSONBody{Role: role})
+	require.NoError(t, err)
+	resp, respBody, err := client.SendJsonReq("", http.MethodPost, BaseURL+"/dummyLogin", reqBody)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var token api.Token
+	require.NoError(t, json.Unmarshal(respBody, &token))
+	require.Greater(t, len(token), 0)
+
+	return token
+}
+
+func (s *E2eIntegrationTestSuite) createPVZ(client *HttpClient, moderatorToken api.Token) uuid.UUID {
+	t := s.T()
+	pvzUuid := uuid.New()
+	regDate := time.Now()
+
+	body, err := json.Marshal(api.PostPvzJSONRequestBody{
+		Id:               &pvzUuid,
+		City:             "Казань",
+		RegistrationDate: &regDate,
+	})
+	require.NoError(t, err)
+	resp, _, err := client.SendJsonReq(moderatorToken, http.MethodPost, BaseURL+"/pvz", body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	return pvzUuid
+}
+
+// Параллельные запросы на открытие приемки в одном ПВЗ: успешно должен выполниться ровно один
+func (s *E2eIntegrationTestSuite) TestConcurrentReceptionOpening() {
+	t := s.T()
+	const workers = 20
+	client := HttpClient{}
+
+	moderatorToken := s.dummyLogin(&client, api.PostDummyLoginJSONBodyRole(api.Moderator))
+	employeeToken := s.dummyLogin(&client, api.PostDummyLoginJSONBodyRole(api.Employee))
+	pvzUuid := s.createPVZ(&client, moderatorToken)
+
+	body, err := json.Marshal(api.PostReceptionsJSONRequestBody{PvzId: pvzUuid})
+	require.NoError(t, err)
+
+	statuses := make(chan int, workers)
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, _, err := client.SendJsonReq(employeeToken, http.MethodPost, BaseURL+"/receptions", body)
+			if err != nil {
+				statuses <- 0
+				return
+			}
+			statuses <- resp.StatusCode
+		}()
+	}
+	wg.Wait()
+	close(statuses)
+
+	created, rejected := 0, 0
+	for status := range statuses {
+		switch status {
+		case http.StatusCreated:
+			created++
+		case http.StatusBadRequest:
+			rejected++
+		}
+	}
+	require.Equal(t, 1, created)
+	require.Equal(t, workers-1, rejected)
+}
+
+// Параллельное добавление товаров и закрытие приемки: товары не должны попадать в закрытую приемку
+func (s *E2eIntegrationTestSuite) TestConcurrentProductsAndClose() {
+	t := s.T()
+	const workers = 30
+	client := HttpClient{}
+
+	moderatorToken := s.dummyLogin(&client, api.PostDummyLoginJSONBodyRole(api.Moderator))
+	employeeToken := s.dummyLogin(&client, api.PostDummyLoginJSONBodyRole(api.Employee))
+	pvzUuid := s.createPVZ(&client, moderatorToken)
+
+	body, err := json.Marshal(api.PostReceptionsJSONRequestBody{PvzId: pvzUuid})
+	require.NoError(t, err)
+	resp, _, err := client.SendJsonReq(employeeToken, http.MethodPost, BaseURL+"/receptions", body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	productBody, err := json.Marshal(api.PostProductsJSONRequestBody{
+		PvzId:      pvzUuid,
+		Type:       "обувь",
+		Attributes: &api.ProductAttributes{"size": 42},
+	})
+	require.NoError(t, err)
+
+	var (
+		wg      sync.WaitGroup
+		created atomic.Int32
+	)
+	for i := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if i == workers/2 {
+				url := fmt.Sprintf(BaseURL+"/pvz/%s/close_last_reception", pvzUuid)
+				resp, _, err := client.SendJsonReq(employeeToken, http.MethodPost, url, nil)
+				if err == nil && resp.StatusCode != http.StatusOK {
+					t.Errorf("close reception status = %d", resp.StatusCode)
+				}
+				return
+			}
+
+			resp, _, err := client.SendJsonReq(employeeToken, http.MethodPost, BaseURL+"/products", productBody)
+			if err != nil {
+				return
+			}
+			switch resp.StatusCode {
+			case http.StatusCreated:
+				created.Add(1)
+			case http.StatusBadRequest:
+			default:
+				t.Errorf("create product status = %d", resp.StatusCode)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// все успешно добавленные товары находятся в закрытой приемке, лишних нет
+	var products int
+	err = s.dbPool.Get(&products, `
+		SELECT COUNT(*)
+		FROM shop.products p
+		JOIN shop.receptions r ON r.id = p.reception_id
+		WHERE r.pvz_id = $1 AND r.status = 'closed'
+	`, pvzUuid)
+	require.NoError(t, err)
+	require.Equal(t, int(created.Load()), products)
+}
+
+// Пакетное сканирование: товары пакета получают реальное время добавления,
+// а удаление последнего товара снимает последний товар пакета
+func (s *E2eIntegrationTestSuite) TestBatchProducts() {
+	t := s.T()
+	client := HttpClient{}
+
+	moderatorToken := s.dummyLogin(&client, api.PostDummyLoginJSONBodyRole(api.Moderator))
+	employeeToken := s.dummyLogin(&client, api.PostDummyLoginJSONBodyRole(api.Employee))
+	pvzUuid := s.createPVZ(&client, moderatorToken)
+
+	body, err := json.Marshal(api.PostReceptionsJSONRequestBody{PvzId: pvzUuid})
+	require.NoError(t, err)
+	resp, _, err := client.SendJsonReq(employeeToken, http.MethodPost, BaseURL+"/receptions", body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	// Add 10 products in one batch
+	batchItems := make([]api.ProductBatchItem, 10)
+	for i := range batchItems {
+		batchItems[i] = api.ProductBatchItem{Type: "одежда"}
+	}
+	body, err = json.Marshal(api.PostProductsBatchJSONRequestBody{PvzId: pvzUuid, Items: batchItems})
+	require.NoError(t, err)
+
+	resp, respBody, err := client.SendJsonReq(employeeToken, http.MethodPost, BaseURL+"/products/batch", body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var batchProducts []api.Product
+	require.NoError(t, json.Unmarshal(respBody, &batchProducts))
+	require.Len(t, batchProducts, len(batchItems))
+
+	// время добавления не сдвигается искусственно: у товаров одного пакета оно совпадает
+	var createdAts int
+	err = s.dbPool.Get(&createdAts, `SELECT COUNT(DISTINCT created_at) FROM shop.products WHERE reception_id = $1`, batchProducts[0].ReceptionId)
+	require.NoError(t, err)
+	require.Equal(t, 1, createdAts)
+
+	// Delete last product
+	url := fmt.Sprintf(BaseURL+"/pvz/%s/delete_last_product", pvzUuid)
+	resp, _, err = client.SendJsonReq(employeeToken, http.MethodPost, url, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var deleted uuid.UUID
+	err = s.dbPool.Get(&deleted, `SELECT id FROM shop.products WHERE reception_id = $1 AND deleted_at IS NOT NULL`, batchProducts[0].ReceptionId)
+	require.NoError(t, err)
+	require.Equal(t, *batchProducts[len(batchProducts)-1].Id, deleted)
+
+	// Close reception
+	url = fmt.Sprintf(BaseURL+"/pvz/%s/close_last_reception", pvzUuid)
+	resp, _, err = client.SendJsonReq(employeeToken, http.MethodPost, url, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+// Получение перемещения в ПВЗ с открытой приемкой поставки: приемка перемещения создается рядом с ней,
+// а открытая приемка остается последней и продолжает принимать товары
+func (s *E2eIntegrationTestSuite) TestReceiveTransferWithOpenReception() {
+	t := s.T()
+	client := HttpClient{}
+
+	moderatorToken := s.dummyLogin(&client, api.PostDummyLoginJSONBodyRole(api.Moderator))
+	employeeToken := s.dummyLogin(&client, api.PostDummyLoginJSONBodyRole(api.Employee))
+	sourcePvzUuid := s.createPVZ(&client, moderatorToken)
+	destinationPvzUuid := s.createPVZ(&client, moderatorToken)
+
+	// товар на хранении в ПВЗ отправления
+	body, err := json.Marshal(api.PostReceptionsJSONRequestBody{PvzId: sourcePvzUuid})
+	require.NoError(t, err)
+	resp, _, err := client.SendJsonReq(employeeToken, http.MethodPost, BaseURL+"/receptions", body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	body, err = json.Marshal(api.PostProductsJSONRequestBody{
+		PvzId:      sourcePvzUuid,
+		Type:       "электроника",
+		Attributes: &api.ProductAttributes{"serialNumber": "SN-TRANSFER"},
+	})
+	require.NoError(t, err)
+	resp, respBody, err := client.SendJsonReq(employeeToken, http.MethodPost, BaseURL+"/products", body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var product api.Product
+	require.NoError(t, json.Unmarshal(respBody, &product))
+
+	url := fmt.Sprintf(BaseURL+"/pvz/%s/close_last_reception", sourcePvzUuid)
+	resp, _, err = client.SendJsonReq(employeeToken, http.MethodPost, url, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// открытая приемка поставки в ПВЗ назначения
+	body, err = json.Marshal(api.PostReceptionsJSONRequestBody{PvzId: destinationPvzUuid})
+	require.NoError(t, err)
+	resp, respBody, err = client.SendJsonReq(employeeToken, http.MethodPost, BaseURL+"/receptions", body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var openReception api.Reception
+	require.NoError(t, json.Unmarshal(respBody, &openReception))
+
+	// Create, dispatch and receive transfer
+	body, err = json.Marshal(api.PostTransfersJSONRequestBody{
+		SourcePvzId:      sourcePvzUuid,
+		DestinationPvzId: destinationPvzUuid,
+		ProductIds:       []uuid.UUID{*product.Id},
+	})
+	require.NoError(t, err)
+	resp, respBody, err = client.SendJsonReq(employeeToken, http.MethodPost, BaseURL+"/transfers", body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var transfer api.Transfer
+	require.NoError(t, json.Unmarshal(respBody, &transfer))
+
+	url = fmt.Sprintf(BaseURL+"/transfers/%s/dispatch", transfer.Id)
+	resp, _, err = client.SendJsonReq(employeeToken, http.MethodPost, url, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	url = fmt.Sprintf(BaseURL+"/transfers/%s/receive", transfer.Id)
+	resp, respBody, err = client.SendJsonReq(employeeToken, http.MethodPost, url, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	require.NoError(t, json.Unmarshal(respBody, &transfer))
+	require.Equal(t, api.Received, transfer.Status)
+	require.NotNil(t, transfer.ReceptionId)
+	require.NotEqual(t, *openReception.Id, *transfer.ReceptionId)
+
+	// товар числится в ПВЗ назначения, а его приемка поставки не меняется
+	url = fmt.Sprintf(BaseURL+"/products/%s/location", product.Id)
+	resp, respBody, err = client.SendJsonReq(employeeToken, http.MethodGet, url, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var location api.ProductLocation
+	require.NoError(t, json.Unmarshal(respBody, &location))
+	require.Equal(t, destinationPvzUuid, location.PvzId)
+	require.Equal(t, product.ReceptionId, location.ReceptionId)
+	require.Equal(t, api.ProductStatusStored, location.Status)
+
+	// открытая приемка поставки по-прежнему принимает товары и закрывается
+	body, err = json.Marshal(api.PostProductsJSONRequestBody{
+		PvzId:      destinationPvzUuid,
+		Type:       "обувь",
+		Attributes: &api.ProductAttributes{"size": 42},
+	})
+	require.NoError(t, err)
+	resp, respBody, err = client.SendJsonReq(employeeToken, http.MethodPost, BaseURL+"/products", body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var received api.Product
+	require.NoError(t, json.Unmarshal(respBody, &received))
+	require.Equal(t, *openReception.Id, received.ReceptionId)
+
+	url = fmt.Sprintf(BaseURL+"/pvz/%s/close_last_reception", destinationPvzUuid)
+	resp, _, err = client.SendJsonReq(employeeToken, http.MethodPost, url, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
@@ -0,0 +1,159 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/devWaylander/pvz_store/api"
+	"github.com/devWaylander/pvz_store/internal/repo"
+	"github.com/devWaylander/pvz_store/internal/service"
+	"github.com/devWaylander/pvz_store/pkg/models"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+// seedProductsCounted товаров засеянной приемки без удаленных и аннулированных
+const seedProductsCounted = 17
+
+// TestDailySummaries проверяет, что статистика из пересчитанных сводок совпадает с засеянными данными
+// и что сводки следуют за переоткрытием и повторным закрытием приемки и не меняются при перемещении товара
+func TestDailySummaries(t *testing.T) {
+	db := connectTestDB(t)
+	pvzUUIDs, recsUUIDs := seedPVZsInfo(t, db)
+	r := repo.New(db)
+	ctx := context.Background()
+
+	// история статусов пишется при переоткрытии и закрытии приемки, её строки ссылаются на засеянные товары
+	t.Cleanup(func() {
+		_, err := db.ExecContext(ctx, `
+			DELETE FROM shop.product_status_history
+			WHERE product_id IN (SELECT id FROM shop.products WHERE reception_id = ANY($1))
+		`, pq.Array(recsUUIDs))
+		require.NoError(t, err)
+		_, err = db.ExecContext(ctx, `DELETE FROM shop.reception_status_history WHERE reception_id = ANY($1)`, pq.Array(recsUUIDs))
+		require.NoError(t, err)
+		_, err = db.ExecContext(ctx, `DELETE FROM shop.daily_pvz_summaries WHERE pvz_id = ANY($1)`, pq.Array(pvzUUIDs))
+		require.NoError(t, err)
+		_, err = db.ExecContext(ctx, `DELETE FROM shop.daily_product_type_summaries WHERE pvz_id = ANY($1)`, pq.Array(pvzUUIDs))
+		require.NoError(t, err)
+	})
+
+	// засеянные приемки созданы за последние часы, дни берутся с запасом на часовой пояс БД
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	from, to := today.AddDate(0, 0, -2), today.AddDate(0, 0, 1)
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		require.NoError(t, r.RebuildDailySummaries(ctx, day))
+	}
+
+	// statsByPvz возвращает строки статистики засеянных ПВЗ
+	statsByPvz := func(groupBy ...string) map[uuid.UUID]api.ReceptionStatsRow {
+		rows, err := r.GetReceptionStats(ctx, models.ReceptionStatsFilter{
+			GroupBy:   groupBy,
+			StartDate: &from,
+			EndDate:   &to,
+		})
+		require.NoError(t, err)
+
+		seeded := make(map[uuid.UUID]bool, len(pvzUUIDs))
+		for _, pvzUUID := range pvzUUIDs {
+			seeded[pvzUUID] = true
+		}
+		result := make(map[uuid.UUID]api.ReceptionStatsRow)
+		for _, row := range rows {
+			if row.PvzId != nil && seeded[*row.PvzId] {
+				result[*row.PvzId] = row
+			}
+		}
+		return result
+	}
+
+	t.Run("rebuild", func(t *testing.T) {
+		for _, groupBy := range [][]string{
+			{string(api.StatsDimensionPvz)},
+			{string(api.StatsDimensionPvz), string(api.StatsDimensionProductType)},
+		} {
+			stats := statsByPvz(groupBy...)
+			require.Len(t, stats, seedPVZs)
+			for _, row := range stats {
+				require.Equal(t, seedReceptionsPerPVZ, row.Receptions)
+				require.Equal(t, seedReceptionsPerPVZ*seedProductsCounted, row.Products)
+			}
+		}
+	})
+
+	t.Run("reopen and close", func(t *testing.T) {
+		var pvzUUID uuid.UUID
+		require.NoError(t, db.GetContext(ctx, &pvzUUID, `SELECT pvz_id FROM shop.receptions WHERE id = $1`, recsUUIDs[0]))
+		transition := models.ReceptionTransition{
+			ReceptionID: recsUUIDs[0],
+			From:        string(api.ReceptionStatusClosed),
+			To:          string(api.ReceptionStatusInProgress),
+			ActorID:     uuid.New(),
+			ActorRole:   string(api.Moderator),
+		}
+
+		require.NoError(t, r.UpdateReceptionStatus(ctx, transition))
+		row := statsByPvz(string(api.StatsDimensionPvz))[pvzUUID]
+		require.Equal(t, seedReceptionsPerPVZ-1, row.Receptions)
+		require.Equal(t, (seedReceptionsPerPVZ-1)*seedProductsCounted, row.Products)
+
+		transition.From, transition.To = transition.To, transition.From
+		require.NoError(t, r.UpdateReceptionStatus(ctx, transition))
+		row = statsByPvz(string(api.StatsDimensionPvz))[pvzUUID]
+		require.Equal(t, seedReceptionsPerPVZ, row.Receptions)
+		require.Equal(t, seedReceptionsPerPVZ*seedProductsCounted, row.Products)
+	})
+	t.Run("transfer", func(t *testing.T) {
+		var productUUID, sourcePvzUUID uuid.UUID
+		err := db.QueryRowContext(ctx, `
+			SELECT p.id, r.pvz_id
+			FROM shop.products p
+			JOIN shop.receptions r ON r.id = p.reception_id
+			WHERE p.reception_id = $1 AND p.deleted_at IS NULL AND p.voided_at IS NULL
+			LIMIT 1
+		`, recsUUIDs[0]).Scan(&productUUID, &sourcePvzUUID)
+		require.NoError(t, err)
+		_, err = db.ExecContext(ctx, `UPDATE shop.products SET status = 'stored' WHERE id = $1`, productUUID)
+		require.NoError(t, err)
+
+		destinationPvzUUID := pvzUUIDs[0]
+		if destinationPvzUUID == sourcePvzUUID {
+			destinationPvzUUID = pvzUUIDs[1]
+		}
+
+		s := service.New(r, nil, nil, nil, nil)
+		employeeCtx := models.SetAuthPrincipal(ctx, models.AuthPrincipal{UserUUID: uuid.New(), Role: string(api.Employee)})
+		transfer, err := s.CreateTransfer(employeeCtx, api.PostTransfersJSONBody{
+			SourcePvzId:      sourcePvzUUID,
+			DestinationPvzId: destinationPvzUUID,
+			ProductIds:       []uuid.UUID{productUUID},
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			var recUUID uuid.NullUUID
+			require.NoError(t, db.GetContext(ctx, &recUUID, `SELECT reception_id FROM shop.transfers WHERE id = $1`, transfer.Id))
+			_, err := db.ExecContext(ctx, `DELETE FROM shop.transfers WHERE id = $1`, transfer.Id)
+			require.NoError(t, err)
+			_, err = db.ExecContext(ctx, `DELETE FROM shop.reception_status_history WHERE reception_id = $1`, recUUID)
+			require.NoError(t, err)
+			_, err = db.ExecContext(ctx, `DELETE FROM shop.receptions WHERE id = $1`, recUUID)
+			require.NoError(t, err)
+		})
+
+		before := statsByPvz(string(api.StatsDimensionPvz), string(api.StatsDimensionProductType))
+		_, err = s.DispatchTransfer(employeeCtx, *transfer.Id)
+		require.NoError(t, err)
+		_, err = s.ReceiveTransfer(employeeCtx, *transfer.Id)
+		require.NoError(t, err)
+
+		// сводки после перемещения совпадают и с прежними, и с пересчитанными по сырым данным
+		after := statsByPvz(string(api.StatsDimensionPvz), string(api.StatsDimensionProductType))
+		require.Equal(t, before, after)
+		for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+			require.NoError(t, r.RebuildDailySummaries(ctx, day))
+		}
+		require.Equal(t, after, statsByPvz(string(api.StatsDimensionPvz), string(api.StatsDimensionProductType)))
+	})
+}
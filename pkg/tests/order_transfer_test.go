@@ -0,0 +1,92 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/devWaylander/pvz_store/api"
+	"github.com/devWaylander/pvz_store/internal/repo"
+	"github.com/devWaylander/pvz_store/internal/service"
+	"github.com/devWaylander/pvz_store/pkg/models"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCreateOrderSkipsProductsInTransfer проверяет, что товар созданного перемещения не сопоставляется
+// с новым заказом ПВЗ отправления и перемещение после этого отправляется
+func TestCreateOrderSkipsProductsInTransfer(t *testing.T) {
+	db := connectTestDB(t)
+	r := repo.New(db)
+	ctx := context.Background()
+
+	var pvzUUIDs []uuid.UUID
+	require.NoError(t, db.SelectContext(ctx, &pvzUUIDs, `
+		INSERT INTO shop.pvz (id, city, registration_date)
+		SELECT gen_random_uuid(), 'Москва', NOW()
+		FROM generate_series(1, 2)
+		RETURNING id
+	`))
+	sourcePvzUUID, destinationPvzUUID := pvzUUIDs[0], pvzUUIDs[1]
+
+	var recUUID uuid.UUID
+	require.NoError(t, db.GetContext(ctx, &recUUID, `
+		INSERT INTO shop.receptions (pvz_id, status)
+		VALUES ($1, 'closed')
+		RETURNING id
+	`, sourcePvzUUID))
+
+	barcode := "TRANSFER-" + uuid.NewString()[:8]
+	var productUUID uuid.UUID
+	require.NoError(t, db.GetContext(ctx, &productUUID, `
+		INSERT INTO shop.products (reception_id, current_pvz_id, type, barcode, status)
+		VALUES ($1, $2, 'электроника', $3, 'stored')
+		RETURNING id
+	`, recUUID, sourcePvzUUID, barcode))
+
+	var transferUUID, orderUUID uuid.UUID
+	t.Cleanup(func() {
+		for _, stmt := range []struct {
+			query string
+			arg   uuid.UUID
+		}{
+			{`DELETE FROM shop.orders WHERE id = $1`, orderUUID},
+			{`DELETE FROM shop.transfers WHERE id = $1`, transferUUID},
+			{`DELETE FROM shop.product_status_history WHERE product_id = $1`, productUUID},
+			{`DELETE FROM shop.products WHERE id = $1`, productUUID},
+			{`DELETE FROM shop.receptions WHERE id = $1`, recUUID},
+		} {
+			_, err := db.ExecContext(ctx, stmt.query, stmt.arg)
+			require.NoError(t, err)
+		}
+		_, err := db.ExecContext(ctx, `DELETE FROM shop.pvz WHERE id = ANY($1)`, pq.Array(pvzUUIDs))
+		require.NoError(t, err)
+	})
+
+	s := service.New(r, nil, nil, nil, nil)
+	employeeCtx := models.SetAuthPrincipal(ctx, models.AuthPrincipal{UserUUID: uuid.New(), Role: string(api.Employee)})
+	transfer, err := s.CreateTransfer(employeeCtx, api.PostTransfersJSONBody{
+		SourcePvzId:      sourcePvzUUID,
+		DestinationPvzId: destinationPvzUUID,
+		ProductIds:       []uuid.UUID{productUUID},
+	})
+	require.NoError(t, err)
+	transferUUID = *transfer.Id
+
+	phone := "+79990000000"
+	order, err := s.CreateOrder(employeeCtx, api.PostOrdersJSONBody{
+		Number:         uuid.NewString(),
+		PvzId:          sourcePvzUUID,
+		RecipientPhone: &phone,
+		Barcodes:       []string{barcode},
+	})
+	require.NoError(t, err)
+	orderUUID = *order.Id
+	require.Equal(t, api.Awaiting, order.Status)
+	require.Len(t, order.Items, 1)
+	require.Nil(t, order.Items[0].ProductId)
+
+	dispatched, err := s.DispatchTransfer(employeeCtx, transferUUID)
+	require.NoError(t, err)
+	require.Equal(t, api.Dispatched, dispatched.Status)
+}
@@ -0,0 +1,75 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/devWaylander/pvz_store/internal/repo"
+	"github.com/devWaylander/pvz_store/pkg/models"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetReceptionTimings проверяет, что в отчет о скорости приемок попадают только приемки поставок
+func TestGetReceptionTimings(t *testing.T) {
+	db := connectTestDB(t)
+	r := repo.New(db)
+	ctx := context.Background()
+
+	var pvzUUID uuid.UUID
+	require.NoError(t, db.GetContext(ctx, &pvzUUID, `
+		INSERT INTO shop.pvz (id, city, registration_date)
+		VALUES (gen_random_uuid(), 'Москва', NOW())
+		RETURNING id
+	`))
+
+	// закрытые приемка поставки и приемка перемещения с товаром в каждой
+	receptions := make(map[string]uuid.UUID, 2)
+	for _, recType := range []string{"supply", "transfer"} {
+		var recUUID uuid.UUID
+		require.NoError(t, db.GetContext(ctx, &recUUID, `
+			INSERT INTO shop.receptions (pvz_id, status, type, created_at)
+			VALUES ($1, 'closed', $2, NOW() - INTERVAL '1 hour')
+			RETURNING id
+		`, pvzUUID, recType))
+		receptions[recType] = recUUID
+
+		_, err := db.ExecContext(ctx, `
+			INSERT INTO shop.reception_status_history (reception_id, from_status, to_status, actor_id, actor_role)
+			VALUES ($1, 'in_progress', 'closed', gen_random_uuid(), 'employee')
+		`, recUUID)
+		require.NoError(t, err)
+		_, err = db.ExecContext(ctx, `
+			INSERT INTO shop.products (reception_id, current_pvz_id, type, created_at)
+			VALUES ($1, $2, 'электроника', NOW() - INTERVAL '30 minutes')
+		`, recUUID, pvzUUID)
+		require.NoError(t, err)
+	}
+
+	t.Cleanup(func() {
+		recsUUIDs := []uuid.UUID{receptions["supply"], receptions["transfer"]}
+		for _, query := range []string{
+			`DELETE FROM shop.products WHERE reception_id = ANY($1)`,
+			`DELETE FROM shop.reception_status_history WHERE reception_id = ANY($1)`,
+			`DELETE FROM shop.receptions WHERE id = ANY($1)`,
+		} {
+			_, err := db.ExecContext(ctx, query, pq.Array(recsUUIDs))
+			require.NoError(t, err)
+		}
+		_, err := db.ExecContext(ctx, `DELETE FROM shop.pvz WHERE id = $1`, pvzUUID)
+		require.NoError(t, err)
+	})
+
+	now := time.Now()
+	timings, err := r.GetReceptionTimings(ctx, models.ProductivityFilter{
+		StartDate: now.Add(-24 * time.Hour),
+		EndDate:   now.Add(24 * time.Hour),
+		PvzID:     &pvzUUID,
+	})
+	require.NoError(t, err)
+	require.Len(t, timings, 1)
+	require.Equal(t, receptions["supply"], timings[0].ReceptionID)
+	require.Len(t, timings[0].ScanTimes, 1)
+}
@@ -0,0 +1,253 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/devWaylander/pvz_store/api"
+	"github.com/devWaylander/pvz_store/config"
+	"github.com/devWaylander/pvz_store/internal/repo"
+	"github.com/devWaylander/pvz_store/pkg/models"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+// Размер засеянных данных: ПВЗ, приемок на ПВЗ и товаров на приемку
+const (
+	seedPVZs                 = 50
+	seedReceptionsPerPVZ     = 10
+	seedProductsPerReception = 20
+)
+
+// pvzInfoRepo методы репозитория, которыми собирается ответ GET /pvz
+type pvzInfoRepo interface {
+	GetPVZsWithPagination(ctx context.Context, page, limit int) ([]api.PVZ, error)
+	GetReceptionsByPvzUUIDsFiltered(ctx context.Context, pvzUUIDs []uuid.UUID, startDate, endDate *time.Time) ([]api.Reception, error)
+	GetProductsByRecsUUIDs(ctx context.Context, recsUUIDs []uuid.UUID) ([]api.Product, error)
+	GetPVZsInfo(ctx context.Context, filter models.PvzInfoFilter) ([]models.PvzInfo, error)
+}
+
+// connectTestDB подключается к тестовой БД, при отсутствии настроек или БД тест пропускается
+func connectTestDB(tb testing.TB) *sqlx.DB {
+	tb.Helper()
+
+	cfg, err := config.Parse()
+	if err != nil {
+		tb.Skipf("test db is not configured: %v", err)
+	}
+	db, err := sqlx.Connect("postgres", cfg.DB.DBTestUrl)
+	if err != nil {
+		tb.Skipf("test db is unavailable: %v", err)
+	}
+	tb.Cleanup(func() { db.Close() })
+
+	return db
+}
+
+// seedPVZsInfo засеивает ПВЗ с закрытыми приемками и товарами, часть товаров аннулирована или удалена,
+// у части заполнены вес и габариты. Возвращает ПВЗ и приемки, засеянные строки удаляются по завершении
+func seedPVZsInfo(tb testing.TB, db *sqlx.DB) ([]uuid.UUID, []uuid.UUID) {
+	tb.Helper()
+	ctx := context.Background()
+
+	var pvzUUIDs []uuid.UUID
+	err := db.SelectContext(ctx, &pvzUUIDs, `
+		INSERT INTO shop.pvz (id, city, registration_date)
+		SELECT gen_random_uuid(), 'Москва', NOW()
+		FROM generate_series(1, $1)
+		RETURNING id
+	`, seedPVZs)
+	require.NoError(tb, err)
+
+	var recsUUIDs []uuid.UUID
+	err = db.SelectContext(ctx, &recsUUIDs, `
+		INSERT INTO shop.receptions (pvz_id, status, created_at)
+		SELECT pv.id, 'closed', NOW() - make_interval(hours => g)
+		FROM unnest($1::uuid[]) AS pv(id), generate_series(1, $2) AS g
+		RETURNING id
+	`, pq.Array(pvzUUIDs), seedReceptionsPerPVZ)
+	require.NoError(tb, err)
+
+	_, err = db.ExecContext(ctx, `
+		INSERT INTO shop.products (reception_id, current_pvz_id, type, created_at, voided_at, deleted_at, weight, length, width, height)
+		SELECT
+			r.id,
+			r.pvz_id,
+			'электроника',
+			NOW() - make_interval(mins => g),
+			CASE WHEN g % 10 = 0 THEN NOW() END,
+			CASE WHEN g % 15 = 0 THEN NOW() END,
+			CASE WHEN g % 2 = 0 THEN 1000 + g END,
+			CASE WHEN g % 3 = 0 THEN 10 END,
+			CASE WHEN g % 3 = 0 THEN 20 END,
+			CASE WHEN g % 3 = 0 THEN 30 END
+		FROM shop.receptions r, generate_series(1, $2) AS g
+		WHERE r.id = ANY($1)
+	`, pq.Array(recsUUIDs), seedProductsPerReception)
+	require.NoError(tb, err)
+
+	tb.Cleanup(func() {
+		_, err := db.ExecContext(ctx, `DELETE FROM shop.products WHERE reception_id = ANY($1)`, pq.Array(recsUUIDs))
+		require.NoError(tb, err)
+		_, err = db.ExecContext(ctx, `DELETE FROM shop.receptions WHERE id = ANY($1)`, pq.Array(recsUUIDs))
+		require.NoError(tb, err)
+		_, err = db.ExecContext(ctx, `DELETE FROM shop.pvz WHERE id = ANY($1)`, pq.Array(pvzUUIDs))
+		require.NoError(tb, err)
+	})
+
+	return pvzUUIDs, recsUUIDs
+}
+
+// getPVZsInfoSeparately собирает страницу ПВЗ тремя запросами со склейкой в Go, как до агрегирующего запроса
+func getPVZsInfoSeparately(ctx context.Context, r pvzInfoRepo, page, limit int, startDate, endDate *time.Time) ([]models.PvzInfo, error) {
+	pvzs, err := r.GetPVZsWithPagination(ctx, page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	pvzsUUIDs := make([]uuid.UUID, 0, len(pvzs))
+	for _, pvz := range pvzs {
+		pvzsUUIDs = append(pvzsUUIDs, *pvz.Id)
+	}
+	receptions, err := r.GetReceptionsByPvzUUIDsFiltered(ctx, pvzsUUIDs, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	recsUUIDs := make([]uuid.UUID, 0, len(receptions))
+	for _, rec := range receptions {
+		recsUUIDs = append(recsUUIDs, *rec.Id)
+	}
+	products, err := r.GetProductsByRecsUUIDs(ctx, recsUUIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	productsByRec := make(map[uuid.UUID][]api.Product)
+	for _, product := range products {
+		productsByRec[product.ReceptionId] = append(productsByRec[product.ReceptionId], product)
+	}
+	recsByPvz := make(map[uuid.UUID][]models.ReceptionWithProducts)
+	for _, rec := range receptions {
+		recsByPvz[rec.PvzId] = append(recsByPvz[rec.PvzId], models.ReceptionWithProducts{
+			Reception: rec,
+			Products:  productsByRec[*rec.Id],
+		})
+	}
+
+	var result []models.PvzInfo
+	for _, pvz := range pvzs {
+		result = append(result, models.PvzInfo{
+			Pvz:        pvz,
+			Receptions: recsByPvz[*pvz.Id],
+		})
+	}
+
+	return result, nil
+}
+
+// TestGetPVZsInfoAggregated проверяет, что агрегирующий запрос и его потоковое чтение отдают тот же ответ GET /pvz,
+// что и три отдельных запроса.
+// В тестовой БД могут быть и другие ПВЗ, поэтому страницы проходятся целиком, а сравниваются только засеянные ПВЗ
+func TestGetPVZsInfoAggregated(t *testing.T) {
+	db := connectTestDB(t)
+	pvzUUIDs, _ := seedPVZsInfo(t, db)
+	r := repo.New(db)
+	ctx := context.Background()
+
+	seeded := make(map[uuid.UUID]bool, len(pvzUUIDs))
+	for _, pvzUUID := range pvzUUIDs {
+		seeded[pvzUUID] = true
+	}
+	onlySeeded := func(infos []models.PvzInfo) []models.PvzInfo {
+		var result []models.PvzInfo
+		for _, info := range infos {
+			if seeded[*info.Pvz.Id] {
+				result = append(result, info)
+			}
+		}
+		return result
+	}
+
+	startDate := time.Now().Add(-5 * time.Hour)
+	projection := models.NewPvzInfoProjection(nil, nil)
+	filters := []struct {
+		name      string
+		startDate *time.Time
+		endDate   *time.Time
+	}{
+		{name: "without period"},
+		{name: "with start date", startDate: &startDate},
+		{name: "with end date", endDate: &startDate},
+	}
+
+	for _, filter := range filters {
+		t.Run(filter.name, func(t *testing.T) {
+			const limit = 100
+			compared := 0
+			for page := 1; ; page++ {
+				separately, err := getPVZsInfoSeparately(ctx, r, page, limit, filter.startDate, filter.endDate)
+				require.NoError(t, err)
+				pvzFilter := models.PvzInfoFilter{
+					Page:           page,
+					Limit:          limit,
+					StartDate:      filter.startDate,
+					EndDate:        filter.endDate,
+					WithReceptions: true,
+					WithProducts:   true,
+				}
+				aggregated, err := r.GetPVZsInfo(ctx, pvzFilter)
+				require.NoError(t, err)
+				require.Len(t, aggregated, len(separately))
+
+				// потоковое чтение курсором отдает ту же страницу
+				var streamed []models.PvzInfo
+				require.NoError(t, r.StreamPVZsInfo(ctx, pvzFilter, func(info models.PvzInfo) error {
+					streamed = append(streamed, info)
+					return nil
+				}))
+				require.Equal(t, aggregated, streamed)
+
+				want, err := json.Marshal(models.MapPvzInfoToAPIResponse(onlySeeded(separately), projection))
+				require.NoError(t, err)
+				got, err := json.Marshal(models.MapPvzInfoToAPIResponse(onlySeeded(aggregated), projection))
+				require.NoError(t, err)
+				require.JSONEq(t, string(want), string(got))
+
+				compared += len(onlySeeded(aggregated))
+				if len(aggregated) < limit {
+					break
+				}
+			}
+			require.Equal(t, seedPVZs, compared)
+		})
+	}
+}
+
+// BenchmarkGetPVZsInfo сравнивает сборку страницы ПВЗ тремя запросами и одним агрегирующим запросом
+func BenchmarkGetPVZsInfo(b *testing.B) {
+	db := connectTestDB(b)
+	seedPVZsInfo(b, db)
+	r := repo.New(db)
+	ctx := context.Background()
+
+	b.Run("three queries", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := getPVZsInfoSeparately(ctx, r, 1, seedPVZs, nil, nil); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("json_agg", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := r.GetPVZsInfo(ctx, models.PvzInfoFilter{Page: 1, Limit: seedPVZs, WithReceptions: true, WithProducts: true}); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}